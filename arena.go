@@ -0,0 +1,97 @@
+package gomme
+
+// Arena is a bump allocator for values of type T, which parsers producing
+// large numbers of small outputs, such as the elements of the lists found in
+// big documents, can allocate them from. Instead of scattering many small
+// allocations across the heap, an Arena carves them out of large chunks, which
+// are released all at once when the Arena is reset, or becomes unreachable.
+//
+// The slices and pointers handed out by an Arena remain valid until Reset is
+// called: callers are expected to extract what they need from a parser's output
+// before resetting the Arena it was allocated from.
+//
+// An Arena is not safe for concurrent use: parsers allocating from the same
+// Arena must not be invoked concurrently.
+type Arena[T any] struct {
+	chunkSize int
+	chunk     []T
+	offset    int
+
+	// scratch collects the elements of the lists being parsed by the
+	// combinators allocating from the Arena. As nested lists complete
+	// before the lists holding them, it is used as a stack.
+	scratch []T
+}
+
+// NewArena produces a new Arena, allocating its values in chunks of the
+// provided number of elements. A chunk size of zero or less defaults to 1024.
+func NewArena[T any](chunkSize int) *Arena[T] {
+	if chunkSize <= 0 {
+		chunkSize = 1024
+	}
+
+	return &Arena[T]{chunkSize: chunkSize}
+}
+
+// New returns a pointer to a new zero value of type T, allocated from the Arena.
+func (a *Arena[T]) New() *T {
+	return &a.Slice(1)[0]
+}
+
+// Slice returns a new slice of zero values of type T, of the provided length and
+// capacity, allocated from the Arena. Slices longer than the Arena's chunk size
+// are allocated on their own.
+func (a *Arena[T]) Slice(length int) []T {
+	if length == 0 {
+		return []T{}
+	}
+
+	if length > a.chunkSize {
+		return make([]T, length)
+	}
+
+	if a.offset+length > len(a.chunk) {
+		a.chunk = make([]T, a.chunkSize)
+		a.offset = 0
+	}
+
+	start := a.offset
+	a.offset += length
+
+	// Limiting the slice's capacity ensures appending to it never
+	// overwrites the values handed out next.
+	return a.chunk[start:a.offset:a.offset]
+}
+
+// Reset releases all the values allocated from the Arena at once, and makes
+// its current chunk available to allocate new ones. The slices and pointers
+// previously handed out by the Arena must not be used anymore.
+func (a *Arena[T]) Reset() {
+	var zero T
+	for idx := 0; idx < a.offset; idx++ {
+		a.chunk[idx] = zero
+	}
+
+	a.offset = 0
+}
+
+// collect copies the elements pushed onto the scratch stack since the provided
+// position into a slice allocated from the Arena, and pops them.
+func (a *Arena[T]) collect(start int) []T {
+	results := a.Slice(len(a.scratch) - start)
+	copy(results, a.scratch[start:])
+	a.discard(start)
+
+	return results
+}
+
+// discard pops the elements pushed onto the scratch stack since the provided
+// position, clearing them so that they can be garbage collected.
+func (a *Arena[T]) discard(start int) {
+	var zero T
+	for idx := start; idx < len(a.scratch); idx++ {
+		a.scratch[idx] = zero
+	}
+
+	a.scratch = a.scratch[:start]
+}
@@ -0,0 +1,66 @@
+package gomme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArenaSlice(t *testing.T) {
+	t.Parallel()
+
+	arena := NewArena[int](4)
+
+	first := arena.Slice(3)
+	second := arena.Slice(2)
+	large := arena.Slice(10)
+
+	assert.Equal(t, []int{0, 0, 0}, first)
+	assert.Equal(t, 3, cap(first), "slices capacity should be limited to their length")
+	assert.Equal(t, []int{0, 0}, second)
+	assert.Len(t, large, 10)
+
+	// Appending to a slice must not overwrite the ones handed out next.
+	second[0] = 42
+	_ = append(first[:2], 7, 7)
+	assert.Equal(t, 42, second[0])
+}
+
+func TestArenaNew(t *testing.T) {
+	t.Parallel()
+
+	type point struct{ X, Y int }
+
+	arena := NewArena[point](0)
+
+	first := arena.New()
+	first.X = 1
+	second := arena.New()
+
+	assert.Equal(t, &point{}, second)
+	assert.Equal(t, &point{X: 1}, first)
+}
+
+func TestArenaReset(t *testing.T) {
+	t.Parallel()
+
+	arena := NewArena[int](4)
+
+	first := arena.Slice(2)
+	first[0], first[1] = 1, 2
+
+	arena.Reset()
+	second := arena.Slice(2)
+
+	assert.Equal(t, []int{0, 0}, second, "reset arenas should hand out zero values")
+}
+
+func BenchmarkArenaSlice(b *testing.B) {
+	arena := NewArena[int](1024)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		arena.Slice(8)
+	}
+}
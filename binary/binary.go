@@ -0,0 +1,204 @@
+// Package binary provides parsers for binary encoded data, such as the integers
+// found in file headers and network frames. Unlike the textual parsers of the
+// gomme package, these parsers decode the raw bytes of the input, and are meant
+// to be used with []byte inputs.
+package binary
+
+import "github.com/oleiade/gomme"
+
+// Uint8 parses a single byte from the input as a uint8.
+func Uint8[Input gomme.Bytes]() gomme.Parser[Input, uint8] {
+	return func(input Input) gomme.Result[uint8, Input] {
+		if len(input) < 1 {
+			return gomme.Failure[Input, uint8](gomme.NewError(input, "Uint8"), input)
+		}
+
+		return gomme.Success(input[0], input[1:])
+	}
+}
+
+// Int8 parses a single byte from the input as a two's complement int8.
+func Int8[Input gomme.Bytes]() gomme.Parser[Input, int8] {
+	return func(input Input) gomme.Result[int8, Input] {
+		if len(input) < 1 {
+			return gomme.Failure[Input, int8](gomme.NewError(input, "Int8"), input)
+		}
+
+		return gomme.Success(int8(input[0]), input[1:])
+	}
+}
+
+// BEUint16 parses 2 bytes from the input as a big-endian uint16.
+func BEUint16[Input gomme.Bytes]() gomme.Parser[Input, uint16] {
+	return func(input Input) gomme.Result[uint16, Input] {
+		if len(input) < 2 {
+			return gomme.Failure[Input, uint16](gomme.NewError(input, "BEUint16"), input)
+		}
+
+		return gomme.Success(uint16(bigEndian(input[:2])), input[2:])
+	}
+}
+
+// LEUint16 parses 2 bytes from the input as a little-endian uint16.
+func LEUint16[Input gomme.Bytes]() gomme.Parser[Input, uint16] {
+	return func(input Input) gomme.Result[uint16, Input] {
+		if len(input) < 2 {
+			return gomme.Failure[Input, uint16](gomme.NewError(input, "LEUint16"), input)
+		}
+
+		return gomme.Success(uint16(littleEndian(input[:2])), input[2:])
+	}
+}
+
+// BEInt16 parses 2 bytes from the input as a big-endian two's complement int16.
+func BEInt16[Input gomme.Bytes]() gomme.Parser[Input, int16] {
+	return func(input Input) gomme.Result[int16, Input] {
+		if len(input) < 2 {
+			return gomme.Failure[Input, int16](gomme.NewError(input, "BEInt16"), input)
+		}
+
+		return gomme.Success(int16(bigEndian(input[:2])), input[2:])
+	}
+}
+
+// LEInt16 parses 2 bytes from the input as a little-endian two's complement int16.
+func LEInt16[Input gomme.Bytes]() gomme.Parser[Input, int16] {
+	return func(input Input) gomme.Result[int16, Input] {
+		if len(input) < 2 {
+			return gomme.Failure[Input, int16](gomme.NewError(input, "LEInt16"), input)
+		}
+
+		return gomme.Success(int16(littleEndian(input[:2])), input[2:])
+	}
+}
+
+// BEUint32 parses 4 bytes from the input as a big-endian uint32.
+func BEUint32[Input gomme.Bytes]() gomme.Parser[Input, uint32] {
+	return func(input Input) gomme.Result[uint32, Input] {
+		if len(input) < 4 {
+			return gomme.Failure[Input, uint32](gomme.NewError(input, "BEUint32"), input)
+		}
+
+		return gomme.Success(uint32(bigEndian(input[:4])), input[4:])
+	}
+}
+
+// LEUint32 parses 4 bytes from the input as a little-endian uint32.
+func LEUint32[Input gomme.Bytes]() gomme.Parser[Input, uint32] {
+	return func(input Input) gomme.Result[uint32, Input] {
+		if len(input) < 4 {
+			return gomme.Failure[Input, uint32](gomme.NewError(input, "LEUint32"), input)
+		}
+
+		return gomme.Success(uint32(littleEndian(input[:4])), input[4:])
+	}
+}
+
+// BEInt32 parses 4 bytes from the input as a big-endian two's complement int32.
+func BEInt32[Input gomme.Bytes]() gomme.Parser[Input, int32] {
+	return func(input Input) gomme.Result[int32, Input] {
+		if len(input) < 4 {
+			return gomme.Failure[Input, int32](gomme.NewError(input, "BEInt32"), input)
+		}
+
+		return gomme.Success(int32(bigEndian(input[:4])), input[4:])
+	}
+}
+
+// LEInt32 parses 4 bytes from the input as a little-endian two's complement int32.
+func LEInt32[Input gomme.Bytes]() gomme.Parser[Input, int32] {
+	return func(input Input) gomme.Result[int32, Input] {
+		if len(input) < 4 {
+			return gomme.Failure[Input, int32](gomme.NewError(input, "LEInt32"), input)
+		}
+
+		return gomme.Success(int32(littleEndian(input[:4])), input[4:])
+	}
+}
+
+// BEUint64 parses 8 bytes from the input as a big-endian uint64.
+func BEUint64[Input gomme.Bytes]() gomme.Parser[Input, uint64] {
+	return func(input Input) gomme.Result[uint64, Input] {
+		if len(input) < 8 {
+			return gomme.Failure[Input, uint64](gomme.NewError(input, "BEUint64"), input)
+		}
+
+		return gomme.Success(uint64(bigEndian(input[:8])), input[8:])
+	}
+}
+
+// LEUint64 parses 8 bytes from the input as a little-endian uint64.
+func LEUint64[Input gomme.Bytes]() gomme.Parser[Input, uint64] {
+	return func(input Input) gomme.Result[uint64, Input] {
+		if len(input) < 8 {
+			return gomme.Failure[Input, uint64](gomme.NewError(input, "LEUint64"), input)
+		}
+
+		return gomme.Success(uint64(littleEndian(input[:8])), input[8:])
+	}
+}
+
+// BEInt64 parses 8 bytes from the input as a big-endian two's complement int64.
+func BEInt64[Input gomme.Bytes]() gomme.Parser[Input, int64] {
+	return func(input Input) gomme.Result[int64, Input] {
+		if len(input) < 8 {
+			return gomme.Failure[Input, int64](gomme.NewError(input, "BEInt64"), input)
+		}
+
+		return gomme.Success(int64(bigEndian(input[:8])), input[8:])
+	}
+}
+
+// LEInt64 parses 8 bytes from the input as a little-endian two's complement int64.
+func LEInt64[Input gomme.Bytes]() gomme.Parser[Input, int64] {
+	return func(input Input) gomme.Result[int64, Input] {
+		if len(input) < 8 {
+			return gomme.Failure[Input, int64](gomme.NewError(input, "LEInt64"), input)
+		}
+
+		return gomme.Success(int64(littleEndian(input[:8])), input[8:])
+	}
+}
+
+// Endianness describes the order in which the bytes of a multi-byte integer
+// are laid out in the input.
+type Endianness int
+
+const (
+	// BigEndian lays out the most significant byte first, as network
+	// protocols do.
+	BigEndian Endianness = iota
+
+	// LittleEndian lays out the least significant byte first, as most
+	// CPU architectures do.
+	LittleEndian
+)
+
+// decode decodes the provided bytes into an integer, in the provided byte order.
+func decode[Input gomme.Bytes](input Input, endianness Endianness) uint64 {
+	if endianness == LittleEndian {
+		return littleEndian(input)
+	}
+
+	return bigEndian(input)
+}
+
+// bigEndian decodes the provided bytes, most significant first, into an integer.
+func bigEndian[Input gomme.Bytes](input Input) uint64 {
+	var n uint64
+	for idx := 0; idx < len(input); idx++ {
+		n = n<<8 | uint64(input[idx])
+	}
+
+	return n
+}
+
+// littleEndian decodes the provided bytes, least significant first, into an integer.
+func littleEndian[Input gomme.Bytes](input Input) uint64 {
+	var n uint64
+	for idx := len(input) - 1; idx >= 0; idx-- {
+		n = n<<8 | uint64(input[idx])
+	}
+
+	return n
+}
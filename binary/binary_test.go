@@ -0,0 +1,986 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUint8(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, uint8]
+		input         []byte
+		wantErr       bool
+		wantOutput    uint8
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        Uint8[[]byte](),
+			input:         []byte{0xFE, 0xAA},
+			wantErr:       false,
+			wantOutput:    0xFE,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Uint8[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkUint8(b *testing.B) {
+	parser := Uint8[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xFE, 0xAA})
+	}
+}
+
+func TestInt8(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, int8]
+		input         []byte
+		wantErr       bool
+		wantOutput    int8
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        Int8[[]byte](),
+			input:         []byte{0xFE, 0xAA},
+			wantErr:       false,
+			wantOutput:    -2,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Int8[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkInt8(b *testing.B) {
+	parser := Int8[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xFE, 0xAA})
+	}
+}
+
+func TestBEUint16(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, uint16]
+		input         []byte
+		wantErr       bool
+		wantOutput    uint16
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        BEUint16[[]byte](),
+			input:         []byte{0x01, 0x02, 0xAA},
+			wantErr:       false,
+			wantOutput:    0x0102,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        BEUint16[[]byte](),
+			input:         []byte{0x01},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BEUint16[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkBEUint16(b *testing.B) {
+	parser := BEUint16[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0x01, 0x02, 0xAA})
+	}
+}
+
+func TestLEUint16(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, uint16]
+		input         []byte
+		wantErr       bool
+		wantOutput    uint16
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        LEUint16[[]byte](),
+			input:         []byte{0x01, 0x02, 0xAA},
+			wantErr:       false,
+			wantOutput:    0x0201,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        LEUint16[[]byte](),
+			input:         []byte{0x01},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LEUint16[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkLEUint16(b *testing.B) {
+	parser := LEUint16[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0x01, 0x02, 0xAA})
+	}
+}
+
+func TestBEInt16(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, int16]
+		input         []byte
+		wantErr       bool
+		wantOutput    int16
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        BEInt16[[]byte](),
+			input:         []byte{0xFF, 0xFE, 0xAA},
+			wantErr:       false,
+			wantOutput:    -2,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        BEInt16[[]byte](),
+			input:         []byte{0x01},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BEInt16[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkBEInt16(b *testing.B) {
+	parser := BEInt16[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xFF, 0xFE, 0xAA})
+	}
+}
+
+func TestLEInt16(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, int16]
+		input         []byte
+		wantErr       bool
+		wantOutput    int16
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        LEInt16[[]byte](),
+			input:         []byte{0xFF, 0xFE, 0xAA},
+			wantErr:       false,
+			wantOutput:    -257,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        LEInt16[[]byte](),
+			input:         []byte{0x01},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LEInt16[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkLEInt16(b *testing.B) {
+	parser := LEInt16[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xFF, 0xFE, 0xAA})
+	}
+}
+
+func TestBEUint32(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, uint32]
+		input         []byte
+		wantErr       bool
+		wantOutput    uint32
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        BEUint32[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03, 0x04, 0xAA},
+			wantErr:       false,
+			wantOutput:    0x01020304,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        BEUint32[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01, 0x02, 0x03},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BEUint32[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkBEUint32(b *testing.B) {
+	parser := BEUint32[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0x01, 0x02, 0x03, 0x04, 0xAA})
+	}
+}
+
+func TestLEUint32(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, uint32]
+		input         []byte
+		wantErr       bool
+		wantOutput    uint32
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        LEUint32[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03, 0x04, 0xAA},
+			wantErr:       false,
+			wantOutput:    0x04030201,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        LEUint32[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01, 0x02, 0x03},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LEUint32[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkLEUint32(b *testing.B) {
+	parser := LEUint32[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0x01, 0x02, 0x03, 0x04, 0xAA})
+	}
+}
+
+func TestBEInt32(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, int32]
+		input         []byte
+		wantErr       bool
+		wantOutput    int32
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        BEInt32[[]byte](),
+			input:         []byte{0xFF, 0xFF, 0xFF, 0xFE, 0xAA},
+			wantErr:       false,
+			wantOutput:    -2,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        BEInt32[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01, 0x02, 0x03},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BEInt32[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkBEInt32(b *testing.B) {
+	parser := BEInt32[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xFF, 0xFF, 0xFF, 0xFE, 0xAA})
+	}
+}
+
+func TestLEInt32(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, int32]
+		input         []byte
+		wantErr       bool
+		wantOutput    int32
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        LEInt32[[]byte](),
+			input:         []byte{0xFF, 0xFF, 0xFF, 0xFE, 0xAA},
+			wantErr:       false,
+			wantOutput:    -16777217,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        LEInt32[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01, 0x02, 0x03},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LEInt32[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkLEInt32(b *testing.B) {
+	parser := LEInt32[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xFF, 0xFF, 0xFF, 0xFE, 0xAA})
+	}
+}
+
+func TestBEUint64(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, uint64]
+		input         []byte
+		wantErr       bool
+		wantOutput    uint64
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        BEUint64[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xAA},
+			wantErr:       false,
+			wantOutput:    0x0102030405060708,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        BEUint64[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BEUint64[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkBEUint64(b *testing.B) {
+	parser := BEUint64[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xAA})
+	}
+}
+
+func TestLEUint64(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, uint64]
+		input         []byte
+		wantErr       bool
+		wantOutput    uint64
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        LEUint64[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xAA},
+			wantErr:       false,
+			wantOutput:    0x0807060504030201,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        LEUint64[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LEUint64[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkLEUint64(b *testing.B) {
+	parser := LEUint64[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0xAA})
+	}
+}
+
+func TestBEInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, int64]
+		input         []byte
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        BEInt64[[]byte](),
+			input:         []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE, 0xAA},
+			wantErr:       false,
+			wantOutput:    -2,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        BEInt64[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BEInt64[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkBEInt64(b *testing.B) {
+	parser := BEInt64[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE, 0xAA})
+	}
+}
+
+func TestLEInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, int64]
+		input         []byte
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing enough bytes should succeed",
+			parser:        LEInt64[[]byte](),
+			input:         []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE, 0xAA},
+			wantErr:       false,
+			wantOutput:    -72057594037927937,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        LEInt64[[]byte](),
+			input:         []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LEInt64[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkLEInt64(b *testing.B) {
+	parser := LEInt64[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE, 0xAA})
+	}
+}
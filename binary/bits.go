@@ -0,0 +1,125 @@
+package binary
+
+import "github.com/oleiade/gomme"
+
+// BitInput is the input of bit-level parsers. It holds the bytes left to parse,
+// along with the number of bits of the first byte that were already consumed.
+// Bits are consumed from the most significant to the least significant one.
+type BitInput[Input gomme.Bytes] struct {
+	Bytes  Input
+	Offset uint
+}
+
+// BitResult is the result of a bit-level parser. It mirrors gomme.Result, but
+// its remaining input is expressed as a BitInput.
+type BitResult[Output any, Input gomme.Bytes] struct {
+	Output    Output
+	Err       *gomme.Error[Input]
+	Remaining BitInput[Input]
+}
+
+// BitParser is a parser operating at the bit level, rather than at the byte
+// level. Bit parsers are turned into regular parsers using Bits, and byte
+// parsers can be used within bit parsers using Bytes.
+type BitParser[Input gomme.Bytes, Output any] func(input BitInput[Input]) BitResult[Output, Input]
+
+// BitSuccess creates a BitResult with an output and the remaining bit input.
+func BitSuccess[Output any, Input gomme.Bytes](output Output, remaining BitInput[Input]) BitResult[Output, Input] {
+	return BitResult[Output, Input]{Output: output, Remaining: remaining}
+}
+
+// BitFailure creates a BitResult with an error and the bit input it occurred at.
+func BitFailure[Input gomme.Bytes, Output any](err *gomme.Error[Input], input BitInput[Input]) BitResult[Output, Input] {
+	var output Output
+	return BitResult[Output, Input]{Output: output, Err: err, Remaining: input}
+}
+
+// Bits switches from byte-level to bit-level parsing: it applies the provided
+// bit parser to the input, and returns its output. Once the bit parser is done,
+// any bits left in a partially consumed byte are discarded, and parsing resumes
+// at the next byte boundary.
+func Bits[Input gomme.Bytes, Output any](parse BitParser[Input, Output]) gomme.Parser[Input, Output] {
+	return func(input Input) gomme.Result[Output, Input] {
+		result := parse(BitInput[Input]{Bytes: input})
+		if result.Err != nil {
+			return gomme.Failure[Input, Output](result.Err, input)
+		}
+
+		return gomme.Success(result.Output, alignBits(result.Remaining))
+	}
+}
+
+// Bytes switches back from bit-level to byte-level parsing within a bit parser:
+// any bits left in a partially consumed byte are discarded, and the provided
+// parser is applied from the next byte boundary.
+func Bytes[Input gomme.Bytes, Output any](parse gomme.Parser[Input, Output]) BitParser[Input, Output] {
+	return func(input BitInput[Input]) BitResult[Output, Input] {
+		result := parse(alignBits(input))
+		if result.Err != nil {
+			return BitFailure[Input, Output](result.Report(), input)
+		}
+
+		return BitSuccess(result.Output, BitInput[Input]{Bytes: result.Remaining})
+	}
+}
+
+// TakeBits parses `count` bits from the input, and returns them as an unsigned
+// integer, the first bit parsed being the most significant one. Up to 64 bits
+// can be parsed at once.
+//
+// If the input doesn't hold enough bits, or if more than 64 bits are requested,
+// the parser returns an error result.
+func TakeBits[Input gomme.Bytes](count uint) BitParser[Input, uint64] {
+	return func(input BitInput[Input]) BitResult[uint64, Input] {
+		if count > 64 || uint(len(input.Bytes))*8-input.Offset < count {
+			return BitFailure[Input, uint64](gomme.NewError(input.Bytes, "TakeBits"), input)
+		}
+
+		var n uint64
+		bytes, offset := input.Bytes, input.Offset
+		for remaining := count; remaining > 0; {
+			available := 8 - offset
+
+			taken := available
+			if remaining < available {
+				taken = remaining
+			}
+
+			bits := uint64(bytes[0]>>(available-taken)) & (1<<taken - 1)
+			n = n<<taken | bits
+
+			remaining -= taken
+			offset += taken
+			if offset == 8 {
+				bytes, offset = bytes[1:], 0
+			}
+		}
+
+		return BitSuccess(n, BitInput[Input]{Bytes: bytes, Offset: offset})
+	}
+}
+
+// BitFlag parses a single bit from the input, and returns whether it is set.
+// If the input holds no more bits, the parser returns an error result.
+func BitFlag[Input gomme.Bytes]() BitParser[Input, bool] {
+	take := TakeBits[Input](1)
+
+	return func(input BitInput[Input]) BitResult[bool, Input] {
+		result := take(input)
+		if result.Err != nil {
+			return BitFailure[Input, bool](gomme.NewError(input.Bytes, "BitFlag"), input)
+		}
+
+		return BitSuccess(result.Output == 1, result.Remaining)
+	}
+}
+
+// alignBits returns the bytes of the provided bit input, starting from the next
+// byte boundary.
+func alignBits[Input gomme.Bytes](input BitInput[Input]) Input {
+	if input.Offset > 0 {
+		return input.Bytes[1:]
+	}
+
+	return input.Bytes
+}
@@ -0,0 +1,357 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+// dnsFlags holds the flags found in the second half of a DNS header's first word.
+type dnsFlags struct {
+	Response bool
+	Opcode   uint64
+	Rest     uint64
+}
+
+// parseDNSFlags is a bit parser, as users of the package would write one, that
+// sequences multiple bit parsers to decode a DNS header's flags.
+func parseDNSFlags(input BitInput[[]byte]) BitResult[dnsFlags, []byte] {
+	response := BitFlag[[]byte]()(input)
+	if response.Err != nil {
+		return BitFailure[[]byte, dnsFlags](response.Err, input)
+	}
+
+	opcode := TakeBits[[]byte](4)(response.Remaining)
+	if opcode.Err != nil {
+		return BitFailure[[]byte, dnsFlags](opcode.Err, input)
+	}
+
+	rest := TakeBits[[]byte](11)(opcode.Remaining)
+	if rest.Err != nil {
+		return BitFailure[[]byte, dnsFlags](rest.Err, input)
+	}
+
+	return BitSuccess(dnsFlags{response.Output, opcode.Output, rest.Output}, rest.Remaining)
+}
+
+func TestBits(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, dnsFlags]
+		input         []byte
+		wantErr       bool
+		wantOutput    dnsFlags
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing whole bytes of bits should succeed",
+			parser:        Bits(parseDNSFlags),
+			input:         []byte{0b1001_0001, 0b1000_0000, 0xAA},
+			wantErr:       false,
+			wantOutput:    dnsFlags{Response: true, Opcode: 0b0010, Rest: 0b001_1000_0000},
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name: "parsing a partial byte of bits should skip its remaining bits",
+			parser: Bits(BitParser[[]byte, dnsFlags](func(input BitInput[[]byte]) BitResult[dnsFlags, []byte] {
+				result := TakeBits[[]byte](3)(input)
+				return BitResult[dnsFlags, []byte]{Output: dnsFlags{Opcode: result.Output}, Err: result.Err, Remaining: result.Remaining}
+			})),
+			input:         []byte{0b1010_0000, 0xAA},
+			wantErr:       false,
+			wantOutput:    dnsFlags{Opcode: 0b101},
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing too few bits should fail",
+			parser:        Bits(parseDNSFlags),
+			input:         []byte{0b1001_0001},
+			wantErr:       true,
+			wantOutput:    dnsFlags{},
+			wantRemaining: []byte{0b1001_0001},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+		})
+	}
+}
+
+func BenchmarkBits(b *testing.B) {
+	parser := Bits(parseDNSFlags)
+	input := []byte{0b1001_0001, 0b1000_0000, 0xAA}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(input)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        BitParser[[]byte, uint16]
+		input         BitInput[[]byte]
+		wantErr       bool
+		wantOutput    uint16
+		wantRemaining BitInput[[]byte]
+	}{
+		{
+			name:          "parsing from a byte boundary should succeed",
+			parser:        Bytes(BEUint16[[]byte]()),
+			input:         BitInput[[]byte]{Bytes: []byte{0x01, 0x02, 0xAA}},
+			wantErr:       false,
+			wantOutput:    0x0102,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0xAA}},
+		},
+		{
+			name:          "parsing from within a byte should skip its remaining bits",
+			parser:        Bytes(BEUint16[[]byte]()),
+			input:         BitInput[[]byte]{Bytes: []byte{0xFF, 0x01, 0x02}, Offset: 4},
+			wantErr:       false,
+			wantOutput:    0x0102,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{}},
+		},
+		{
+			name:          "parsing too few bytes should fail",
+			parser:        Bytes(BEUint16[[]byte]()),
+			input:         BitInput[[]byte]{Bytes: []byte{0xFF, 0x01}, Offset: 4},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0xFF, 0x01}, Offset: 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+		})
+	}
+}
+
+func BenchmarkBytes(b *testing.B) {
+	parser := Bytes(BEUint16[[]byte]())
+	input := BitInput[[]byte]{Bytes: []byte{0xFF, 0x01, 0x02}, Offset: 4}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(input)
+	}
+}
+
+func TestTakeBits(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        BitParser[[]byte, uint64]
+		input         BitInput[[]byte]
+		wantErr       bool
+		wantOutput    uint64
+		wantRemaining BitInput[[]byte]
+	}{
+		{
+			name:          "parsing bits within a byte should succeed",
+			parser:        TakeBits[[]byte](3),
+			input:         BitInput[[]byte]{Bytes: []byte{0b1010_1100}},
+			wantErr:       false,
+			wantOutput:    0b101,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0b1010_1100}, Offset: 3},
+		},
+		{
+			name:          "parsing bits from an offset should succeed",
+			parser:        TakeBits[[]byte](4),
+			input:         BitInput[[]byte]{Bytes: []byte{0b1010_1100}, Offset: 3},
+			wantErr:       false,
+			wantOutput:    0b0110,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0b1010_1100}, Offset: 7},
+		},
+		{
+			name:          "parsing bits across bytes should succeed",
+			parser:        TakeBits[[]byte](6),
+			input:         BitInput[[]byte]{Bytes: []byte{0b1010_1100, 0b0111_0000}, Offset: 5},
+			wantErr:       false,
+			wantOutput:    0b100011,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0b0111_0000}, Offset: 3},
+		},
+		{
+			name:          "parsing the remaining bits of a byte should move to the next one",
+			parser:        TakeBits[[]byte](5),
+			input:         BitInput[[]byte]{Bytes: []byte{0b1010_1100, 0xFF}, Offset: 3},
+			wantErr:       false,
+			wantOutput:    0b01100,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0xFF}},
+		},
+		{
+			name:          "parsing 64 bits should succeed",
+			parser:        TakeBits[[]byte](64),
+			input:         BitInput[[]byte]{Bytes: []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}},
+			wantErr:       false,
+			wantOutput:    0x0102030405060708,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{}},
+		},
+		{
+			name:          "parsing zero bits should succeed",
+			parser:        TakeBits[[]byte](0),
+			input:         BitInput[[]byte]{Bytes: []byte{}},
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{}},
+		},
+		{
+			name:          "parsing more bits than available should fail",
+			parser:        TakeBits[[]byte](6),
+			input:         BitInput[[]byte]{Bytes: []byte{0xFF}, Offset: 3},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0xFF}, Offset: 3},
+		},
+		{
+			name:          "parsing more than 64 bits should fail",
+			parser:        TakeBits[[]byte](65),
+			input:         BitInput[[]byte]{Bytes: make([]byte, 9)},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: BitInput[[]byte]{Bytes: make([]byte, 9)},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkTakeBits(b *testing.B) {
+	parser := TakeBits[[]byte](6)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(BitInput[[]byte]{Bytes: []byte{0b1010_1100, 0b0111_0000}, Offset: 5})
+	}
+}
+
+func TestBitFlag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        BitParser[[]byte, bool]
+		input         BitInput[[]byte]
+		wantErr       bool
+		wantOutput    bool
+		wantRemaining BitInput[[]byte]
+	}{
+		{
+			name:          "parsing a set bit should succeed",
+			parser:        BitFlag[[]byte](),
+			input:         BitInput[[]byte]{Bytes: []byte{0b1000_0000}},
+			wantErr:       false,
+			wantOutput:    true,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0b1000_0000}, Offset: 1},
+		},
+		{
+			name:          "parsing an unset bit should succeed",
+			parser:        BitFlag[[]byte](),
+			input:         BitInput[[]byte]{Bytes: []byte{0b1000_0000}, Offset: 1},
+			wantErr:       false,
+			wantOutput:    false,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0b1000_0000}, Offset: 2},
+		},
+		{
+			name:          "parsing the last bit of a byte should move to the next one",
+			parser:        BitFlag[[]byte](),
+			input:         BitInput[[]byte]{Bytes: []byte{0b0000_0001, 0xFF}, Offset: 7},
+			wantErr:       false,
+			wantOutput:    true,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{0xFF}},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BitFlag[[]byte](),
+			input:         BitInput[[]byte]{Bytes: []byte{}},
+			wantErr:       true,
+			wantOutput:    false,
+			wantRemaining: BitInput[[]byte]{Bytes: []byte{}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkBitFlag(b *testing.B) {
+	parser := BitFlag[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(BitInput[[]byte]{Bytes: []byte{0b1000_0000}})
+	}
+}
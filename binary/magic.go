@@ -0,0 +1,36 @@
+package binary
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/oleiade/gomme"
+)
+
+// Magic parses the exact byte signature, or magic number, identifying files of
+// the provided format, such as PNG or ELF, from the input, and returns the part
+// of the input that matched it. It is the binary counterpart of gomme.Token.
+//
+// If the input doesn't start with the signature, the parser returns an error
+// result naming the format and its expected signature, for instance "expected
+// PNG magic bytes 89 50 4E 47 0D 0A 1A 0A".
+func Magic[Input gomme.Bytes](format string, signature []byte) gomme.Parser[Input, Input] {
+	expected := fmt.Sprintf("%s magic bytes % X", format, signature)
+	if format == "" {
+		expected = strings.TrimPrefix(expected, " ")
+	}
+
+	return func(input Input) gomme.Result[Input, Input] {
+		if len(input) < len(signature) {
+			return gomme.Failure[Input, Input](gomme.NewError(input, expected), input)
+		}
+
+		for idx := range signature {
+			if input[idx] != signature[idx] {
+				return gomme.Failure[Input, Input](gomme.NewError(input, expected), input)
+			}
+		}
+
+		return gomme.Success(input[:len(signature)], input[len(signature):])
+	}
+}
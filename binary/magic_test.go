@@ -0,0 +1,97 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+var pngSignature = []byte{0x89, 'P', 'N', 'G', 0x0D, 0x0A, 0x1A, 0x0A}
+
+func TestMagic(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, []byte]
+		input         []byte
+		wantErr       bool
+		wantOutput    []byte
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing a matching signature should succeed",
+			parser:        Magic[[]byte]("PNG", pngSignature),
+			input:         []byte{0x89, 'P', 'N', 'G', 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00},
+			wantErr:       false,
+			wantOutput:    pngSignature,
+			wantRemaining: []byte{0x00, 0x00},
+		},
+		{
+			name:          "parsing a mismatching signature should fail",
+			parser:        Magic[[]byte]("PNG", pngSignature),
+			input:         []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10, 0x4A, 0x46, 0x49, 0x46},
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: []byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10, 0x4A, 0x46, 0x49, 0x46},
+		},
+		{
+			name:          "parsing a truncated signature should fail",
+			parser:        Magic[[]byte]("PNG", pngSignature),
+			input:         []byte{0x89, 'P', 'N', 'G'},
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: []byte{0x89, 'P', 'N', 'G'},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Magic[[]byte]("PNG", pngSignature),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkMagic(b *testing.B) {
+	parser := Magic[[]byte]("PNG", pngSignature)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0x89, 'P', 'N', 'G', 0x0D, 0x0A, 0x1A, 0x0A, 0x00, 0x00})
+	}
+}
+
+func TestMagicError(t *testing.T) {
+	t.Parallel()
+
+	result := Magic[[]byte]("PNG", pngSignature)([]byte("GIF89a"))
+
+	assert.EqualError(t, result.Err, "expected PNG magic bytes 89 50 4E 47 0D 0A 1A 0A")
+}
@@ -0,0 +1,76 @@
+package binary
+
+import "github.com/oleiade/gomme"
+
+// CString parses a null-terminated string, as found in C structures and many
+// binary formats, from the input. It consumes the bytes up to, and including,
+// the first NUL byte, and returns the ones preceding it as a string.
+//
+// If the input holds no NUL byte, the parser returns an error result.
+func CString[Input gomme.Bytes]() gomme.Parser[Input, string] {
+	return cString[Input](-1, "CString")
+}
+
+// CStringMax parses a null-terminated string of at most maxLength bytes, not
+// counting the NUL terminator, from the input. It consumes the bytes up to, and
+// including, the first NUL byte, and returns the ones preceding it as a string.
+//
+// If no NUL byte is found within the first maxLength+1 bytes of the input, the
+// parser returns an error result. Malicious inputs thus can't make it scan an
+// unbounded amount of data.
+func CStringMax[Input gomme.Bytes](maxLength int) gomme.Parser[Input, string] {
+	if maxLength < 0 {
+		maxLength = 0
+	}
+
+	return cString[Input](maxLength, "CStringMax")
+}
+
+// cString implements the null-terminated string parsers. A negative maxLength
+// leaves the string's length unbounded.
+func cString[Input gomme.Bytes](maxLength int, name string) gomme.Parser[Input, string] {
+	return func(input Input) gomme.Result[string, Input] {
+		limit := len(input)
+		if maxLength >= 0 && maxLength < limit {
+			limit = maxLength + 1
+		}
+
+		for idx := 0; idx < limit; idx++ {
+			if input[idx] == 0 {
+				return gomme.Success(string(input[:idx]), input[idx+1:])
+			}
+		}
+
+		return gomme.Failure[Input, string](gomme.NewError(input, name), input)
+	}
+}
+
+// PrefixedString parses a length-prefixed string, as Pascal strings and many
+// binary protocols lay them out, from the input. The string's length is read
+// as an unsigned integer spanning width bytes, in the provided byte order, and
+// is followed by that many bytes, which the parser returns as a string.
+//
+// The width must be one of 1, 2, 4 or 8, otherwise the parser always returns an
+// error result. If the input holds fewer bytes than the prefix announces, the
+// parser returns an error result.
+func PrefixedString[Input gomme.Bytes](width int, endianness Endianness) gomme.Parser[Input, string] {
+	return func(input Input) gomme.Result[string, Input] {
+		if !validPrefixWidth(width) || len(input) < width {
+			return gomme.Failure[Input, string](gomme.NewError(input, "PrefixedString"), input)
+		}
+
+		length := decode(input[:width], endianness)
+		if length > uint64(len(input)-width) {
+			return gomme.Failure[Input, string](gomme.NewError(input, "PrefixedString"), input)
+		}
+
+		end := width + int(length)
+
+		return gomme.Success(string(input[width:end]), input[end:])
+	}
+}
+
+// validPrefixWidth returns whether a length prefix can span width bytes.
+func validPrefixWidth(width int) bool {
+	return width == 1 || width == 2 || width == 4 || width == 8
+}
@@ -0,0 +1,309 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, string]
+		input         []byte
+		wantErr       bool
+		wantOutput    string
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing a null-terminated string should succeed",
+			parser:        CString[[]byte](),
+			input:         []byte("abc\x00def"),
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: []byte("def"),
+		},
+		{
+			name:          "parsing an empty null-terminated string should succeed",
+			parser:        CString[[]byte](),
+			input:         []byte{0x00, 0xAA},
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing up to the first terminator should succeed",
+			parser:        CString[[]byte](),
+			input:         []byte("ab\x00c\x00"),
+			wantErr:       false,
+			wantOutput:    "ab",
+			wantRemaining: []byte("c\x00"),
+		},
+		{
+			name:          "parsing an unterminated string should fail",
+			parser:        CString[[]byte](),
+			input:         []byte("abc"),
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte("abc"),
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CString[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkCString(b *testing.B) {
+	parser := CString[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte("abc\x00def"))
+	}
+}
+
+func TestCStringMax(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, string]
+		input         []byte
+		wantErr       bool
+		wantOutput    string
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing a string shorter than the maximum length should succeed",
+			parser:        CStringMax[[]byte](4),
+			input:         []byte("abc\x00def"),
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: []byte("def"),
+		},
+		{
+			name:          "parsing a string of the maximum length should succeed",
+			parser:        CStringMax[[]byte](3),
+			input:         []byte("abc\x00def"),
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: []byte("def"),
+		},
+		{
+			name:          "parsing a string longer than the maximum length should fail",
+			parser:        CStringMax[[]byte](2),
+			input:         []byte("abc\x00def"),
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte("abc\x00def"),
+		},
+		{
+			name:          "parsing an empty string with a zero maximum length should succeed",
+			parser:        CStringMax[[]byte](0),
+			input:         []byte{0x00, 0xAA},
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing an unterminated string should fail",
+			parser:        CStringMax[[]byte](8),
+			input:         []byte("abc"),
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte("abc"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkCStringMax(b *testing.B) {
+	parser := CStringMax[[]byte](4)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte("abc\x00def"))
+	}
+}
+
+func TestPrefixedString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, string]
+		input         []byte
+		wantErr       bool
+		wantOutput    string
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing a one byte prefixed string should succeed",
+			parser:        PrefixedString[[]byte](1, BigEndian),
+			input:         []byte("\x03abcdef"),
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: []byte("def"),
+		},
+		{
+			name:          "parsing a big-endian two bytes prefixed string should succeed",
+			parser:        PrefixedString[[]byte](2, BigEndian),
+			input:         []byte("\x00\x03abcdef"),
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: []byte("def"),
+		},
+		{
+			name:          "parsing a little-endian two bytes prefixed string should succeed",
+			parser:        PrefixedString[[]byte](2, LittleEndian),
+			input:         []byte("\x03\x00abcdef"),
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: []byte("def"),
+		},
+		{
+			name:          "parsing a big-endian four bytes prefixed string should succeed",
+			parser:        PrefixedString[[]byte](4, BigEndian),
+			input:         []byte("\x00\x00\x00\x03abcdef"),
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: []byte("def"),
+		},
+		{
+			name:          "parsing a little-endian eight bytes prefixed string should succeed",
+			parser:        PrefixedString[[]byte](8, LittleEndian),
+			input:         []byte("\x03\x00\x00\x00\x00\x00\x00\x00abcdef"),
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: []byte("def"),
+		},
+		{
+			name:          "parsing an empty prefixed string should succeed",
+			parser:        PrefixedString[[]byte](1, BigEndian),
+			input:         []byte("\x00abc"),
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: []byte("abc"),
+		},
+		{
+			name:          "parsing a string shorter than its prefix announces should fail",
+			parser:        PrefixedString[[]byte](1, BigEndian),
+			input:         []byte("\x04abc"),
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte("\x04abc"),
+		},
+		{
+			name:          "parsing a huge length prefix should fail",
+			parser:        PrefixedString[[]byte](8, BigEndian),
+			input:         []byte("\xFF\xFF\xFF\xFF\xFF\xFF\xFF\xFFabc"),
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte("\xFF\xFF\xFF\xFF\xFF\xFF\xFF\xFFabc"),
+		},
+		{
+			name:          "parsing a truncated prefix should fail",
+			parser:        PrefixedString[[]byte](2, BigEndian),
+			input:         []byte{0x00},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte{0x00},
+		},
+		{
+			name:          "parsing with an invalid prefix width should fail",
+			parser:        PrefixedString[[]byte](3, BigEndian),
+			input:         []byte("\x00\x00\x03abc"),
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte("\x00\x00\x03abc"),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkPrefixedString(b *testing.B) {
+	parser := PrefixedString[[]byte](2, BigEndian)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte("\x00\x03abcdef"))
+	}
+}
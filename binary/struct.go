@@ -0,0 +1,245 @@
+package binary
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/oleiade/gomme"
+)
+
+// DecodeStruct derives a parser decoding fixed-layout records, such as file and
+// protocol headers, into values of the struct type T. The struct's fields are
+// decoded in order, each one from the bytes immediately following the previous
+// one's, with no implicit padding.
+//
+// The supported field types are booleans, which span a single byte and are true
+// unless zero, integers, which span as many bytes as their size, floats, which
+// are decoded from their IEEE 754 representation, as well as arrays and structs
+// of those. Fields named _ are skipped over, as padding.
+//
+// The layout of a field can be adjusted using a comma separated list of options
+// in its `binary` struct tag:
+//   - "be" and "le" select the big-endian, the default, or little-endian byte
+//     order. When set on a struct or array field, it applies to its elements.
+//   - "width=N" decodes an integer field, or the elements of an array of
+//     integers, from N bytes instead of their size. Signed integers are sign
+//     extended.
+//   - "skip=N" skips over N bytes of the input before decoding the field.
+//   - "-" ignores the field, leaving it set to its zero value.
+//
+// As it returns a regular parser, DecodeStruct's output composes with the other
+// parsers, which can take care of the variable-length parts of a record.
+//
+// If the input is shorter than the record, the parser returns an error result.
+// If T isn't a struct, or holds a field which can't be decoded, such as an
+// unexported or a pointer one, the parser always returns a fatal error result.
+func DecodeStruct[Input gomme.Bytes, T any]() gomme.Parser[Input, T] {
+	size, decode, err := structLayout[Input](reflect.TypeOf((*T)(nil)).Elem())
+
+	return func(input Input) gomme.Result[T, Input] {
+		if err != nil {
+			return gomme.Failure[Input, T](&gomme.Error[Input]{Input: input, Err: err, Expected: []string{"DecodeStruct"}}, input)
+		}
+
+		if len(input) < size {
+			return gomme.Failure[Input, T](gomme.NewError(input, "DecodeStruct"), input)
+		}
+
+		var output T
+		decode(reflect.ValueOf(&output).Elem(), input[:size])
+
+		return gomme.Success(output, input[size:])
+	}
+}
+
+// fieldDecoder decodes the provided input, which spans exactly the size of the
+// destination's layout, into the destination value.
+type fieldDecoder[Input gomme.Bytes] func(dst reflect.Value, input Input)
+
+// fieldOptions holds the layout options of a field, as set by its struct tag.
+type fieldOptions struct {
+	endianness Endianness
+	width      int
+	skip       int
+	ignore     bool
+}
+
+// parseFieldOptions parses the `binary` struct tag of a field, whose options
+// default to the ones of the enclosing struct.
+func parseFieldOptions(tag string, defaults fieldOptions) (fieldOptions, error) {
+	options := fieldOptions{endianness: defaults.endianness}
+	if tag == "" {
+		return options, nil
+	}
+
+	for _, option := range strings.Split(tag, ",") {
+		name, value, hasValue := strings.Cut(strings.TrimSpace(option), "=")
+
+		switch {
+		case name == "-" && !hasValue:
+			options.ignore = true
+		case name == "be" && !hasValue:
+			options.endianness = BigEndian
+		case name == "le" && !hasValue:
+			options.endianness = LittleEndian
+		case name == "width" && hasValue:
+			width, err := strconv.Atoi(value)
+			if err != nil || width < 1 {
+				return options, fmt.Errorf("invalid width %q", value)
+			}
+
+			options.width = width
+		case name == "skip" && hasValue:
+			skip, err := strconv.Atoi(value)
+			if err != nil || skip < 0 {
+				return options, fmt.Errorf("invalid skip %q", value)
+			}
+
+			options.skip = skip
+		default:
+			return options, fmt.Errorf("unknown option %q", option)
+		}
+	}
+
+	return options, nil
+}
+
+// structLayout computes the size of the struct type t's layout, and returns it
+// along with a decoder for it.
+func structLayout[Input gomme.Bytes](t reflect.Type) (int, fieldDecoder[Input], error) {
+	if t.Kind() != reflect.Struct {
+		return 0, nil, fmt.Errorf("binary: cannot decode non-struct type %s", t)
+	}
+
+	size, decode, err := typeLayout[Input](t, fieldOptions{endianness: BigEndian})
+	if err != nil {
+		return 0, nil, fmt.Errorf("binary: %w", err)
+	}
+
+	return size, decode, nil
+}
+
+// typeLayout computes the size of the type t's layout, as adjusted by the
+// provided options, and returns it along with a decoder for it.
+func typeLayout[Input gomme.Bytes](t reflect.Type, options fieldOptions) (int, fieldDecoder[Input], error) {
+	if options.width != 0 && !isIntegerKind(t.Kind()) && t.Kind() != reflect.Array {
+		return 0, nil, fmt.Errorf("cannot set the width of non-integer type %s", t)
+	}
+
+	switch kind := t.Kind(); kind {
+	case reflect.Bool:
+		return 1, func(dst reflect.Value, input Input) {
+			dst.SetBool(input[0] != 0)
+		}, nil
+
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		width := int(t.Size())
+		if options.width > width {
+			return 0, nil, fmt.Errorf("cannot decode %d bytes into type %s", options.width, t)
+		} else if options.width != 0 {
+			width = options.width
+		}
+
+		endianness := options.endianness
+		if kind >= reflect.Uint8 {
+			return width, func(dst reflect.Value, input Input) {
+				dst.SetUint(decode(input, endianness))
+			}, nil
+		}
+
+		shift := 64 - 8*width
+		return width, func(dst reflect.Value, input Input) {
+			dst.SetInt(int64(decode(input, endianness)<<shift) >> shift)
+		}, nil
+
+	case reflect.Float32:
+		endianness := options.endianness
+		return 4, func(dst reflect.Value, input Input) {
+			dst.SetFloat(float64(math.Float32frombits(uint32(decode(input, endianness)))))
+		}, nil
+
+	case reflect.Float64:
+		endianness := options.endianness
+		return 8, func(dst reflect.Value, input Input) {
+			dst.SetFloat(math.Float64frombits(decode(input, endianness)))
+		}, nil
+
+	case reflect.Array:
+		elemSize, elemDecode, err := typeLayout[Input](t.Elem(), options)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		length := t.Len()
+		return elemSize * length, func(dst reflect.Value, input Input) {
+			for idx := 0; idx < length; idx++ {
+				elemDecode(dst.Index(idx), input[idx*elemSize:(idx+1)*elemSize])
+			}
+		}, nil
+
+	case reflect.Struct:
+		return fieldsLayout[Input](t, options)
+
+	default:
+		return 0, nil, fmt.Errorf("cannot decode type %s", t)
+	}
+}
+
+// fieldsLayout computes the size of the struct type t's layout, whose fields
+// default to the provided options, and returns it along with a decoder for it.
+func fieldsLayout[Input gomme.Bytes](t reflect.Type, defaults fieldOptions) (int, fieldDecoder[Input], error) {
+	type decodedField struct {
+		index  int
+		offset int
+		size   int
+		decode fieldDecoder[Input]
+	}
+
+	var fields []decodedField
+	size := 0
+
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+
+		options, err := parseFieldOptions(field.Tag.Get("binary"), defaults)
+		if err != nil {
+			return 0, nil, fmt.Errorf("field %s.%s: %w", t, field.Name, err)
+		}
+
+		if options.ignore {
+			continue
+		}
+
+		size += options.skip
+
+		fieldSize, fieldDecode, err := typeLayout[Input](field.Type, options)
+		if err != nil {
+			return 0, nil, fmt.Errorf("field %s.%s: %w", t, field.Name, err)
+		}
+
+		if field.Name != "_" {
+			if !field.IsExported() {
+				return 0, nil, fmt.Errorf("cannot decode unexported field %s.%s", t, field.Name)
+			}
+
+			fields = append(fields, decodedField{index: idx, offset: size, size: fieldSize, decode: fieldDecode})
+		}
+
+		size += fieldSize
+	}
+
+	return size, func(dst reflect.Value, input Input) {
+		for _, field := range fields {
+			field.decode(dst.Field(field.index), input[field.offset:field.offset+field.size])
+		}
+	}, nil
+}
+
+// isIntegerKind returns whether kind is one of the fixed-size integer kinds.
+func isIntegerKind(kind reflect.Kind) bool {
+	return (kind >= reflect.Int8 && kind <= reflect.Int64) || (kind >= reflect.Uint8 && kind <= reflect.Uint64)
+}
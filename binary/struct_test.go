@@ -0,0 +1,171 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+type testHeader struct {
+	Magic   uint16
+	Version uint8
+	_       [1]byte
+	Flags   bool
+	Offset  int32       `binary:"width=3"`
+	Counts  [3]uint16   `binary:"le"`
+	Ignored int         `binary:"-"`
+	Trailer testTrailer `binary:"skip=1"`
+	Sign    int16       `binary:"width=1"`
+}
+
+type testTrailer struct {
+	Length uint16 `binary:"le"`
+	Ratio  float32
+}
+
+func TestDecodeStruct(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, testHeader]
+		input         []byte
+		wantErr       bool
+		wantOutput    testHeader
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing a record should succeed",
+			parser:        DecodeStruct[[]byte, testHeader](),
+			input:         []byte{0xCA, 0xFE, 0x02, 0x00, 0x01, 0xFF, 0xFF, 0xFE, 0x01, 0x02, 0x00, 0x03, 0x04, 0x00, 0xAA, 0x04, 0x00, 0x3F, 0x80, 0x00, 0x00, 0x80, 0xBB},
+			wantErr:       false,
+			wantOutput:    testHeader{Magic: 0xCAFE, Version: 2, Flags: true, Offset: -2, Counts: [3]uint16{0x0201, 0x0300, 0x0004}, Trailer: testTrailer{Length: 4, Ratio: 1}, Sign: -128},
+			wantRemaining: []byte{0xBB},
+		},
+		{
+			name:          "parsing a truncated record should fail",
+			parser:        DecodeStruct[[]byte, testHeader](),
+			input:         []byte{0xCA, 0xFE, 0x02},
+			wantErr:       true,
+			wantOutput:    testHeader{},
+			wantRemaining: []byte{0xCA, 0xFE, 0x02},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        DecodeStruct[[]byte, testHeader](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    testHeader{},
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkDecodeStruct(b *testing.B) {
+	parser := DecodeStruct[[]byte, testHeader]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xCA, 0xFE, 0x02, 0x00, 0x01, 0xFF, 0xFF, 0xFE, 0x01, 0x02, 0x00, 0x03, 0x04, 0x00, 0xAA, 0x04, 0x00, 0x3F, 0x80, 0x00, 0x00, 0x80, 0xBB})
+	}
+}
+
+func TestDecodeStructInvalidLayout(t *testing.T) {
+	t.Parallel()
+
+	input := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+
+	testCases := []struct {
+		name    string
+		gotErr  *gomme.Error[[]byte]
+		wantErr string
+	}{
+		{
+			name:    "decoding a non-struct type should fail",
+			gotErr:  DecodeStruct[[]byte, uint32]()(input).Err,
+			wantErr: "expected DecodeStruct: binary: cannot decode non-struct type uint32",
+		},
+		{
+			name: "decoding an unexported field should fail",
+			gotErr: DecodeStruct[[]byte, struct {
+				length uint8
+			}]()(input).Err,
+			wantErr: "expected DecodeStruct: binary: cannot decode unexported field struct { length uint8 }.length",
+		},
+		{
+			name: "decoding an unsupported field type should fail",
+			gotErr: DecodeStruct[[]byte, struct {
+				Length *uint8
+			}]()(input).Err,
+			wantErr: "expected DecodeStruct: binary: field struct { Length *uint8 }.Length: cannot decode type *uint8",
+		},
+		{
+			name: "decoding a field into too few bytes should fail",
+			gotErr: DecodeStruct[[]byte, struct {
+				Length uint8 `binary:"width=2"`
+			}]()(input).Err,
+			wantErr: "expected DecodeStruct: binary: field struct { Length uint8 \"binary:\\\"width=2\\\"\" }.Length: cannot decode 2 bytes into type uint8",
+		},
+		{
+			name: "decoding a field with an unknown option should fail",
+			gotErr: DecodeStruct[[]byte, struct {
+				Length uint8 `binary:"middle"`
+			}]()(input).Err,
+			wantErr: "expected DecodeStruct: binary: field struct { Length uint8 \"binary:\\\"middle\\\"\" }.Length: unknown option \"middle\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.True(t, tc.gotErr.IsFatal())
+			assert.EqualError(t, tc.gotErr, tc.wantErr)
+		})
+	}
+}
+
+func TestDecodeStructComposition(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		Kind    uint8
+		Version uint8
+	}
+
+	parser := gomme.Pair(DecodeStruct[[]byte, record](), PrefixedString[[]byte](1, BigEndian))
+
+	result := parser([]byte{0x01, 0x02, 0x03, 'a', 'b', 'c', 0xAA})
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, record{Kind: 1, Version: 2}, result.Output.Left)
+	assert.Equal(t, "abc", result.Output.Right)
+	assert.Equal(t, []byte{0xAA}, result.Remaining)
+}
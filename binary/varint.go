@@ -0,0 +1,70 @@
+package binary
+
+import "github.com/oleiade/gomme"
+
+// maxVarintLen64 is the maximum number of bytes a 64 bits varint can span.
+const maxVarintLen64 = 10
+
+// Uvarint parses an unsigned varint, as used by protocol buffers and LEB128,
+// from the input into a uint64. Each byte holds 7 bits of the value, least
+// significant group first, and has its most significant bit set as long as
+// more bytes follow.
+//
+// If the input ends before the varint does, or if the varint spans more than
+// 10 bytes, or overflows 64 bits, the parser returns an error result. Malicious
+// inputs thus can't make it read an unbounded amount of data.
+func Uvarint[Input gomme.Bytes]() gomme.Parser[Input, uint64] {
+	return func(input Input) gomme.Result[uint64, Input] {
+		n, length := uvarint(input)
+		if length == 0 {
+			return gomme.Failure[Input, uint64](gomme.NewError(input, "Uvarint"), input)
+		}
+
+		return gomme.Success(n, input[length:])
+	}
+}
+
+// Varint parses a signed varint from the input into an int64. The value is
+// expected to be zigzag encoded, as protocol buffers' sint64 values are: small
+// negative numbers are mapped to small unsigned ones, so that they are encoded
+// using few bytes.
+//
+// If the input ends before the varint does, or if the varint spans more than
+// 10 bytes, or overflows 64 bits, the parser returns an error result.
+func Varint[Input gomme.Bytes]() gomme.Parser[Input, int64] {
+	return func(input Input) gomme.Result[int64, Input] {
+		n, length := uvarint(input)
+		if length == 0 {
+			return gomme.Failure[Input, int64](gomme.NewError(input, "Varint"), input)
+		}
+
+		value := int64(n >> 1)
+		if n&1 != 0 {
+			value = ^value
+		}
+
+		return gomme.Success(value, input[length:])
+	}
+}
+
+// uvarint decodes the unsigned varint found at the beginning of the input, and
+// returns its value, along with the number of bytes it spans. If the input holds
+// no valid varint, the returned length is zero.
+func uvarint[Input gomme.Bytes](input Input) (uint64, int) {
+	var n uint64
+	for idx := 0; idx < len(input) && idx < maxVarintLen64; idx++ {
+		b := input[idx]
+
+		// The last byte of a 64 bits varint can only hold a single bit.
+		if idx == maxVarintLen64-1 && b > 1 {
+			return 0, 0
+		}
+
+		n |= uint64(b&0x7f) << (7 * idx)
+		if b < 0x80 {
+			return n, idx + 1
+		}
+	}
+
+	return 0, 0
+}
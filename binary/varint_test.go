@@ -0,0 +1,215 @@
+package binary
+
+import (
+	"math"
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUvarint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, uint64]
+		input         []byte
+		wantErr       bool
+		wantOutput    uint64
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing a single byte varint should succeed",
+			parser:        Uvarint[[]byte](),
+			input:         []byte{0x01, 0xAA},
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing a multi byte varint should succeed",
+			parser:        Uvarint[[]byte](),
+			input:         []byte{0xAC, 0x02},
+			wantErr:       false,
+			wantOutput:    300,
+			wantRemaining: []byte{},
+		},
+		{
+			name:          "parsing the largest varint should succeed",
+			parser:        Uvarint[[]byte](),
+			input:         []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01},
+			wantErr:       false,
+			wantOutput:    math.MaxUint64,
+			wantRemaining: []byte{},
+		},
+		{
+			name:          "parsing a truncated varint should fail",
+			parser:        Uvarint[[]byte](),
+			input:         []byte{0xAC},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0xAC},
+		},
+		{
+			name:          "parsing an overflowing varint should fail",
+			parser:        Uvarint[[]byte](),
+			input:         []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x02},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x02},
+		},
+		{
+			name:          "parsing a varint longer than 10 bytes should fail",
+			parser:        Uvarint[[]byte](),
+			input:         []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x00},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Uvarint[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkUvarint(b *testing.B) {
+	parser := Uvarint[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xAC, 0x02})
+	}
+}
+
+func TestVarint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[[]byte, int64]
+		input         []byte
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing zero should succeed",
+			parser:        Varint[[]byte](),
+			input:         []byte{0x00, 0xAA},
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: []byte{0xAA},
+		},
+		{
+			name:          "parsing minus one should succeed",
+			parser:        Varint[[]byte](),
+			input:         []byte{0x01},
+			wantErr:       false,
+			wantOutput:    -1,
+			wantRemaining: []byte{},
+		},
+		{
+			name:          "parsing one should succeed",
+			parser:        Varint[[]byte](),
+			input:         []byte{0x02},
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: []byte{},
+		},
+		{
+			name:          "parsing a multi byte negative varint should succeed",
+			parser:        Varint[[]byte](),
+			input:         []byte{0xD7, 0x04},
+			wantErr:       false,
+			wantOutput:    -300,
+			wantRemaining: []byte{},
+		},
+		{
+			name:          "parsing the smallest varint should succeed",
+			parser:        Varint[[]byte](),
+			input:         []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x01},
+			wantErr:       false,
+			wantOutput:    math.MinInt64,
+			wantRemaining: []byte{},
+		},
+		{
+			name:          "parsing a truncated varint should fail",
+			parser:        Varint[[]byte](),
+			input:         []byte{0xD7},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{0xD7},
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Varint[[]byte](),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			assert.Equal(t,
+				tc.wantRemaining,
+				gotResult.Remaining,
+				"got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining,
+			)
+		})
+	}
+}
+
+func BenchmarkVarint(b *testing.B) {
+	parser := Varint[[]byte]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser([]byte{0xD7, 0x04})
+	}
+}
@@ -4,15 +4,115 @@ package gomme
 // succeeds.
 //
 // If none of the parsers succeed, this combinator produces an error Result.
+// If one of them fails with a fatal error, the remaining ones are not tried,
+// and the fatal error is returned as is.
 func Alternative[Input Bytes, Output any](parsers ...Parser[Input, Output]) Parser[Input, Output] {
+	failure := newSharedError[Input]("Alternative")
+
 	return func(input Input) Result[Output, Input] {
 		for _, parse := range parsers {
 			result := parse(input)
 			if result.Err == nil {
 				return result
 			}
+
+			// A fatal error means the input is invalid, rather than not
+			// matching the parser; no other alternative should be tried.
+			if result.Err.IsFatal() {
+				return Failure[Input, Output](result.Err, input)
+			}
+		}
+
+		return Failure[Input, Output](failure, input)
+	}
+}
+
+// AlternativeParallel behaves like Alternative, but evaluates the provided
+// parsers concurrently, each in its own goroutine. It is meant for expensive and
+// independent branches, such as several large sub-grammars tried over the same
+// input: cheap parsers are faster evaluated in sequence by Alternative.
+//
+// At most maxConcurrency parsers are evaluated at once; if it is zero or
+// negative, all of them are. The results are examined in the order the parsers
+// were provided in, so that the outcome is deterministic, and identical to
+// Alternative's: the first parser to succeed, in that order, is the one whose
+// Result is returned, even if another one completed sooner. Once the outcome is
+// known, parsers which weren't started yet are not started at all.
+//
+// The provided parsers must be safe for concurrent use.
+func AlternativeParallel[Input Bytes, Output any](maxConcurrency int, parsers ...Parser[Input, Output]) Parser[Input, Output] {
+	return func(input Input) Result[Output, Input] {
+		workers := maxConcurrency
+		if workers <= 0 || workers > len(parsers) {
+			workers = len(parsers)
+		}
+
+		results := make([]chan Result[Output, Input], len(parsers))
+		for idx := range results {
+			results[idx] = make(chan Result[Output, Input], 1)
+		}
+
+		done := make(chan struct{})
+		defer close(done)
+
+		go func() {
+			slots := make(chan struct{}, workers)
+			for idx, parse := range parsers {
+				select {
+				case slots <- struct{}{}:
+				case <-done:
+					return
+				}
+
+				go func(idx int, parse Parser[Input, Output]) {
+					defer func() { <-slots }()
+					results[idx] <- parse(input)
+				}(idx, parse)
+			}
+		}()
+
+		for idx := range parsers {
+			result := <-results[idx]
+			if result.Err == nil {
+				return result
+			}
+
+			if result.Err.IsFatal() {
+				return Failure[Input, Output](result.Err, input)
+			}
+		}
+
+		return Failure[Input, Output](NewError(input, "AlternativeParallel"), input)
+	}
+}
+
+// And applies the provided parser, and every provided lookahead parser, at the
+// same starting position, and succeeds only if all of them do. The produced
+// Result holds the output and the remaining input of the first parser: the
+// lookahead parsers' outputs are discarded. They share a type, which can differ
+// from the first parser's output type, so that And(Digit1(), Int64()) checks a
+// run of digits fits into an int64; lookahead parsers of different types can be
+// checked by nesting And combinators.
+//
+// And is useful to overlay constraints on top of a parser, such as ensuring an
+// identifier matches a grammar, and is at most a certain length.
+//
+// If any of the parsers fails, this combinator produces an error Result. Fatal
+// errors, such as the RangeError of a number which doesn't fit, are reported as
+// is.
+func And[Input Bytes, Output, Lookahead any](parse Parser[Input, Output], lookaheads ...Parser[Input, Lookahead]) Parser[Input, Output] {
+	return func(input Input) Result[Output, Input] {
+		result := parse(input)
+		if result.Err != nil {
+			return Failure[Input, Output](sequenceError(result.Err, input, "And"), input)
+		}
+
+		for _, lookahead := range lookaheads {
+			if lookaheadResult := lookahead(input); lookaheadResult.Err != nil {
+				return Failure[Input, Output](sequenceError(lookaheadResult.Err, input, "And"), input)
+			}
 		}
 
-		return Failure[Input, Output](NewError(input, "Alternative"), input)
+		return result
 	}
 }
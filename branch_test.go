@@ -1,7 +1,9 @@
 package gomme
 
 import (
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -92,3 +94,331 @@ func BenchmarkAlternative(b *testing.B) {
 		p("123")
 	}
 }
+
+func TestAlternativeFailureAllocations(t *testing.T) {
+	parser := Alternative(Char[string]('x'), Char[string]('y'), Char[string]('z'))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		parser("b")
+	})
+
+	// The failing alternatives, as well as Alternative itself, share
+	// their errors across failures.
+	if allocs != 0 {
+		t.Errorf("got %v allocations per run, want 0", allocs)
+	}
+}
+
+func TestAlternativeFatalError(t *testing.T) {
+	t.Parallel()
+
+	parser := Alternative(Int8[string](), Assign(int8(0), Digit1[string]()))
+	result := parser("300")
+
+	assert.Error(t, result.Err)
+	assert.True(t, result.Err.IsFatal())
+	assert.Equal(t, "expected Int8: number 300 out of range", result.Err.Error())
+	assert.Equal(t, "300", result.Remaining)
+}
+
+func TestAlternativeParallel(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "head matching parser should succeed",
+			parser:        AlternativeParallel(0, Digit1[string](), Alpha1[string]()),
+			input:         "123abc",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "tail matching parser should succeed",
+			parser:        AlternativeParallel(0, Digit1[string](), Alpha1[string]()),
+			input:         "abc123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "first matching parser should be preferred",
+			parser:        AlternativeParallel(0, Alpha1[string](), Alphanumeric1[string]()),
+			input:         "abc123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "limited concurrency should succeed",
+			parser:        AlternativeParallel(1, Digit1[string](), Alpha1[string]()),
+			input:         "abc123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "no matching parser should fail",
+			parser:        AlternativeParallel(0, Digit1[string](), Alpha1[string]()),
+			input:         "$%^*",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "$%^*",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        AlternativeParallel(0, Digit1[string](), Alpha1[string]()),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "no parsers should fail",
+			parser:        AlternativeParallel[string, string](0),
+			input:         "123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "123",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkAlternativeParallel(b *testing.B) {
+	parser := AlternativeParallel(0, Digit1[string](), Alpha1[string]())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("abc123")
+	}
+}
+
+func TestAlternativeParallelDeterministic(t *testing.T) {
+	t.Parallel()
+
+	slow := func(input string) Result[string, string] {
+		time.Sleep(20 * time.Millisecond)
+		return Success("slow", input)
+	}
+	fast := func(input string) Result[string, string] {
+		return Success("fast", input)
+	}
+
+	result := AlternativeParallel(0, slow, fast)("abc")
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "slow", result.Output)
+}
+
+func TestAlternativeParallelFatalError(t *testing.T) {
+	t.Parallel()
+
+	parser := AlternativeParallel(0, Int8[string](), Assign(int8(0), Digit1[string]()))
+	result := parser("300")
+
+	assert.Error(t, result.Err)
+	assert.True(t, result.Err.IsFatal())
+	assert.Equal(t, "300", result.Remaining)
+}
+
+func TestAlternativeParallelMaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var running, maxRunning int32
+	failing := func(input string) Result[string, string] {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			observed := atomic.LoadInt32(&maxRunning)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+
+		return Failure[string, string](NewError(input, "failing"), input)
+	}
+
+	result := AlternativeParallel(2, failing, failing, failing, failing, failing)("abc")
+
+	assert.Error(t, result.Err)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+}
+
+func TestAnd(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "all matching parsers should succeed",
+			parser:        And(Alpha1[string](), TakeWhileMN[string](1, 3, IsLowAlpha)),
+			input:         "abc123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "first parser's span should be consumed",
+			parser:        And(Alphanumeric1[string](), Alpha1[string]()),
+			input:         "abc123;",
+			wantErr:       false,
+			wantOutput:    "abc123",
+			wantRemaining: ";",
+		},
+		{
+			name:          "failing constraint should fail",
+			parser:        And(Alpha1[string](), TakeWhileMN[string](1, 3, IsUpAlpha)),
+			input:         "abc123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc123",
+		},
+		{
+			name:          "failing first parser should fail",
+			parser:        And(Digit1[string](), Alpha1[string]()),
+			input:         "abc123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc123",
+		},
+		{
+			name:          "lookahead of another output type should succeed",
+			parser:        And(Digit1[string](), Int64[string]()),
+			input:         "123abc",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "failing lookahead of another output type should fail",
+			parser:        And(Digit1[string](), Int8[string]()),
+			input:         "300abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "300abc",
+		},
+		{
+			name:          "nested constraints should all apply",
+			parser:        And(And(Alpha1[string](), TakeWhileMN[string](1, 3, IsLowAlpha)), Char[string]('a')),
+			input:         "bcd123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "bcd123",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        And(Alpha1[string](), Alphanumeric1[string]()),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "several matching constraints should succeed",
+			parser:        And(Alpha1[string](), TakeWhileMN[string](1, 3, IsLowAlpha), Alphanumeric1[string]()),
+			input:         "abc123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "any failing constraint should fail",
+			parser:        And(Alpha1[string](), Alphanumeric1[string](), TakeWhileMN[string](1, 3, IsUpAlpha)),
+			input:         "abc123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc123",
+		},
+		{
+			name:          "no constraints should apply the first parser",
+			parser:        And[string, string, string](Alpha1[string]()),
+			input:         "abc123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestAndFatalError(t *testing.T) {
+	t.Parallel()
+
+	parsers := map[string]Parser[string, string]{
+		"lookahead":    And(Digit1[string](), Int8[string]()),
+		"first parser": And(Map(Int8[string](), func(n int8) (string, error) { return "", nil }), Digit1[string]()),
+	}
+
+	for name, parser := range parsers {
+		result := parser("300")
+		if result.Err == nil || !result.Err.IsFatal() {
+			t.Errorf("%s: got error %v, want fatal error", name, result.Err)
+		}
+	}
+}
+
+func BenchmarkAnd(b *testing.B) {
+	p := And(Alpha1[string](), TakeWhileMN[string](1, 3, IsLowAlpha))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("abc123")
+	}
+}
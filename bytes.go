@@ -1,32 +1,169 @@
 package gomme
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Take returns a subset of the input of size `count`.
 func Take[Input Bytes](count uint) Parser[Input, Input] {
-	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 && count > 0 {
-			return Failure[Input, Input](NewError(input, "TakeUntil"), input)
-		}
+	failure := newSharedError[Input]("Take")
 
+	return func(input Input) Result[Input, Input] {
 		if uint(len(input)) < count {
-			return Failure[Input, Input](NewError(input, "Take"), input)
+			return Failure[Input, Input](failure, input)
 		}
 
 		return Success(input[:count], input[count:])
 	}
 }
 
+// LengthData parses a length using the provided parser, and then takes exactly
+// that many bytes from the remaining input, which it returns as the produced value.
+// It allows to parse binary-safe, length-prefixed payloads, whose content may hold
+// sequences a delimiter-based parser would otherwise stop at.
+//
+// If the length parser fails, if the parsed length is negative, or if the
+// remaining input is shorter than the parsed length, the parser fails, and the
+// entire input is returned as the Result's Remaining. Fatal errors produced by the
+// length parser, such as the RangeError of an out of range length, are reported
+// as is.
+func LengthData[Input Bytes, N Integer](length Parser[Input, N]) Parser[Input, Input] {
+	failure := newSharedError[Input]("LengthData")
+
+	return func(input Input) Result[Input, Input] {
+		lengthResult := length(input)
+		if lengthResult.Err != nil {
+			if lengthResult.Err.IsFatal() {
+				return Failure[Input, Input](lengthResult.Err, input)
+			}
+
+			return Failure[Input, Input](failure, input)
+		}
+
+		size := lengthResult.Output
+		if size < 0 || uint64(size) > uint64(len(lengthResult.Remaining)) {
+			return Failure[Input, Input](failure, input)
+		}
+
+		return Success(lengthResult.Remaining[:size], lengthResult.Remaining[size:])
+	}
+}
+
+// LengthValue parses a length using the provided length parser, and then applies the
+// value parser to a window of exactly that many bytes of the remaining input. The
+// value parser's output is returned as the produced value.
+//
+// If the length parser fails, if the parsed length does not fit in the remaining
+// input, or if the value parser fails or does not consume the entire window, the
+// parser fails, and the entire input is returned as the Result's Remaining. Fatal
+// errors produced by the length or value parsers are reported as is.
+func LengthValue[Input Bytes, N Integer, Output any](
+	length Parser[Input, N],
+	value Parser[Input, Output],
+) Parser[Input, Output] {
+	window := LengthData(length)
+
+	failure := newSharedError[Input]("LengthValue")
+
+	return func(input Input) Result[Output, Input] {
+		windowResult := window(input)
+		if windowResult.Err != nil {
+			if windowResult.Err.IsFatal() {
+				return Failure[Input, Output](windowResult.Err, input)
+			}
+
+			return Failure[Input, Output](failure, input)
+		}
+
+		valueResult := value(windowResult.Output)
+		if valueResult.Err != nil && valueResult.Err.IsFatal() {
+			return Failure[Input, Output](valueResult.Err, input)
+		}
+
+		if valueResult.Err != nil || len(valueResult.Remaining) != 0 {
+			return Failure[Input, Output](failure, input)
+		}
+
+		return Success(valueResult.Output, windowResult.Remaining)
+	}
+}
+
+// TLV parses a type-length-value record, as many binary protocols frame their
+// messages. It parses a type code using the type parser, and a length using the
+// length parser, and then applies the value parser registered for the type code
+// to a window of exactly that many bytes of the remaining input. The selected
+// value parser's output is returned as the produced value.
+//
+// If the type or length parsers fail, if no value parser is registered for the
+// type code, if the parsed length does not fit in the remaining input, or if the
+// value parser fails or does not consume the entire window, the parser fails,
+// and the entire input is returned as the Result's Remaining. Fatal errors
+// produced by the type, length or value parsers are reported as is.
+func TLV[Input Bytes, T comparable, N Integer, Output any](
+	typeParser Parser[Input, T],
+	length Parser[Input, N],
+	values map[T]Parser[Input, Output],
+) Parser[Input, Output] {
+	window := LengthData(length)
+
+	failure := newSharedError[Input]("TLV")
+
+	return func(input Input) Result[Output, Input] {
+		typeResult := typeParser(input)
+		if typeResult.Err != nil {
+			if typeResult.Err.IsFatal() {
+				return Failure[Input, Output](typeResult.Err, input)
+			}
+
+			return Failure[Input, Output](failure, input)
+		}
+
+		value, ok := values[typeResult.Output]
+		if !ok {
+			return Failure[Input, Output](failure, input)
+		}
+
+		windowResult := window(typeResult.Remaining)
+		if windowResult.Err != nil {
+			if windowResult.Err.IsFatal() {
+				return Failure[Input, Output](windowResult.Err, input)
+			}
+
+			return Failure[Input, Output](failure, input)
+		}
+
+		valueResult := value(windowResult.Output)
+		if valueResult.Err != nil && valueResult.Err.IsFatal() {
+			return Failure[Input, Output](valueResult.Err, input)
+		}
+
+		if valueResult.Err != nil || len(valueResult.Remaining) != 0 {
+			return Failure[Input, Output](failure, input)
+		}
+
+		return Success(valueResult.Output, windowResult.Remaining)
+	}
+}
+
 // TakeUntil parses any number of characters until the provided parser is successful.
 // If the provided parser is not successful, the parser fails, and the entire input is
 // returned as the Result's Remaining.
+//
+// The provided parser is attempted at every position of the input: as primitive
+// parsers share their errors across failures, probing with one of them doesn't
+// allocate. When looking for a fixed token, TakeUntilToken is faster, and should
+// be preferred.
 func TakeUntil[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, Input] {
+	failure := newSharedError[Input]("TakeUntil")
+
 	return func(input Input) Result[Input, Input] {
 		if len(input) == 0 {
-			return Failure[Input, Input](NewError(input, "TakeUntil"), input)
+			return Failure[Input, Input](failure, input)
 		}
 
 		pos := 0
@@ -36,11 +173,67 @@ func TakeUntil[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Inpu
 			if res.Err == nil {
 				return Success(input[:pos], input[pos:])
 			}
+		}
+
+		return Failure[Input, Input](failure, input)
+	}
+}
 
-			continue
+// TakeUntilIncluding parses any number of characters until the provided parser is
+// successful, and consumes the input it matched too. The Result's Output holds the
+// characters found before the terminator, which itself is discarded. Wrapping it
+// with Recognize produces the consumed input, terminator included, instead.
+//
+// If the provided parser is not successful, the parser fails, and the entire input
+// is returned as the Result's Remaining.
+func TakeUntilIncluding[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, Input] {
+	failure := newSharedError[Input]("TakeUntilIncluding")
+
+	return func(input Input) Result[Input, Input] {
+		if len(input) == 0 {
+			return Failure[Input, Input](failure, input)
 		}
 
-		return Failure[Input, Input](NewError(input, "TakeUntil"), input)
+		for pos := 0; pos < len(input); pos++ {
+			res := parse(input[pos:])
+			if res.Err == nil {
+				return Success(input[:pos], res.Remaining)
+			}
+		}
+
+		return Failure[Input, Input](failure, input)
+	}
+}
+
+// TakeUntilToken parses any number of characters until the provided token is found.
+// It is equivalent to `TakeUntil(Token(token))`, but looks the token up using
+// strings.Index, or bytes.Index, instead of attempting to parse it at every position
+// of the input.
+//
+// If the token cannot be found, the parser fails, and the entire input is
+// returned as the Result's Remaining.
+func TakeUntilToken[Input Bytes](token string) Parser[Input, Input] {
+	tokenBytes := []byte(token)
+	failure := newSharedError[Input](fmt.Sprintf("TakeUntilToken(%s)", token))
+
+	return func(input Input) Result[Input, Input] {
+		if len(input) == 0 {
+			return Failure[Input, Input](failure, input)
+		}
+
+		var pos int
+		switch typed := any(input).(type) {
+		case string:
+			pos = strings.Index(typed, token)
+		case []byte:
+			pos = bytes.Index(typed, tokenBytes)
+		}
+
+		if pos < 0 {
+			return Failure[Input, Input](failure, input)
+		}
+
+		return Success(input[:pos], input[pos:])
 	}
 }
 
@@ -51,16 +244,18 @@ func TakeUntil[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Inpu
 // `atLeast` <= len(input) <= `atMost` range, the parser fails, and the entire
 // input is returned as the Result's Remaining.
 func TakeWhileMN[Input Bytes](atLeast, atMost uint, predicate func(rune) bool) Parser[Input, Input] {
+	failure := newSharedError[Input]("TakeWhileMN")
+
 	return func(input Input) Result[Input, Input] {
 		if len(input) == 0 {
-			return Failure[Input, Input](NewError(input, "TakeWhileMN"), input)
+			return Failure[Input, Input](failure, input)
 		}
 
 		// Input is shorter than the minimum expected matching length,
 		// it is thus not possible to match it within the established
 		// constraints.
 		if uint(len(input)) < atLeast {
-			return Failure[Input, Input](NewError(input, "TakeWhileMN"), input)
+			return Failure[Input, Input](failure, input)
 		}
 
 		lastValidPos := 0
@@ -72,7 +267,7 @@ func TakeWhileMN[Input Bytes](atLeast, atMost uint, predicate func(rune) bool) P
 			matched := predicate(rune(input[idx]))
 			if !matched {
 				if uint(idx) < atLeast {
-					return Failure[Input, Input](NewError(input, "TakeWhileMN"), input)
+					return Failure[Input, Input](failure, input)
 				}
 
 				return Success(input[:idx], input[idx:])
@@ -86,14 +281,721 @@ func TakeWhileMN[Input Bytes](atLeast, atMost uint, predicate func(rune) bool) P
 }
 
 // Token parses a token from the input, and returns the part of the input that
-// matched the token.
+// matched the token. The input is compared in place, using strings.HasPrefix or
+// bytes.HasPrefix depending on its type, so that matching doesn't copy it.
 // If the token could not be found, the parser returns an error result.
 func Token[Input Bytes](token string) Parser[Input, Input] {
+	tokenBytes := []byte(token)
+	expected := fmt.Sprintf("Token(%s)", token)
+
+	failure := newSharedError[Input](expected)
+
 	return func(input Input) Result[Input, Input] {
-		if !strings.HasPrefix(string(input), token) {
-			return Failure[Input, Input](NewError(input, fmt.Sprintf("Token(%s)", token)), input)
+		if !hasPrefix(input, token, tokenBytes) {
+			return Failure[Input, Input](failure, input)
 		}
 
 		return Success(input[:len(token)], input[len(token):])
 	}
 }
+
+// TokenBytes parses a token, expressed as a byte slice, from the input, and returns
+// the part of the input that matched the token. It is the byte slice counterpart of
+// Token, and is mostly useful to express tokens holding binary data.
+// If the token could not be found, the parser returns an error result.
+func TokenBytes[Input Bytes](token []byte) Parser[Input, Input] {
+	return Token[Input](string(token))
+}
+
+// TokenNoCase parses a token from the input regardless of its case, and returns
+// the part of the input that matched the token. The input and the token are
+// compared character by character using Unicode simple case folding, which makes
+// "Content-Length", "content-length" and "CONTENT-LENGTH" equivalent.
+// If the token could not be found, the parser returns an error result.
+func TokenNoCase[Input Bytes](token string) Parser[Input, Input] {
+	name := fmt.Sprintf("TokenNoCase(%s)", token)
+
+	failure := newSharedError[Input](name)
+
+	return func(input Input) Result[Input, Input] {
+		pos := 0
+		for _, expected := range token {
+			if pos >= len(input) {
+				return Failure[Input, Input](failure, input)
+			}
+
+			c, size := decodeRune(input[pos:])
+			if !equalFoldRune(c, expected) {
+				return Failure[Input, Input](failure, input)
+			}
+
+			pos += size
+		}
+
+		return Success(input[:pos], input[pos:])
+	}
+}
+
+// Symbol parses a token from the input, and then skips any whitespace following
+// it. It is equivalent to Lexeme(Token(token), policies...), and is mostly useful
+// to express punctuation such as operators and delimiters.
+// If the token could not be found, the parser returns an error result.
+func Symbol[Input Bytes](token string, policies ...WhitespacePolicy) Parser[Input, Input] {
+	return Lexeme(Token[Input](token), policies...)
+}
+
+// Keyword parses a token from the input, and ensures it is not immediately
+// followed by an identifier character: a letter, a digit, or an underscore.
+// As a result, Keyword("if") matches "if (x)", but not the beginning of "iffy".
+// If the token could not be found, or is followed by an identifier character,
+// the parser returns an error result.
+func Keyword[Input Bytes](token string) Parser[Input, Input] {
+	tokenBytes := []byte(token)
+	expected := fmt.Sprintf("Keyword(%s)", token)
+
+	failure := newSharedError[Input](expected)
+
+	return func(input Input) Result[Input, Input] {
+		if !hasPrefix(input, token, tokenBytes) {
+			return Failure[Input, Input](failure, input)
+		}
+
+		if len(input) > len(token) && isIdentifierChar(rune(input[len(token)])) {
+			return Failure[Input, Input](failure, input)
+		}
+
+		return Success(input[:len(token)], input[len(token):])
+	}
+}
+
+// IdentifierOption configures the Identifier parser.
+type IdentifierOption func(*identifierOptions)
+
+// identifierOptions holds the configuration of the Identifier parser.
+type identifierOptions struct {
+	unicode bool
+}
+
+// WithUnicode allows the identifiers Identifier parses to hold any Unicode
+// letter or digit, rather than ASCII ones only, as in "héllo" or "δx".
+func WithUnicode() IdentifierOption {
+	return func(options *identifierOptions) {
+		options.unicode = true
+	}
+}
+
+// Identifier parses an identifier, as found in most programming languages: a
+// letter or an underscore, followed by any number of letters, digits, and
+// underscores, such as "x", "_tmp" or "parseInput2". Letters and digits are ASCII
+// ones, unless the WithUnicode option is provided.
+// If the input doesn't start with an identifier, the parser returns an error
+// result.
+func Identifier[Input Bytes](options ...IdentifierOption) Parser[Input, Input] {
+	var config identifierOptions
+	for _, option := range options {
+		option(&config)
+	}
+
+	failure := newSharedError[Input]("Identifier")
+
+	return func(input Input) Result[Input, Input] {
+		pos := 0
+		for pos < len(input) {
+			c, size := rune(input[pos]), 1
+			if config.unicode {
+				c, size = decodeRune(input[pos:])
+			}
+
+			valid := c == '_' || IsAlpha(c) || (pos > 0 && IsDigit(c))
+			if config.unicode && c >= utf8.RuneSelf {
+				valid = unicode.IsLetter(c) || (pos > 0 && unicode.IsDigit(c))
+			}
+
+			if !valid {
+				break
+			}
+
+			pos += size
+		}
+
+		if pos == 0 {
+			return Failure[Input, Input](failure, input)
+		}
+
+		return Success(input[:pos], input[pos:])
+	}
+}
+
+func isIdentifierChar(c rune) bool {
+	return IsAlphanumeric(c) || c == '_'
+}
+
+// hasPrefix reports whether the input begins with the provided token. The token
+// is provided both as a string and as a byte slice, so that neither string nor
+// byte slice inputs need to be converted to be compared with it.
+func hasPrefix[Input Bytes](input Input, token string, tokenBytes []byte) bool {
+	switch typed := any(input).(type) {
+	case string:
+		return strings.HasPrefix(typed, token)
+	case []byte:
+		return bytes.HasPrefix(typed, tokenBytes)
+	}
+
+	return false
+}
+
+// TakeTill parses zero or more characters until the provided predicate matches
+// a character, and returns the consumed input. If the predicate never matches,
+// the entire input is returned as the Result's Output.
+func TakeTill[Input Bytes](predicate func(rune) bool) Parser[Input, Input] {
+	failure := newSharedError[Input]("TakeTill")
+
+	return func(input Input) Result[Input, Input] {
+		return takeTill(input, predicate, false, failure)
+	}
+}
+
+// TakeTill1 parses one or more characters until the provided predicate matches
+// a character, and returns the consumed input. If the input is empty, or if the
+// predicate matches its first character, the parser returns an error result.
+func TakeTill1[Input Bytes](predicate func(rune) bool) Parser[Input, Input] {
+	failure := newSharedError[Input]("TakeTill1")
+
+	return func(input Input) Result[Input, Input] {
+		return takeTill(input, predicate, true, failure)
+	}
+}
+
+// TakeWhileNot parses zero or more characters for as long as the provided predicate
+// does not match them, and returns the consumed input. It spares inverting the
+// predicate by hand, and is equivalent to TakeTill.
+func TakeWhileNot[Input Bytes](predicate func(rune) bool) Parser[Input, Input] {
+	failure := newSharedError[Input]("TakeWhileNot")
+
+	return func(input Input) Result[Input, Input] {
+		return takeTill(input, predicate, false, failure)
+	}
+}
+
+// TakeWhileNot1 parses one or more characters for as long as the provided predicate
+// does not match them, and returns the consumed input. If the input is empty, or if
+// the predicate matches its first character, the parser returns an error result.
+func TakeWhileNot1[Input Bytes](predicate func(rune) bool) Parser[Input, Input] {
+	failure := newSharedError[Input]("TakeWhileNot1")
+
+	return func(input Input) Result[Input, Input] {
+		return takeTill(input, predicate, true, failure)
+	}
+}
+
+// takeTill holds the logic shared by the TakeTill and TakeWhileNot parsers. If
+// `required` is true, at least one character must be consumed. The provided
+// failure is reported when the input doesn't match.
+func takeTill[Input Bytes](input Input, predicate func(rune) bool, required bool, failure *Error[Input]) Result[Input, Input] {
+	for idx := 0; idx < len(input); idx++ {
+		if predicate(rune(input[idx])) {
+			if required && idx == 0 {
+				return Failure[Input, Input](failure, input)
+			}
+
+			return Success(input[:idx], input[idx:])
+		}
+	}
+
+	if required && len(input) == 0 {
+		return Failure[Input, Input](failure, input)
+	}
+
+	return Success(input, input[len(input):])
+}
+
+// TakeWhileOneOf parses one or more characters for as long as they are part of
+// the given set of characters, and returns the consumed input. The set is held
+// in a bitmap built when the parser is created, so that checking a character
+// costs a shift and a mask, whatever the size of the set. As with OneOf, the
+// input is compared byte by byte, and characters beyond 255 never match.
+// If the input is empty, or if its first character isn't part of the set, the
+// parser returns an error result.
+func TakeWhileOneOf[Input Bytes](collection ...rune) Parser[Input, Input] {
+	set := newByteSet(collection)
+	expected := fmt.Sprintf("chars(%v)", string(collection))
+
+	failure := newSharedError[Input](expected)
+
+	return func(input Input) Result[Input, Input] {
+		pos := 0
+		for pos < len(input) && set.contains(input[pos]) {
+			pos++
+		}
+
+		if pos == 0 {
+			return Failure[Input, Input](failure, input)
+		}
+
+		return Success(input[:pos], input[pos:])
+	}
+}
+
+// Keywords parses the longest of the provided keywords found at the start of
+// the input, and returns the part of the input that matched it. Unlike a chain
+// of Alternative(Token(...)) parsers, the order in which the keywords are provided
+// does not matter: with the "let" and "letrec" keywords, "letrec" is always
+// preferred over "let" when the input allows it.
+//
+// The keywords are indexed in a trie when the parser is created, so that the
+// input is only ever walked once, regardless of the number of keywords.
+//
+// If none of the keywords could be found, the parser returns an error result.
+func Keywords[Input Bytes](keywords ...string) Parser[Input, Input] {
+	root := &keywordNode{}
+	for _, keyword := range keywords {
+		node := root
+		for idx := 0; idx < len(keyword); idx++ {
+			child, ok := node.children[keyword[idx]]
+			if !ok {
+				if node.children == nil {
+					node.children = make(map[byte]*keywordNode)
+				}
+
+				child = &keywordNode{}
+				node.children[keyword[idx]] = child
+			}
+
+			node = child
+		}
+
+		node.terminal = true
+	}
+
+	failure := newSharedError[Input]("Keywords")
+
+	return func(input Input) Result[Input, Input] {
+		longest := -1
+		if root.terminal {
+			longest = 0
+		}
+
+		node := root
+		for idx := 0; idx < len(input); idx++ {
+			child, ok := node.children[input[idx]]
+			if !ok {
+				break
+			}
+
+			node = child
+			if node.terminal {
+				longest = idx + 1
+			}
+		}
+
+		if longest < 0 {
+			return Failure[Input, Input](failure, input)
+		}
+
+		return Success(input[:longest], input[longest:])
+	}
+}
+
+// keywordNode is a node of the trie used by the Keywords parser to index the
+// keywords it looks for.
+type keywordNode struct {
+	children map[byte]*keywordNode
+	terminal bool
+}
+
+// EnumOption configures the Enum parser.
+type EnumOption func(*enumOptions)
+
+// enumOptions holds the configuration of the Enum parser.
+type enumOptions struct {
+	caseInsensitive bool
+}
+
+// WithCaseInsensitive makes Enum match the spellings regardless of their case,
+// comparing them with the input using Unicode simple case folding, as
+// TokenNoCase does.
+func WithCaseInsensitive() EnumOption {
+	return func(options *enumOptions) {
+		options.caseInsensitive = true
+	}
+}
+
+// Enum parses the longest of the provided spellings found at the start of the
+// input, and returns the value associated with it; such as a log level, an HTTP
+// method, or a boolean. As with Keywords, the spellings are indexed in a trie
+// when the parser is created, and the order in which they are provided does not
+// matter: with the "in" and "inf" spellings, "inf" is always preferred over "in"
+// when the input allows it.
+//
+// Enum panics if the WithCaseInsensitive option is provided, and two of the
+// spellings only differ by their case.
+//
+// If none of the spellings could be found, the parser returns an error result.
+func Enum[Input Bytes, T any](values map[string]T, options ...EnumOption) Parser[Input, T] {
+	var config enumOptions
+	for _, option := range options {
+		option(&config)
+	}
+
+	// The spellings are indexed in order, so that the spellings reported as
+	// conflicting, if any, don't depend on the map's iteration order.
+	spellings := make([]string, 0, len(values))
+	for spelling := range values {
+		spellings = append(spellings, spelling)
+	}
+	sort.Strings(spellings)
+
+	root := &enumNode[T]{}
+	for _, spelling := range spellings {
+		key := spelling
+		if config.caseInsensitive {
+			key = foldString(spelling)
+		}
+
+		node := root
+		for idx := 0; idx < len(key); idx++ {
+			child, ok := node.children[key[idx]]
+			if !ok {
+				if node.children == nil {
+					node.children = make(map[byte]*enumNode[T])
+				}
+
+				child = &enumNode[T]{}
+				node.children[key[idx]] = child
+			}
+
+			node = child
+		}
+
+		if node.terminal {
+			panic(fmt.Sprintf("gomme: Enum spellings %q and %q only differ by their case", node.spelling, spelling))
+		}
+
+		node.terminal = true
+		node.spelling = spelling
+		node.value = values[spelling]
+	}
+
+	failure := newSharedError[Input]("Enum")
+
+	return func(input Input) Result[T, Input] {
+		var longest *enumNode[T]
+		length := 0
+		if root.terminal {
+			longest = root
+		}
+
+		var buf [utf8.UTFMax]byte
+		node := root
+		for pos := 0; pos < len(input) && node != nil; {
+			key, size := buf[:1], 1
+			buf[0] = input[pos]
+			if config.caseInsensitive {
+				c, width := decodeRune(input[pos:])
+				key, size = buf[:utf8.EncodeRune(buf[:], foldRune(c))], width
+			}
+
+			for idx := 0; idx < len(key) && node != nil; idx++ {
+				node = node.children[key[idx]]
+			}
+
+			pos += size
+			if node != nil && node.terminal {
+				longest, length = node, pos
+			}
+		}
+
+		if longest == nil {
+			return Failure[Input, T](failure, input)
+		}
+
+		return Success(longest.value, input[length:])
+	}
+}
+
+// enumNode is a node of the trie used by the Enum parser to index the spellings
+// it looks for, along with their values.
+type enumNode[T any] struct {
+	children map[byte]*enumNode[T]
+	terminal bool
+	spelling string
+	value    T
+}
+
+// foldString returns the canonical form of the provided string under Unicode
+// simple case folding, as produced by foldRune.
+func foldString(s string) string {
+	var builder strings.Builder
+	for _, c := range s {
+		builder.WriteRune(foldRune(c))
+	}
+
+	return builder.String()
+}
+
+// Balanced parses a region of the input starting with the open character, and
+// ending with its matching close character, taking nested pairs of delimiters
+// into account. The consumed region, delimiters included, is returned as the
+// produced value; which allows grabbing a parenthesized expression, or a nested
+// block, as raw input to be parsed later on.
+//
+// If the input does not start with the open character, or if its delimiters are
+// not balanced, the parser returns an error result.
+func Balanced[Input Bytes](open, close rune) Parser[Input, Input] {
+	failure := newSharedError[Input]("Balanced")
+
+	return func(input Input) Result[Input, Input] {
+		return balanced(input, open, close, 0, false, failure)
+	}
+}
+
+// BalancedWith behaves like Balanced, but treats any character following the
+// provided escape character as a literal; escaped delimiters are thus ignored
+// when looking for the region's end.
+func BalancedWith[Input Bytes](open, close, escape rune) Parser[Input, Input] {
+	failure := newSharedError[Input]("BalancedWith")
+
+	return func(input Input) Result[Input, Input] {
+		return balanced(input, open, close, escape, true, failure)
+	}
+}
+
+// balanced holds the logic shared by the Balanced parsers. The escape character
+// is only taken into account if `escapes` is true. The provided failure is
+// reported when the input doesn't match.
+func balanced[Input Bytes](input Input, open, close, escape rune, escapes bool, failure *Error[Input]) Result[Input, Input] {
+	if len(input) == 0 || rune(input[0]) != open {
+		return Failure[Input, Input](failure, input)
+	}
+
+	depth := 0
+	for idx := 0; idx < len(input); idx++ {
+		current := rune(input[idx])
+
+		switch {
+		case escapes && current == escape:
+			idx++
+		case current == close && depth > 0:
+			depth--
+			if depth == 0 {
+				return Success(input[:idx+1], input[idx+1:])
+			}
+		case current == open:
+			depth++
+		}
+	}
+
+	return Failure[Input, Input](failure, input)
+}
+
+// QuotedStringOption configures the QuotedString parser.
+type QuotedStringOption func(*quotedStringOptions)
+
+// quotedStringOptions holds the configuration of the QuotedString parser.
+type quotedStringOptions struct {
+	quote   rune
+	escape  rune
+	escapes map[rune]rune
+}
+
+// WithQuote sets the quote character enclosing the literals QuotedString parses.
+// It defaults to '"'.
+func WithQuote(quote rune) QuotedStringOption {
+	return func(options *quotedStringOptions) {
+		options.quote = quote
+	}
+}
+
+// WithEscape sets the character introducing the escape sequences of the literals
+// QuotedString parses. It defaults to '\\'. When the escape character is the
+// quote character itself, as in CSV or SQL literals, a doubled quote stands for a
+// single one, and no other escape sequence is recognized.
+func WithEscape(escape rune) QuotedStringOption {
+	return func(options *quotedStringOptions) {
+		options.escape = escape
+	}
+}
+
+// WithEscapes sets the escape sequences recognized by QuotedString. The escapes
+// map associates each character allowed after the escape character with the
+// character it stands for; the quote and escape characters always stand for
+// themselves.
+func WithEscapes(escapes map[rune]rune) QuotedStringOption {
+	return func(options *quotedStringOptions) {
+		options.escapes = escapes
+	}
+}
+
+// QuotedString parses a string literal enclosed in double quotes, and produces
+// its unescaped contents. Within the literal, a backslash escapes the character
+// following it: the quote character, the backslash itself, both the `'` and `"`
+// quotes, and the `\n`, `\r`, `\t`, `\b`, `\f` and `\0` sequences are recognized.
+// The quote and escape characters, and the escape sequences, can be changed using
+// the WithQuote, WithEscape and WithEscapes options: QuotedString(WithQuote('`'))
+// parses backquoted literals, for instance.
+//
+// If the input does not start with the quote character, if the literal is not
+// terminated, or if it holds an unknown escape sequence, the parser returns an
+// error result.
+func QuotedString[Input Bytes](options ...QuotedStringOption) Parser[Input, string] {
+	config := quotedStringOptions{quote: '"', escape: '\\', escapes: defaultEscapes}
+	for _, option := range options {
+		option(&config)
+	}
+
+	failure := newSharedError[Input]("QuotedString")
+
+	return func(input Input) Result[string, Input] {
+		return quotedString(input, config.quote, config.escape, config.escapes, failure)
+	}
+}
+
+// defaultEscapes holds the escape sequences recognized by QuotedString.
+var defaultEscapes = map[rune]rune{
+	'n':  '\n',
+	'r':  '\r',
+	't':  '\t',
+	'b':  '\b',
+	'f':  '\f',
+	'0':  0,
+	'\'': '\'',
+	'"':  '"',
+}
+
+// quotedString holds the logic shared by the QuotedString parsers. The provided
+// failure is reported when the input doesn't match.
+func quotedString[Input Bytes](input Input, quote, escape rune, escapes map[rune]rune, failure *Error[Input]) Result[string, Input] {
+	if len(input) == 0 || rune(input[0]) != quote {
+		return Failure[Input, string](failure, input)
+	}
+
+	var builder strings.Builder
+	start := 1
+	for idx := 1; idx < len(input); idx++ {
+		current := rune(input[idx])
+
+		if current == escape && idx+1 < len(input) {
+			next := rune(input[idx+1])
+			replacement, ok := next, next == quote || next == escape
+			if !ok && escape != quote {
+				replacement, ok = escapes[next]
+			}
+
+			if ok {
+				builder.WriteString(viewString(input[start:idx]))
+				builder.WriteRune(replacement)
+				idx++
+				start = idx + 1
+
+				continue
+			}
+
+			// An unknown escape sequence is an error, unless the escape
+			// character is the quote itself, in which case it terminates
+			// the literal.
+			if escape != quote {
+				return Failure[Input, string](failure, input)
+			}
+		}
+
+		if current == quote {
+			// A literal holding no escape sequence is produced as is.
+			if start == 1 {
+				return Success(viewString(input[1:idx]), input[idx+1:])
+			}
+
+			builder.WriteString(viewString(input[start:idx]))
+			return Success(builder.String(), input[idx+1:])
+		}
+	}
+
+	return Failure[Input, string](failure, input)
+}
+
+// CommentLine parses a line comment starting with the provided marker, such as
+// "//" or "#", and running until the end of the line. The comment, marker
+// included, is returned as the produced value; the line ending itself is left
+// in the remaining input.
+// If the input does not start with the marker, the parser returns an error result.
+func CommentLine[Input Bytes](start string) Parser[Input, Input] {
+	startBytes := []byte(start)
+	expected := fmt.Sprintf("CommentLine(%s)", start)
+
+	failure := newSharedError[Input](expected)
+
+	return func(input Input) Result[Input, Input] {
+		if !hasPrefix(input, start, startBytes) {
+			return Failure[Input, Input](failure, input)
+		}
+
+		pos := len(start)
+		for pos < len(input) && !isLineEnding(rune(input[pos])) {
+			pos++
+		}
+
+		return Success(input[:pos], input[pos:])
+	}
+}
+
+// CommentBlock parses a block comment enclosed in the provided open and close
+// markers, such as "/*" and "*/". The comment ends at the first close marker
+// found, and is returned, markers included, as the produced value.
+// If the input does not start with the open marker, or if the comment is not
+// terminated, the parser returns an error result.
+func CommentBlock[Input Bytes](open, close string) Parser[Input, Input] {
+	openBytes, closeBytes := []byte(open), []byte(close)
+
+	failure := newSharedError[Input]("CommentBlock")
+
+	return func(input Input) Result[Input, Input] {
+		return commentBlock(input, open, close, openBytes, closeBytes, false, failure)
+	}
+}
+
+// CommentBlockNested behaves like CommentBlock, but allows block comments to be
+// nested: each open marker found within the comment must be matched by its own
+// close marker before the comment ends.
+func CommentBlockNested[Input Bytes](open, close string) Parser[Input, Input] {
+	openBytes, closeBytes := []byte(open), []byte(close)
+
+	failure := newSharedError[Input]("CommentBlockNested")
+
+	return func(input Input) Result[Input, Input] {
+		return commentBlock(input, open, close, openBytes, closeBytes, true, failure)
+	}
+}
+
+// commentBlock holds the logic shared by the CommentBlock parsers. Open markers
+// found within the comment are only taken into account if `nested` is true. The
+// provided failure is reported when the input doesn't match.
+func commentBlock[Input Bytes](
+	input Input,
+	open, close string,
+	openBytes, closeBytes []byte,
+	nested bool,
+	failure *Error[Input],
+) Result[Input, Input] {
+	if !hasPrefix(input, open, openBytes) {
+		return Failure[Input, Input](failure, input)
+	}
+
+	depth := 1
+	pos := len(open)
+	for pos < len(input) {
+		switch {
+		case hasPrefix(input[pos:], close, closeBytes):
+			pos += len(close)
+			depth--
+			if depth == 0 {
+				return Success(input[:pos], input[pos:])
+			}
+		case nested && hasPrefix(input[pos:], open, openBytes):
+			pos += len(open)
+			depth++
+		default:
+			pos++
+		}
+	}
+
+	return Failure[Input, Input](failure, input)
+}
@@ -1,8 +1,9 @@
 package gomme
 
 import (
-	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestTake(t *testing.T) {
@@ -85,62 +86,68 @@ func TestTake(t *testing.T) {
 func BenchmarkTake(b *testing.B) {
 	p := Take[string](6)
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		p("123456")
 	}
 }
 
-func TestTakeUntil(t *testing.T) {
+func TestLengthData(t *testing.T) {
 	t.Parallel()
 
-	type args struct {
-		p Parser[string, string]
-	}
 	testCases := []struct {
 		name          string
-		args          args
+		parser        Parser[string, string]
 		input         string
 		wantErr       bool
 		wantOutput    string
 		wantRemaining string
 	}{
 		{
-			name:  "matching parser should succeed",
-			input: "abc123",
-			args: args{
-				p: TakeUntil(Digit1[string]()),
-			},
+			name:          "matching length and enough data should succeed",
+			parser:        LengthData(Terminated(Int64[string](), Char[string](':'))),
+			input:         "5:ab\r\ncdef",
 			wantErr:       false,
-			wantOutput:    "abc",
-			wantRemaining: "123",
+			wantOutput:    "ab\r\nc",
+			wantRemaining: "def",
 		},
 		{
-			name:  "immediately matching parser should succeed",
-			input: "123",
-			args: args{
-				p: TakeUntil(Digit1[string]()),
-			},
+			name:          "zero length should succeed",
+			parser:        LengthData(Terminated(Int64[string](), Char[string](':'))),
+			input:         "0:abc",
 			wantErr:       false,
 			wantOutput:    "",
-			wantRemaining: "123",
+			wantRemaining: "abc",
 		},
 		{
-			name:  "no match should fail",
-			input: "abcdef",
-			args: args{
-				p: TakeUntil(Digit1[string]()),
-			},
+			name:          "not enough data should fail",
+			parser:        LengthData(Terminated(Int64[string](), Char[string](':'))),
+			input:         "5:abc",
 			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "abcdef",
+			wantRemaining: "5:abc",
 		},
 		{
-			name:  "empty input should fail",
-			input: "",
-			args: args{
-				p: TakeUntil(Digit1[string]()),
-			},
+			name:          "negative length should fail",
+			parser:        LengthData(Terminated(Int64[string](), Char[string](':'))),
+			input:         "-1:abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "-1:abc",
+		},
+		{
+			name:          "failing length parser should fail",
+			parser:        LengthData(Terminated(Int64[string](), Char[string](':'))),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        LengthData(Terminated(Int64[string](), Char[string](':'))),
+			input:         "",
 			wantErr:       true,
 			wantOutput:    "",
 			wantRemaining: "",
@@ -152,7 +159,7 @@ func TestTakeUntil(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			gotResult := tc.args.p(tc.input)
+			gotResult := tc.parser(tc.input)
 			if (gotResult.Err != nil) != tc.wantErr {
 				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
 			}
@@ -168,88 +175,178 @@ func TestTakeUntil(t *testing.T) {
 	}
 }
 
-func BenchmarkTakeUntil(b *testing.B) {
-	p := TakeUntil(Digit1[string]())
+func BenchmarkLengthData(b *testing.B) {
+	p := LengthData(Terminated(Int64[string](), Char[string](':')))
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		p("abc123")
+		p("5:abcde")
 	}
 }
 
-func TestTakeWhileMN(t *testing.T) {
+func TestLengthValue(t *testing.T) {
 	t.Parallel()
 
-	type args struct {
-		p Parser[string, string]
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []string]
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:          "value parser consuming the whole window should succeed",
+			parser:        LengthValue(Terminated(UInt8[string](), Char[string](':')), SeparatedList1(Digit1[string](), Char[string](','))),
+			input:         "5:1,2,3,4",
+			wantErr:       false,
+			wantOutput:    []string{"1", "2", "3"},
+			wantRemaining: ",4",
+		},
+		{
+			name:          "value parser partially consuming the window should fail",
+			parser:        LengthValue(Terminated(UInt8[string](), Char[string](':')), SeparatedList1(Digit1[string](), Char[string](','))),
+			input:         "4:1,2,3,4",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "4:1,2,3,4",
+		},
+		{
+			name:          "failing value parser should fail",
+			parser:        LengthValue(Terminated(UInt8[string](), Char[string](':')), SeparatedList1(Digit1[string](), Char[string](','))),
+			input:         "3:abc",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "3:abc",
+		},
+		{
+			name:          "window larger than the input should fail",
+			parser:        LengthValue(Terminated(UInt8[string](), Char[string](':')), SeparatedList1(Digit1[string](), Char[string](','))),
+			input:         "9:1,2",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "9:1,2",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        LengthValue(Terminated(UInt8[string](), Char[string](':')), SeparatedList1(Digit1[string](), Char[string](','))),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
 	}
+}
+
+func BenchmarkLengthValue(b *testing.B) {
+	p := LengthValue(Terminated(UInt8[string](), Char[string](':')), SeparatedList1(Digit1[string](), Char[string](',')))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("5:1,2,3")
+	}
+}
+
+func TestTLV(t *testing.T) {
+	t.Parallel()
+
+	tlv := TLV(AnyChar[string](), Terminated(UInt8[string](), Char[string](':')), map[rune]Parser[string, string]{'n': Digit1[string](), 'a': Alpha1[string]()})
+
 	testCases := []struct {
 		name          string
-		args          args
+		parser        Parser[string, string]
 		input         string
 		wantErr       bool
 		wantOutput    string
 		wantRemaining string
 	}{
 		{
-			name:  "parsing input with enough characters and partially matching predicated should succeed",
-			input: "latin123",
-			args: args{
-				p: TakeWhileMN[string](3, 6, IsAlpha),
-			},
+			name:          "parsing a record of a registered type should succeed",
+			parser:        tlv,
+			input:         "n3:123abc",
 			wantErr:       false,
-			wantOutput:    "latin",
-			wantRemaining: "123",
+			wantOutput:    "123",
+			wantRemaining: "abc",
 		},
 		{
-			name:  "parsing input longer than atLeast and atMost should succeed",
-			input: "lengthy",
-			args: args{
-				p: TakeWhileMN[string](3, 6, IsAlpha),
-			},
+			name:          "parsing records of different types should succeed",
+			parser:        tlv,
+			input:         "a3:abc123",
 			wantErr:       false,
-			wantOutput:    "length",
-			wantRemaining: "y",
+			wantOutput:    "abc",
+			wantRemaining: "123",
 		},
 		{
-			name:  "parsing input longer than atLeast and shorter than atMost should succeed",
-			input: "latin",
-			args: args{
-				p: TakeWhileMN[string](3, 6, IsAlpha),
-			},
-			wantErr:       false,
-			wantOutput:    "latin",
-			wantRemaining: "",
+			name:          "parsing a record of an unregistered type should fail",
+			parser:        tlv,
+			input:         "x3:123abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "x3:123abc",
 		},
 		{
-			name:  "parsing empty input should fail",
-			input: "",
-			args: args{
-				p: TakeWhileMN[string](3, 6, IsAlpha),
-			},
+			name:          "parsing a record whose value doesn't match its type should fail",
+			parser:        tlv,
+			input:         "n3:abc123",
 			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "",
+			wantRemaining: "n3:abc123",
 		},
 		{
-			name:  "parsing too short input should fail",
-			input: "ed",
-			args: args{
-				p: TakeWhileMN[string](3, 6, IsAlpha),
-			},
+			name:          "parsing a record whose value doesn't fill its length should fail",
+			parser:        tlv,
+			input:         "n3:12a",
 			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "ed",
+			wantRemaining: "n3:12a",
 		},
 		{
-			name:  "parsing with non-matching predicate should fail",
-			input: "12345",
-			args: args{
-				p: TakeWhileMN[string](3, 6, IsAlpha),
-			},
+			name:          "parsing a record longer than the input should fail",
+			parser:        tlv,
+			input:         "n5:123",
 			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "12345",
+			wantRemaining: "n5:123",
+		},
+		{
+			name:          "parsing a record with an invalid length should fail",
+			parser:        tlv,
+			input:         "n:123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "n:123",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        tlv,
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
 		},
 	}
 	for _, tc := range testCases {
@@ -258,14 +355,16 @@ func TestTakeWhileMN(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			gotResult := tc.args.p(tc.input)
+			gotResult := tc.parser(tc.input)
 			if (gotResult.Err != nil) != tc.wantErr {
 				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
 			}
 
-			if gotResult.Output != tc.wantOutput {
-				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
-			}
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
 
 			if gotResult.Remaining != tc.wantRemaining {
 				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
@@ -274,48 +373,46 @@ func TestTakeWhileMN(t *testing.T) {
 	}
 }
 
-func BenchmarkTakeWhileMN(b *testing.B) {
-	p := TakeWhileMN[string](3, 6, IsAlpha)
+func BenchmarkTLV(b *testing.B) {
+	parser := TLV(AnyChar[string](), Terminated(UInt8[string](), Char[string](':')), map[rune]Parser[string, string]{'n': Digit1[string](), 'a': Alpha1[string]()})
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		p("latin")
+		parser("n3:123abc")
 	}
 }
 
-// TakeWhileOneOf parses any number of characters present in the
-// provided collection of runes.
-func TakeWhileOneOf[I Bytes](collection ...rune) Parser[I, I] {
-	index := make(map[rune]struct{}, len(collection))
+func TestLengthPrefixedFatalErrors(t *testing.T) {
+	t.Parallel()
 
-	for _, r := range collection {
-		index[r] = struct{}{}
+	values := map[rune]Parser[string, string]{'n': Digit1[string]()}
+	testCases := []struct {
+		name   string
+		parser Parser[string, string]
+		input  string
+	}{
+		{name: "LengthData", parser: LengthData(Int8[string]()), input: "300abc"},
+		{name: "LengthValue", parser: LengthValue(Int8[string](), Alpha1[string]()), input: "300abc"},
+		{name: "TLV type", parser: TLV(Preceded(AnyChar[string](), Int8[string]()), UInt8[string](), map[int8]Parser[string, string]{}), input: "n300"},
+		{name: "TLV length", parser: TLV(AnyChar[string](), Int8[string](), values), input: "n300"},
 	}
 
-	expected := fmt.Sprintf("chars(%v)", string(collection))
-
-	return func(input I) Result[I, I] {
-		if len(input) == 0 {
-			return Failure[I, I](NewError(input, expected), input)
-		}
-
-		pos := 0
-		for ; pos < len(input); pos++ {
-			_, exists := index[rune(input[pos])]
-			if !exists {
-				if pos == 0 {
-					return Failure[I, I](NewError(input, expected), input)
-				}
+	for _, tc := range testCases {
+		tc := tc
 
-				break
-			}
-		}
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
 
-		return Success(input[:pos], input[pos:])
+			result := tc.parser(tc.input)
+			assert.NotNil(t, result.Err)
+			assert.True(t, result.Err.IsFatal(), "out of range numbers should produce fatal errors")
+			assert.Equal(t, tc.input, result.Remaining)
+		})
 	}
 }
 
-func TestTakeWhileOneOf(t *testing.T) {
+func TestTakeUntil(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
@@ -333,27 +430,37 @@ func TestTakeWhileOneOf(t *testing.T) {
 			name:  "matching parser should succeed",
 			input: "abc123",
 			args: args{
-				p: TakeWhileOneOf[string]('a', 'b', 'c'),
+				p: TakeUntil(Digit1[string]()),
 			},
 			wantErr:       false,
 			wantOutput:    "abc",
 			wantRemaining: "123",
 		},
 		{
-			name:  "no match should fail",
+			name:  "immediately matching parser should succeed",
 			input: "123",
 			args: args{
-				p: TakeWhileOneOf[string]('a', 'b', 'c'),
+				p: TakeUntil(Digit1[string]()),
 			},
-			wantErr:       true,
+			wantErr:       false,
 			wantOutput:    "",
 			wantRemaining: "123",
 		},
+		{
+			name:  "no match should fail",
+			input: "abcdef",
+			args: args{
+				p: TakeUntil(Digit1[string]()),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abcdef",
+		},
 		{
 			name:  "empty input should fail",
 			input: "",
 			args: args{
-				p: TakeWhileOneOf[string]('a', 'b', 'c'),
+				p: TakeUntil(Digit1[string]()),
 			},
 			wantErr:       true,
 			wantOutput:    "",
@@ -382,16 +489,17 @@ func TestTakeWhileOneOf(t *testing.T) {
 	}
 }
 
-func BenchmarkTakeWhileOneOf(b *testing.B) {
-	p := TakeWhileOneOf[string]('a', 'b', 'c')
+func BenchmarkTakeUntil(b *testing.B) {
+	p := TakeUntil(Digit1[string]())
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		p("abc123")
 	}
 }
 
-func TestToken(t *testing.T) {
+func TestTakeUntilIncluding(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -403,31 +511,46 @@ func TestToken(t *testing.T) {
 		wantRemaining string
 	}{
 		{
-			name:          "parsing a token from an input starting with it should succeed",
-			parser:        Token[string]("Bonjour"),
-			input:         "Bonjour tout le monde",
+			name:          "matching parser should succeed and consume it",
+			parser:        TakeUntilIncluding(CRLF[string]()),
+			input:         "abc\r\n123",
 			wantErr:       false,
-			wantOutput:    "Bonjour",
-			wantRemaining: " tout le monde",
+			wantOutput:    "abc",
+			wantRemaining: "123",
 		},
 		{
-			name:          "parsing a token from an non-matching input should fail",
-			parser:        Token[string]("Bonjour"),
-			input:         "Hello tout le monde",
+			name:          "recognized matching parser should include the terminator",
+			parser:        Recognize(TakeUntilIncluding(CRLF[string]())),
+			input:         "abc\r\n123",
+			wantErr:       false,
+			wantOutput:    "abc\r\n",
+			wantRemaining: "123",
+		},
+		{
+			name:          "immediately matching parser should succeed",
+			parser:        TakeUntilIncluding(CRLF[string]()),
+			input:         "\r\n123",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "123",
+		},
+		{
+			name:          "no parser match should fail",
+			parser:        TakeUntilIncluding(CRLF[string]()),
+			input:         "abc123",
 			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "Hello tout le monde",
+			wantRemaining: "abc123",
 		},
 		{
-			name:          "parsing a token from an empty input should fail",
-			parser:        Token[string]("Bonjour"),
+			name:          "empty input should fail",
+			parser:        TakeUntilIncluding(CRLF[string]()),
 			input:         "",
 			wantErr:       true,
 			wantOutput:    "",
 			wantRemaining: "",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -450,10 +573,2025 @@ func TestToken(t *testing.T) {
 	}
 }
 
-func BenchmarkToken(b *testing.B) {
-	parser := Token[string]("Bonjour")
+func BenchmarkTakeUntilIncluding(b *testing.B) {
+	p := TakeUntilIncluding(CRLF[string]())
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("Bonjour tout le monde")
+		p("abc\r\n")
+	}
+}
+
+func TestTakeUntilToken(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "matching token should succeed",
+			parser:        TakeUntilToken[string]("\r\n"),
+			input:         "abc\r\n123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "\r\n123",
+		},
+		{
+			name:          "immediately matching token should succeed",
+			parser:        TakeUntilToken[string]("\r\n"),
+			input:         "\r\n123",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "\r\n123",
+		},
+		{
+			name:          "no token match should fail",
+			parser:        TakeUntilToken[string]("\r\n"),
+			input:         "abc\r123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc\r123",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        TakeUntilToken[string]("\r\n"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestTakeUntilTokenBytes(t *testing.T) {
+	t.Parallel()
+
+	p := TakeUntilToken[[]byte]("\r\n")
+
+	result := p([]byte("abc\r\n123"))
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, []byte("abc"), result.Output)
+	assert.Equal(t, []byte("\r\n123"), result.Remaining)
+}
+
+func BenchmarkTakeUntilToken(b *testing.B) {
+	p := TakeUntilToken[string]("\r\n")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("abc\r\n")
+	}
+}
+
+func TestTakeWhileMN(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		p Parser[string, string]
+	}
+	testCases := []struct {
+		name          string
+		args          args
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:  "parsing input with enough characters and partially matching predicated should succeed",
+			input: "latin123",
+			args: args{
+				p: TakeWhileMN[string](3, 6, IsAlpha),
+			},
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: "123",
+		},
+		{
+			name:  "parsing input longer than atLeast and atMost should succeed",
+			input: "lengthy",
+			args: args{
+				p: TakeWhileMN[string](3, 6, IsAlpha),
+			},
+			wantErr:       false,
+			wantOutput:    "length",
+			wantRemaining: "y",
+		},
+		{
+			name:  "parsing input longer than atLeast and shorter than atMost should succeed",
+			input: "latin",
+			args: args{
+				p: TakeWhileMN[string](3, 6, IsAlpha),
+			},
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: "",
+		},
+		{
+			name:  "parsing empty input should fail",
+			input: "",
+			args: args{
+				p: TakeWhileMN[string](3, 6, IsAlpha),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:  "parsing too short input should fail",
+			input: "ed",
+			args: args{
+				p: TakeWhileMN[string](3, 6, IsAlpha),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "ed",
+		},
+		{
+			name:  "parsing with non-matching predicate should fail",
+			input: "12345",
+			args: args{
+				p: TakeWhileMN[string](3, 6, IsAlpha),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "12345",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.args.p(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkTakeWhileMN(b *testing.B) {
+	p := TakeWhileMN[string](3, 6, IsAlpha)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("latin")
+	}
+}
+
+func TestTakeWhileOneOf(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		p Parser[string, string]
+	}
+	testCases := []struct {
+		name          string
+		args          args
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:  "matching parser should succeed",
+			input: "abc123",
+			args: args{
+				p: TakeWhileOneOf[string]('a', 'b', 'c'),
+			},
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:  "matching the whole input should succeed",
+			input: "cabbac",
+			args: args{
+				p: TakeWhileOneOf[string]('a', 'b', 'c'),
+			},
+			wantErr:       false,
+			wantOutput:    "cabbac",
+			wantRemaining: "",
+		},
+		{
+			name:  "characters beyond a byte should never match",
+			input: "ab中",
+			args: args{
+				p: TakeWhileOneOf[string]('a', 'b', '中'),
+			},
+			wantErr:       false,
+			wantOutput:    "ab",
+			wantRemaining: "中",
+		},
+		{
+			name:  "no match should fail",
+			input: "123",
+			args: args{
+				p: TakeWhileOneOf[string]('a', 'b', 'c'),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "123",
+		},
+		{
+			name:  "empty input should fail",
+			input: "",
+			args: args{
+				p: TakeWhileOneOf[string]('a', 'b', 'c'),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.args.p(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkTakeWhileOneOf(b *testing.B) {
+	p := TakeWhileOneOf[string]('a', 'b', 'c')
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("abc123")
+	}
+}
+
+func TestToken(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a token from an input starting with it should succeed",
+			parser:        Token[string]("Bonjour"),
+			input:         "Bonjour tout le monde",
+			wantErr:       false,
+			wantOutput:    "Bonjour",
+			wantRemaining: " tout le monde",
+		},
+		{
+			name:          "parsing a token from an non-matching input should fail",
+			parser:        Token[string]("Bonjour"),
+			input:         "Hello tout le monde",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "Hello tout le monde",
+		},
+		{
+			name:          "parsing a token from an empty input should fail",
+			parser:        Token[string]("Bonjour"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkToken(b *testing.B) {
+	parser := Token[string]("Bonjour")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("Bonjour tout le monde")
+	}
+}
+
+func TestTokenNoCase(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a token with the same case should succeed",
+			parser:        TokenNoCase[string]("Content-Length"),
+			input:         "Content-Length: 42",
+			wantErr:       false,
+			wantOutput:    "Content-Length",
+			wantRemaining: ": 42",
+		},
+		{
+			name:          "parsing a lowercase token should succeed",
+			parser:        TokenNoCase[string]("Content-Length"),
+			input:         "content-length: 42",
+			wantErr:       false,
+			wantOutput:    "content-length",
+			wantRemaining: ": 42",
+		},
+		{
+			name:          "parsing an uppercase token should succeed",
+			parser:        TokenNoCase[string]("Content-Length"),
+			input:         "CONTENT-LENGTH: 42",
+			wantErr:       false,
+			wantOutput:    "CONTENT-LENGTH",
+			wantRemaining: ": 42",
+		},
+		{
+			name:          "parsing a unicode token with a different case should succeed",
+			parser:        TokenNoCase[string]("élan"),
+			input:         "ÉLAN vital",
+			wantErr:       false,
+			wantOutput:    "ÉLAN",
+			wantRemaining: " vital",
+		},
+		{
+			name:          "parsing a non-matching input should fail",
+			parser:        TokenNoCase[string]("Content-Length"),
+			input:         "Content-Type: text",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "Content-Type: text",
+		},
+		{
+			name:          "parsing a too short input should fail",
+			parser:        TokenNoCase[string]("Content-Length"),
+			input:         "content",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "content",
+		},
+		{
+			name:          "parsing an empty input should fail",
+			parser:        TokenNoCase[string]("Content-Length"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkTokenNoCase(b *testing.B) {
+	parser := TokenNoCase[string]("Content-Length")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("content-length: 42")
+	}
+}
+
+func TestSymbol(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a symbol followed by whitespace should succeed",
+			parser:        Symbol[string]("=>"),
+			input:         "=> \tvalue",
+			wantErr:       false,
+			wantOutput:    "=>",
+			wantRemaining: "value",
+		},
+		{
+			name:          "parsing a symbol without trailing whitespace should succeed",
+			parser:        Symbol[string]("=>"),
+			input:         "=>value",
+			wantErr:       false,
+			wantOutput:    "=>",
+			wantRemaining: "value",
+		},
+		{
+			name:          "parsing a different symbol should fail",
+			parser:        Symbol[string]("=>"),
+			input:         "->value",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "->value",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Symbol[string]("=>"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a symbol with HorizontalWhitespace should leave line endings",
+			parser:        Symbol[string](";", HorizontalWhitespace),
+			input:         "; \nx",
+			wantErr:       false,
+			wantOutput:    ";",
+			wantRemaining: "\nx",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSymbol(b *testing.B) {
+	parser := Symbol[string]("=>")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("=> value")
+	}
+}
+
+func TestKeyword(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a keyword followed by a delimiter should succeed",
+			parser:        Keyword[string]("if"),
+			input:         "if (x)",
+			wantErr:       false,
+			wantOutput:    "if",
+			wantRemaining: " (x)",
+		},
+		{
+			name:          "parsing a keyword followed by punctuation should succeed",
+			parser:        Keyword[string]("if"),
+			input:         "if(x)",
+			wantErr:       false,
+			wantOutput:    "if",
+			wantRemaining: "(x)",
+		},
+		{
+			name:          "parsing a keyword at the end of the input should succeed",
+			parser:        Keyword[string]("if"),
+			input:         "if",
+			wantErr:       false,
+			wantOutput:    "if",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a keyword followed by a letter should fail",
+			parser:        Keyword[string]("if"),
+			input:         "iffy",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "iffy",
+		},
+		{
+			name:          "parsing a keyword followed by a digit should fail",
+			parser:        Keyword[string]("if"),
+			input:         "if2",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "if2",
+		},
+		{
+			name:          "parsing a keyword followed by an underscore should fail",
+			parser:        Keyword[string]("if"),
+			input:         "if_x",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "if_x",
+		},
+		{
+			name:          "parsing a different token should fail",
+			parser:        Keyword[string]("if"),
+			input:         "else",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "else",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Keyword[string]("if"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkKeyword(b *testing.B) {
+	parser := Keyword[string]("if")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("if (x)")
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing an identifier should succeed",
+			parser:        Identifier[string](),
+			input:         "parseInput2 := 1",
+			wantErr:       false,
+			wantOutput:    "parseInput2",
+			wantRemaining: " := 1",
+		},
+		{
+			name:          "parsing an identifier starting with an underscore should succeed",
+			parser:        Identifier[string](),
+			input:         "_tmp",
+			wantErr:       false,
+			wantOutput:    "_tmp",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an identifier starting with a digit should fail",
+			parser:        Identifier[string](),
+			input:         "2x",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "2x",
+		},
+		{
+			name:          "parsing a non-ASCII identifier without WithUnicode should stop before its first non-ASCII letter",
+			parser:        Identifier[string](),
+			input:         "xé",
+			wantErr:       false,
+			wantOutput:    "x",
+			wantRemaining: "é",
+		},
+		{
+			name:          "parsing a non-ASCII identifier without WithUnicode should fail",
+			parser:        Identifier[string](),
+			input:         "δx",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "δx",
+		},
+		{
+			name:          "parsing a non-ASCII identifier with WithUnicode should succeed",
+			parser:        Identifier[string](WithUnicode()),
+			input:         "héllo_δ2 = 1",
+			wantErr:       false,
+			wantOutput:    "héllo_δ2",
+			wantRemaining: " = 1",
+		},
+		{
+			name:          "parsing an identifier starting with a non-ASCII digit with WithUnicode should fail",
+			parser:        Identifier[string](WithUnicode()),
+			input:         "٣x",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "٣x",
+		},
+		{
+			name:          "parsing invalid UTF-8 with WithUnicode should fail",
+			parser:        Identifier[string](WithUnicode()),
+			input:         "\xffx",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\xffx",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Identifier[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkIdentifier(b *testing.B) {
+	parser := Identifier[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("parseInput2 := 1")
+	}
+}
+
+func TestTakeTill(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing until predicate matches should succeed",
+			parser:        TakeTill[string](IsWhitespace),
+			input:         "latin 123",
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: " 123",
+		},
+		{
+			name:          "parsing input with never matching predicate should succeed",
+			parser:        TakeTill[string](IsWhitespace),
+			input:         "latin",
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input with immediately matching predicate should succeed",
+			parser:        TakeTill[string](IsWhitespace),
+			input:         " latin",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: " latin",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        TakeTill[string](IsWhitespace),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkTakeTill(b *testing.B) {
+	p := TakeTill[string](IsWhitespace)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("latin 123")
+	}
+}
+
+func TestTakeTill1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing until predicate matches should succeed",
+			parser:        TakeTill1[string](IsWhitespace),
+			input:         "latin 123",
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: " 123",
+		},
+		{
+			name:          "parsing input with never matching predicate should succeed",
+			parser:        TakeTill1[string](IsWhitespace),
+			input:         "latin",
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input with immediately matching predicate should fail",
+			parser:        TakeTill1[string](IsWhitespace),
+			input:         " latin",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: " latin",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        TakeTill1[string](IsWhitespace),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkTakeTill1(b *testing.B) {
+	p := TakeTill1[string](IsWhitespace)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("latin 123")
+	}
+}
+
+func TestTakeWhileNot(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing until predicate matches should succeed",
+			parser:        TakeWhileNot[string](quoteOrBackslash),
+			input:         "latin\\123",
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: "\\123",
+		},
+		{
+			name:          "parsing input with never matching predicate should succeed",
+			parser:        TakeWhileNot[string](quoteOrBackslash),
+			input:         "latin",
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input with immediately matching predicate should succeed",
+			parser:        TakeWhileNot[string](quoteOrBackslash),
+			input:         "\"latin",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "\"latin",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        TakeWhileNot[string](quoteOrBackslash),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkTakeWhileNot(b *testing.B) {
+	p := TakeWhileNot[string](quoteOrBackslash)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("latin\\123")
+	}
+}
+
+func TestTakeWhileNot1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing until predicate matches should succeed",
+			parser:        TakeWhileNot1[string](quoteOrBackslash),
+			input:         "latin\\123",
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: "\\123",
+		},
+		{
+			name:          "parsing input with never matching predicate should succeed",
+			parser:        TakeWhileNot1[string](quoteOrBackslash),
+			input:         "latin",
+			wantErr:       false,
+			wantOutput:    "latin",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input with immediately matching predicate should fail",
+			parser:        TakeWhileNot1[string](quoteOrBackslash),
+			input:         "\"latin",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\"latin",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        TakeWhileNot1[string](quoteOrBackslash),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkTakeWhileNot1(b *testing.B) {
+	p := TakeWhileNot1[string](quoteOrBackslash)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("latin\\123")
+	}
+}
+
+func quoteOrBackslash(c rune) bool {
+	return c == '"' || c == '\\'
+}
+
+func TestTokenBytesInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[[]byte, []byte]
+		input         []byte
+		wantErr       bool
+		wantOutput    []byte
+		wantRemaining []byte
+	}{
+		{
+			name:          "parsing a token from a byte slice input starting with it should succeed",
+			parser:        Token[[]byte]("Bonjour"),
+			input:         []byte("Bonjour tout le monde"),
+			wantErr:       false,
+			wantOutput:    []byte("Bonjour"),
+			wantRemaining: []byte(" tout le monde"),
+		},
+		{
+			name:          "parsing a byte slice token from a byte slice input starting with it should succeed",
+			parser:        TokenBytes[[]byte]([]byte{0xCA, 0xFE}),
+			input:         []byte{0xCA, 0xFE, 0xBA, 0xBE},
+			wantErr:       false,
+			wantOutput:    []byte{0xCA, 0xFE},
+			wantRemaining: []byte{0xBA, 0xBE},
+		},
+		{
+			name:          "parsing a byte slice token from a non-matching input should fail",
+			parser:        TokenBytes[[]byte]([]byte{0xCA, 0xFE}),
+			input:         []byte{0xBA, 0xBE},
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: []byte{0xBA, 0xBE},
+		},
+		{
+			name:          "parsing a token from an empty byte slice input should fail",
+			parser:        Token[[]byte]("Bonjour"),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+		})
+	}
+}
+
+func BenchmarkTokenBytesInput(b *testing.B) {
+	parser := Token[[]byte]("Bonjour")
+	input := []byte("Bonjour tout le monde")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(input)
+	}
+}
+
+func TestTokenBytesDoesNotAllocate(t *testing.T) {
+	parser := Token[[]byte]("Bonjour")
+	input := []byte("Bonjour tout le monde")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		parser(input)
+	})
+
+	if allocs != 0 {
+		t.Errorf("got %v allocations per run, want 0", allocs)
+	}
+}
+
+func TestKeywords(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing the longest matching keyword should succeed",
+			parser:        Keywords[string]("let", "letrec", "lambda"),
+			input:         "letrec x",
+			wantErr:       false,
+			wantOutput:    "letrec",
+			wantRemaining: " x",
+		},
+		{
+			name:          "parsing a shorter keyword prefixing a longer one should succeed",
+			parser:        Keywords[string]("letrec", "let", "lambda"),
+			input:         "let x",
+			wantErr:       false,
+			wantOutput:    "let",
+			wantRemaining: " x",
+		},
+		{
+			name:          "parsing a partially matching longer keyword should fall back to the shorter one",
+			parser:        Keywords[string]("let", "letrec", "lambda"),
+			input:         "letre",
+			wantErr:       false,
+			wantOutput:    "let",
+			wantRemaining: "re",
+		},
+		{
+			name:          "parsing a non-matching input should fail",
+			parser:        Keywords[string]("let", "letrec", "lambda"),
+			input:         "lam",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "lam",
+		},
+		{
+			name:          "parsing without keywords should fail",
+			parser:        Keywords[string](),
+			input:         "let",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "let",
+		},
+		{
+			name:          "parsing an empty input should fail",
+			parser:        Keywords[string]("let", "letrec", "lambda"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkKeywords(b *testing.B) {
+	parser := Keywords[string]("let", "letrec", "lambda")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("letrec x")
+	}
+}
+
+// enumLevel is a log level, as parsed by the Enum tests.
+type enumLevel int
+
+const (
+	enumDebug enumLevel = iota + 1
+	enumInfo
+	enumWarn
+	enumError
+)
+
+// enumLevels holds the spellings of the log levels parsed by the Enum tests.
+var enumLevels = map[string]enumLevel{
+	"debug":   enumDebug,
+	"info":    enumInfo,
+	"warn":    enumWarn,
+	"warning": enumWarn,
+	"err":     enumError,
+	"error":   enumError,
+}
+
+func TestEnum(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, enumLevel]
+		input         string
+		wantErr       bool
+		wantOutput    enumLevel
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a spelling should succeed",
+			parser:        Enum[string](enumLevels),
+			input:         "debug rest",
+			wantErr:       false,
+			wantOutput:    enumDebug,
+			wantRemaining: " rest",
+		},
+		{
+			name:          "parsing a spelling prefixed by another one should prefer the longest",
+			parser:        Enum[string](enumLevels),
+			input:         "warning: disk full",
+			wantErr:       false,
+			wantOutput:    enumWarn,
+			wantRemaining: ": disk full",
+		},
+		{
+			name:          "parsing a spelling prefix of another one should succeed",
+			parser:        Enum[string](enumLevels),
+			input:         "errno",
+			wantErr:       false,
+			wantOutput:    enumError,
+			wantRemaining: "no",
+		},
+		{
+			name:          "parsing a spelling in another case should fail",
+			parser:        Enum[string](enumLevels),
+			input:         "DEBUG",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "DEBUG",
+		},
+		{
+			name:          "parsing a spelling in another case with WithCaseInsensitive should succeed",
+			parser:        Enum[string](enumLevels, WithCaseInsensitive()),
+			input:         "WaRnInG!",
+			wantErr:       false,
+			wantOutput:    enumWarn,
+			wantRemaining: "!",
+		},
+		{
+			name:          "parsing a non-ASCII spelling in another case with WithCaseInsensitive should succeed",
+			parser:        Enum[string](map[string]enumLevel{"été": enumInfo}, WithCaseInsensitive()),
+			input:         "ÉTÉ!",
+			wantErr:       false,
+			wantOutput:    enumInfo,
+			wantRemaining: "!",
+		},
+		{
+			name:          "parsing a spelling holding a character folding to ASCII with WithCaseInsensitive should succeed",
+			parser:        Enum[string](map[string]enumLevel{"kb": enumInfo}, WithCaseInsensitive()),
+			input:         "\u212ab",
+			wantErr:       false,
+			wantOutput:    enumInfo,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an unknown spelling should fail",
+			parser:        Enum[string](enumLevels),
+			input:         "fatal",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "fatal",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Enum[string](enumLevels),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestEnumBytes(t *testing.T) {
+	t.Parallel()
+
+	result := Enum[[]byte](map[string]bool{"true": true, "false": false}, WithCaseInsensitive())([]byte("FALSE,"))
+	assert.Nil(t, result.Err)
+	assert.False(t, result.Output)
+	assert.Equal(t, []byte(","), result.Remaining)
+}
+
+func TestEnumPanicsOnCaseConflicts(t *testing.T) {
+	t.Parallel()
+
+	assert.NotPanics(t, func() {
+		Enum[string](map[string]int{"yes": 1, "YES": 2})
+	})
+
+	assert.PanicsWithValue(t, `gomme: Enum spellings "YES" and "yes" only differ by their case`, func() {
+		Enum[string](map[string]int{"yes": 1, "YES": 2}, WithCaseInsensitive())
+	})
+}
+
+func BenchmarkEnum(b *testing.B) {
+	parser := Enum[string](enumLevels)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("warning: disk full")
+	}
+}
+
+func BenchmarkEnumCaseInsensitive(b *testing.B) {
+	parser := Enum[string](enumLevels, WithCaseInsensitive())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("WARNING: disk full")
+	}
+}
+
+func TestBalanced(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a balanced region should succeed",
+			parser:        Balanced[string]('(', ')'),
+			input:         "(a + (b * c)) + d",
+			wantErr:       false,
+			wantOutput:    "(a + (b * c))",
+			wantRemaining: " + d",
+		},
+		{
+			name:          "parsing an empty region should succeed",
+			parser:        Balanced[string]('{', '}'),
+			input:         "{}abc",
+			wantErr:       false,
+			wantOutput:    "{}",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing an unbalanced region should fail",
+			parser:        Balanced[string]('(', ')'),
+			input:         "(a + (b * c) + d",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "(a + (b * c) + d",
+		},
+		{
+			name:          "parsing an input not starting with the open delimiter should fail",
+			parser:        Balanced[string]('(', ')'),
+			input:         "a + (b)",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "a + (b)",
+		},
+		{
+			name:          "parsing a balanced region with escaped delimiters should succeed",
+			parser:        BalancedWith[string]('{', '}', '\\'),
+			input:         `{a \} {b}}c`,
+			wantErr:       false,
+			wantOutput:    `{a \} {b}}`,
+			wantRemaining: "c",
+		},
+		{
+			name:          "parsing a region with an escaped closing delimiter should fail",
+			parser:        BalancedWith[string]('{', '}', '\\'),
+			input:         `{a\}`,
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: `{a\}`,
+		},
+		{
+			name:          "parsing an empty input should fail",
+			parser:        Balanced[string]('(', ')'),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkBalanced(b *testing.B) {
+	parser := Balanced[string]('(', ')')
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("(a + (b * c)) + d")
+	}
+}
+
+func TestQuotedString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a quoted string should succeed",
+			parser:        QuotedString[string](),
+			input:         `"abc" rest`,
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: " rest",
+		},
+		{
+			name:          "parsing an empty quoted string should succeed",
+			parser:        QuotedString[string](),
+			input:         `""`,
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing escaped quotes should succeed",
+			parser:        QuotedString[string](),
+			input:         `"say \"hi\""`,
+			wantErr:       false,
+			wantOutput:    `say "hi"`,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing escape sequences should succeed",
+			parser:        QuotedString[string](),
+			input:         `"a\tb\nc\\d"`,
+			wantErr:       false,
+			wantOutput:    "a\tb\nc\\d",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single quoted strings should succeed",
+			parser:        QuotedString[string](WithQuote('\'')),
+			input:         `'it\'s'`,
+			wantErr:       false,
+			wantOutput:    "it's",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing multi-byte characters should succeed",
+			parser:        QuotedString[string](),
+			input:         `"café"`,
+			wantErr:       false,
+			wantOutput:    "café",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an unknown escape sequence should fail",
+			parser:        QuotedString[string](),
+			input:         `"a\qb"`,
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: `"a\qb"`,
+		},
+		{
+			name:          "parsing an unterminated string should fail",
+			parser:        QuotedString[string](),
+			input:         `"abc`,
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: `"abc`,
+		},
+		{
+			name:          "parsing a string ending with an escape should fail",
+			parser:        QuotedString[string](),
+			input:         `"abc\"`,
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: `"abc\"`,
+		},
+		{
+			name:          "parsing unquoted input should fail",
+			parser:        QuotedString[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        QuotedString[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a literal with a custom escape character should succeed",
+			parser:        QuotedString[string](WithEscape('%')),
+			input:         `"a%"b%nc"`,
+			wantErr:       false,
+			wantOutput:    "a\"b\nc",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a literal with doubled quotes should succeed",
+			parser:        QuotedString[string](WithEscape('"')),
+			input:         `"say ""hi"""`,
+			wantErr:       false,
+			wantOutput:    `say "hi"`,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a literal with custom escape sequences should succeed",
+			parser:        QuotedString[string](WithEscapes(map[rune]rune{'s': ' '})),
+			input:         `"a\sb"`,
+			wantErr:       false,
+			wantOutput:    "a b",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an escape sequence missing from custom escapes should fail",
+			parser:        QuotedString[string](WithEscapes(map[rune]rune{'s': ' '})),
+			input:         `"a\nb"`,
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: `"a\nb"`,
+		},
+		{
+			name:          "parsing doubled quotes with a quote escape should succeed",
+			parser:        QuotedString[string](WithEscape('"')),
+			input:         `"a ""b"" c",d`,
+			wantErr:       false,
+			wantOutput:    `a "b" c`,
+			wantRemaining: ",d",
+		},
+		{
+			name:          "parsing a literal without escapes with a quote escape should succeed",
+			parser:        QuotedString[string](WithEscape('"')),
+			input:         `"a,b"`,
+			wantErr:       false,
+			wantOutput:    "a,b",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a doubled quote at the end with a quote escape should succeed",
+			parser:        QuotedString[string](WithEscape('"')),
+			input:         `""""`,
+			wantErr:       false,
+			wantOutput:    `"`,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a literal with custom quote, escape and escapes should succeed",
+			parser:        QuotedString[string](WithQuote('\''), WithEscape('%'), WithEscapes(map[rune]rune{'n': '\n'})),
+			input:         `'a%nb%%c%'d'`,
+			wantErr:       false,
+			wantOutput:    "a\nb%c'd",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an unknown escape sequence with custom escapes should fail",
+			parser:        QuotedString[string](WithQuote('\''), WithEscape('%'), WithEscapes(map[rune]rune{'n': '\n'})),
+			input:         `'a%tb'`,
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: `'a%tb'`,
+		},
+		{
+			name:          "parsing an unterminated literal with a quote escape should fail",
+			parser:        QuotedString[string](WithEscape('"')),
+			input:         `"abc""`,
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: `"abc""`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkQuotedString(b *testing.B) {
+	parser := QuotedString[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(`"say \"hi\""`)
+	}
+}
+
+func BenchmarkQuotedStringWithOptions(b *testing.B) {
+	parser := QuotedString[string](WithEscape('"'))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(`"a ""b"" c",d`)
+	}
+}
+
+func TestQuotedStringBytes(t *testing.T) {
+	t.Parallel()
+
+	result := QuotedString[[]byte]()([]byte(`"a\"b" c`))
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, `a"b`, result.Output)
+	assert.Equal(t, []byte(" c"), result.Remaining)
+}
+
+func TestCommentLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a line comment should succeed",
+			parser:        CommentLine[string]("//"),
+			input:         "// note\nx := 1",
+			wantErr:       false,
+			wantOutput:    "// note",
+			wantRemaining: "\nx := 1",
+		},
+		{
+			name:          "parsing a line comment ending with CRLF should succeed",
+			parser:        CommentLine[string]("#"),
+			input:         "# note\r\nkey",
+			wantErr:       false,
+			wantOutput:    "# note",
+			wantRemaining: "\r\nkey",
+		},
+		{
+			name:          "parsing a line comment ending the input should succeed",
+			parser:        CommentLine[string]("#"),
+			input:         "# note",
+			wantErr:       false,
+			wantOutput:    "# note",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an empty line comment should succeed",
+			parser:        CommentLine[string]("#"),
+			input:         "#\n",
+			wantErr:       false,
+			wantOutput:    "#",
+			wantRemaining: "\n",
+		},
+		{
+			name:          "parsing input without the marker should fail",
+			parser:        CommentLine[string]("//"),
+			input:         "/ note",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "/ note",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CommentLine[string]("//"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkCommentLine(b *testing.B) {
+	parser := CommentLine[string]("//")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("// note\nx := 1")
+	}
+}
+
+func TestCommentBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a block comment should succeed",
+			parser:        CommentBlock[string]("/*", "*/"),
+			input:         "/* a\nb */x",
+			wantErr:       false,
+			wantOutput:    "/* a\nb */",
+			wantRemaining: "x",
+		},
+		{
+			name:          "parsing an empty block comment should succeed",
+			parser:        CommentBlock[string]("/*", "*/"),
+			input:         "/**/x",
+			wantErr:       false,
+			wantOutput:    "/**/",
+			wantRemaining: "x",
+		},
+		{
+			name:          "parsing a block comment should stop at the first close marker",
+			parser:        CommentBlock[string]("/*", "*/"),
+			input:         "/* a /* b */ c */",
+			wantErr:       false,
+			wantOutput:    "/* a /* b */",
+			wantRemaining: " c */",
+		},
+		{
+			name:          "parsing an unterminated block comment should fail",
+			parser:        CommentBlock[string]("/*", "*/"),
+			input:         "/* a",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "/* a",
+		},
+		{
+			name:          "parsing input without the open marker should fail",
+			parser:        CommentBlock[string]("/*", "*/"),
+			input:         "x /* a */",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "x /* a */",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CommentBlock[string]("/*", "*/"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkCommentBlock(b *testing.B) {
+	parser := CommentBlock[string]("/*", "*/")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("/* a\nb */x")
+	}
+}
+
+func TestCommentBlockNested(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a nested block comment should succeed",
+			parser:        CommentBlockNested[string]("/*", "*/"),
+			input:         "/* a /* b */ c */x",
+			wantErr:       false,
+			wantOutput:    "/* a /* b */ c */",
+			wantRemaining: "x",
+		},
+		{
+			name:          "parsing a flat block comment should succeed",
+			parser:        CommentBlockNested[string]("(*", "*)"),
+			input:         "(* a *)x",
+			wantErr:       false,
+			wantOutput:    "(* a *)",
+			wantRemaining: "x",
+		},
+		{
+			name:          "parsing an unbalanced nested block comment should fail",
+			parser:        CommentBlockNested[string]("/*", "*/"),
+			input:         "/* a /* b */ c",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "/* a /* b */ c",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CommentBlockNested[string]("/*", "*/"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkCommentBlockNested(b *testing.B) {
+	parser := CommentBlockNested[string]("/*", "*/")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("/* a /* b */ c */x")
 	}
 }
@@ -2,98 +2,201 @@ package gomme
 
 import (
 	"strconv"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Char parses a single character and matches it with
 // a provided candidate.
 func Char[Input Bytes](character rune) Parser[Input, rune] {
+	expected := string(character)
+
+	failure := newSharedError[Input](expected)
+
 	return func(input Input) Result[rune, Input] {
 		if len(input) == 0 || rune(input[0]) != character {
-			return Failure[Input, rune](NewError(input, string(character)), input)
+			return Failure[Input, rune](failure, input)
 		}
 
 		return Success(rune(input[0]), input[1:])
 	}
 }
 
-// AnyChar parses any single character.
-func AnyChar[Input Bytes]() Parser[Input, rune] {
+// NotChar parses any single character, as long as it differs from the provided
+// one.
+func NotChar[Input Bytes](character rune) Parser[Input, rune] {
+	failure := newSharedError[Input]("NotChar")
+
 	return func(input Input) Result[rune, Input] {
-		if len(input) == 0 {
-			return Failure[Input, rune](NewError(input, "AnyChar"), input)
+		if len(input) == 0 || rune(input[0]) == character {
+			return Failure[Input, rune](failure, input)
 		}
 
 		return Success(rune(input[0]), input[1:])
 	}
 }
 
-// Alpha0 parses a zero or more lowercase or uppercase alphabetic characters: a-z, A-Z.
-// In the cases where the input is empty, or no terminating character is found, the parser
-// returns the input as is.
-func Alpha0[Input Bytes]() Parser[Input, Input] {
-	return func(input Input) Result[Input, Input] {
+// CharNoCase parses a single character, and matches it with a provided candidate
+// regardless of its case. The character is decoded as UTF-8, and compared using
+// Unicode simple case folding; 'k' thus matches 'K', as well as the Kelvin sign.
+func CharNoCase[Input Bytes](character rune) Parser[Input, rune] {
+	failure := newSharedError[Input]("CharNoCase")
+
+	return func(input Input) Result[rune, Input] {
 		if len(input) == 0 {
-			return Success(input, input)
+			return Failure[Input, rune](failure, input)
 		}
 
-		lastAlphaPos := 0
-		for idx := 0; idx < len(input); idx++ {
-			if !IsAlpha(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
-			}
+		c, size := decodeRune(input)
+		if !equalFoldRune(c, character) {
+			return Failure[Input, rune](failure, input)
+		}
 
-			lastAlphaPos++
+		return Success(c, input[size:])
+	}
+}
+
+// equalFoldRune reports whether two characters are equal under Unicode simple
+// case folding.
+func equalFoldRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+
+	for folded := unicode.SimpleFold(a); folded != a; folded = unicode.SimpleFold(folded) {
+		if folded == b {
+			return true
+		}
+	}
+
+	return false
+}
+
+// foldRune returns the canonical form of the provided character under Unicode
+// simple case folding: the smallest of the characters equivalent to it, so that
+// two characters are equal regardless of case if their canonical forms are.
+func foldRune(c rune) rune {
+	if c < utf8.RuneSelf {
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
 		}
 
-		return Success(input[:lastAlphaPos], input[lastAlphaPos:])
+		return c
 	}
+
+	canonical := c
+	for folded := unicode.SimpleFold(c); folded != c; folded = unicode.SimpleFold(folded) {
+		if folded < canonical {
+			canonical = folded
+		}
+	}
+
+	return canonical
 }
 
-// Alpha1 parses one or more lowercase or uppercase alphabetic characters: a-z, A-Z.
-// In the cases where the input doesn't hold enough data, or a terminating character
-// is found before any matching ones were, the parser returns an error result.
-func Alpha1[Input Bytes]() Parser[Input, Input] {
-	return func(input Input) Result[Input, Input] {
+// CharRange parses a single character, and ensures it lies within the inclusive
+// `low` and `high` bounds. The character is decoded as UTF-8, which allows to match
+// ranges beyond ASCII, such as the CJK unified ideographs' `0x4E00`-`0x9FFF` range.
+func CharRange[Input Bytes](low, high rune) Parser[Input, rune] {
+	return CharRanges[Input](RuneRange{Low: low, High: high})
+}
+
+// RuneRange represents an inclusive range of characters, as matched by CharRanges.
+type RuneRange struct {
+	Low  rune
+	High rune
+}
+
+// CharRanges parses a single character, and ensures it lies within at least one of
+// the provided inclusive ranges. The character is decoded as UTF-8: invalid UTF-8
+// never matches, even if a range holds utf8.RuneError.
+func CharRanges[Input Bytes](ranges ...RuneRange) Parser[Input, rune] {
+	failure := newSharedError[Input]("CharRange")
+
+	return func(input Input) Result[rune, Input] {
 		if len(input) == 0 {
-			return Failure[Input, Input](NewError(input, "Alpha1"), input)
+			return Failure[Input, rune](failure, input)
 		}
 
-		if !IsAlpha(rune(input[0])) {
-			return Failure[Input, Input](NewError(input, "Alpha1"), input)
+		c, size := decodeRune(input)
+		if c == utf8.RuneError && size <= 1 {
+			return Failure[Input, rune](failure, input)
 		}
 
-		lastAlphaPos := 1
-		for idx := 1; idx < len(input); idx++ {
-			if !IsAlpha(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
+		for _, r := range ranges {
+			if c >= r.Low && c <= r.High {
+				return Success(c, input[size:])
 			}
+		}
+
+		return Failure[Input, rune](failure, input)
+	}
+}
+
+// AnyChar parses any single character.
+func AnyChar[Input Bytes]() Parser[Input, rune] {
+	failure := newSharedError[Input]("AnyChar")
 
-			lastAlphaPos++
+	return func(input Input) Result[rune, Input] {
+		if len(input) == 0 {
+			return Failure[Input, rune](failure, input)
 		}
 
-		return Success(input[:lastAlphaPos], input[lastAlphaPos:])
+		return Success(rune(input[0]), input[1:])
 	}
 }
 
-// Alphanumeric0 parses zero or more ASCII alphabetical or numerical characters: a-z, A-Z, 0-9.
+// AnyRune parses any single UTF-8 encoded character, consuming as many bytes
+// as its encoding spans. Unlike AnyChar, which consumes a single byte, the
+// input is decoded as UTF-8. Invalid encodings produce an error result.
+func AnyRune[Input Bytes]() Parser[Input, rune] {
+	failure := newSharedError[Input]("AnyRune")
+	invalid := newSharedError[Input]("valid UTF-8 rune")
+
+	return func(input Input) Result[rune, Input] {
+		if len(input) == 0 {
+			return Failure[Input, rune](failure, input)
+		}
+
+		c, size := decodeRune(input)
+		if c == utf8.RuneError && size <= 1 {
+			return Failure[Input, rune](invalid, input)
+		}
+
+		return Success(c, input[size:])
+	}
+}
+
+// Alpha0 parses a zero or more lowercase or uppercase alphabetic characters: a-z, A-Z.
 // In the cases where the input is empty, or no terminating character is found, the parser
 // returns the input as is.
-func Alphanumeric0[Input Bytes]() Parser[Input, Input] {
+func Alpha0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Alpha0")
+
 	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 {
-			return Success(input, input)
-		}
+		return takeClass(input, classAlpha, false, failure)
+	}
+}
 
-		lastDigitPos := 0
-		for idx := 0; idx < len(input); idx++ {
-			if !IsAlphanumeric(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
-			}
+// Alpha1 parses one or more lowercase or uppercase alphabetic characters: a-z, A-Z.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func Alpha1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Alpha1")
 
-			lastDigitPos++
-		}
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classAlpha, true, failure)
+	}
+}
+
+// Alphanumeric0 parses zero or more ASCII alphabetical or numerical characters: a-z, A-Z, 0-9.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func Alphanumeric0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Alphanumeric0")
 
-		return Success(input[:lastDigitPos], input[lastDigitPos:])
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classAlpha|classDigit, false, failure)
 	}
 }
 
@@ -101,177 +204,418 @@ func Alphanumeric0[Input Bytes]() Parser[Input, Input] {
 // In the cases where the input doesn't hold enough data, or a terminating character
 // is found before any matching ones were, the parser returns an error result.
 func Alphanumeric1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Digit1")
+
 	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 {
-			return Failure[Input, Input](NewError(input, "Digit1"), input)
-		}
+		return takeClass(input, classAlpha|classDigit, true, failure)
+	}
+}
 
-		if !IsAlphanumeric(rune(input[0])) {
-			return Failure[Input, Input](NewError(input, "Digit1"), input)
-		}
+// Digit0 parses zero or more ASCII numerical characters: 0-9.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func Digit0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Digit0")
 
-		lastDigitPos := 1
-		for idx := 1; idx < len(input); idx++ {
-			if !IsAlphanumeric(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
-			}
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classDigit, false, failure)
+	}
+}
 
-			lastDigitPos++
-		}
+// Digit1 parses one or more numerical characters: 0-9.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func Digit1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Digit1")
 
-		return Success(input[:lastDigitPos], input[lastDigitPos:])
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classDigit, true, failure)
 	}
 }
 
-// Digit0 parses zero or more ASCII numerical characters: 0-9.
+// HexDigit0 parses zero or more ASCII hexadecimal characters: a-f, A-F, 0-9.
 // In the cases where the input is empty, or no terminating character is found, the parser
 // returns the input as is.
-func Digit0[Input Bytes]() Parser[Input, Input] {
+func HexDigit0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("HexDigit0")
+
 	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 {
-			return Success(input, input)
-		}
+		return takeClass(input, classHexDigit, false, failure)
+	}
+}
 
-		lastDigitPos := 0
-		for idx := 0; idx < len(input); idx++ {
-			if !IsDigit(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
-			}
+// HexDigit1 parses one or more ASCII hexadecimal characters: a-f, A-F, 0-9.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func HexDigit1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("HexDigit1")
 
-			lastDigitPos++
-		}
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classHexDigit, true, failure)
+	}
+}
 
-		return Success(input[:lastDigitPos], input[lastDigitPos:])
+// OctDigit0 parses zero or more ASCII octal characters: 0-7.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func OctDigit0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("OctDigit0")
+
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classOctDigit, false, failure)
 	}
 }
 
-// Digit1 parses one or more numerical characters: 0-9.
+// OctDigit1 parses one or more ASCII octal characters: 0-7.
 // In the cases where the input doesn't hold enough data, or a terminating character
 // is found before any matching ones were, the parser returns an error result.
-func Digit1[Input Bytes]() Parser[Input, Input] {
+func OctDigit1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("OctDigit1")
+
 	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 {
-			return Failure[Input, Input](NewError(input, "Digit1"), input)
+		return takeClass(input, classOctDigit, true, failure)
+	}
+}
+
+// BinDigit0 parses zero or more ASCII binary characters: 0-1.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func BinDigit0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("BinDigit0")
+
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classBinDigit, false, failure)
+	}
+}
+
+// BinDigit1 parses one or more ASCII binary characters: 0-1.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func BinDigit1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("BinDigit1")
+
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classBinDigit, true, failure)
+	}
+}
+
+// WhitespacePolicy reports whether a character is insignificant whitespace in a
+// format. Whitespace0, Whitespace1, and the combinators skipping whitespace, such
+// as Lexeme, Padded and Symbol, can be provided with policies, so that formats
+// which, for instance, treat line endings as significant, can be expressed
+// directly. Policies are predicates, so that any function reporting whether a
+// rune is whitespace, such as unicode.IsSpace, can be used as one.
+type WhitespacePolicy func(c rune) bool
+
+// HorizontalWhitespace is a WhitespacePolicy treating spaces and tabs as
+// whitespace, but not line endings.
+var HorizontalWhitespace = WhitespaceRunes(' ', '\t')
+
+// WhitespaceRunes returns a WhitespacePolicy treating the provided characters,
+// and them only, as whitespace.
+func WhitespaceRunes(runes ...rune) WhitespacePolicy {
+	var ascii [utf8.RuneSelf]bool
+	var others []rune
+	for _, r := range runes {
+		if 0 <= r && r < utf8.RuneSelf {
+			ascii[r] = true
+		} else {
+			others = append(others, r)
 		}
+	}
 
-		if !IsDigit(rune(input[0])) {
-			return Failure[Input, Input](NewError(input, "Digit1"), input)
+	return func(c rune) bool {
+		if 0 <= c && c < utf8.RuneSelf {
+			return ascii[c]
 		}
 
-		lastDigitPos := 1
-		for idx := 1; idx < len(input); idx++ {
-			if !IsDigit(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
+		for _, other := range others {
+			if c == other {
+				return true
 			}
+		}
+
+		return false
+	}
+}
+
+// whitespacePolicy combines the provided policies into a single one, treating a
+// character as whitespace if any of them does.
+func whitespacePolicy(policies []WhitespacePolicy) WhitespacePolicy {
+	if len(policies) == 1 {
+		return policies[0]
+	}
 
-			lastDigitPos++
+	return func(c rune) bool {
+		for _, policy := range policies {
+			if policy(c) {
+				return true
+			}
 		}
 
-		return Success(input[:lastDigitPos], input[lastDigitPos:])
+		return false
 	}
 }
 
-// HexDigit0 parses zero or more ASCII hexadecimal characters: a-f, A-F, 0-9.
+// Whitespace0 parses zero or more whitespace characters: ' ', '\t', '\n', '\r'.
+// When whitespace policies are provided, it parses the characters any of them
+// treats as whitespace instead.
 // In the cases where the input is empty, or no terminating character is found, the parser
 // returns the input as is.
-func HexDigit0[Input Bytes]() Parser[Input, Input] {
+func Whitespace0[Input Bytes](policies ...WhitespacePolicy) Parser[Input, Input] {
+	failure := newSharedError[Input]("Whitespace0")
+
+	if len(policies) > 0 {
+		isSpace := whitespacePolicy(policies)
+
+		return func(input Input) Result[Input, Input] {
+			return takeWhileRunes(input, isSpace, false, failure)
+		}
+	}
+
 	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 {
-			return Success(input, input)
+		return takeClass(input, classWhitespace, false, failure)
+	}
+}
+
+// Whitespace1 parses one or more whitespace characters: ' ', '\t', '\n', '\r'.
+// When whitespace policies are provided, it parses the characters any of them
+// treats as whitespace instead.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func Whitespace1[Input Bytes](policies ...WhitespacePolicy) Parser[Input, Input] {
+	failure := newSharedError[Input]("WhiteSpace1")
+
+	if len(policies) > 0 {
+		isSpace := whitespacePolicy(policies)
+
+		return func(input Input) Result[Input, Input] {
+			return takeWhileRunes(input, isSpace, true, failure)
 		}
+	}
 
-		lastDigitPos := 0
-		for idx := 0; idx < len(input); idx++ {
-			if !IsHexDigit(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
-			}
+	return func(input Input) Result[Input, Input] {
+		return takeClass(input, classWhitespace, true, failure)
+	}
+}
 
-			lastDigitPos++
+// Control parses a single ASCII control character: 0x00-0x1F and 0x7F.
+func Control[Input Bytes]() Parser[Input, rune] {
+	failure := newSharedError[Input]("Control")
+
+	return func(input Input) Result[rune, Input] {
+		if len(input) == 0 || !IsControl(rune(input[0])) {
+			return Failure[Input, rune](failure, input)
 		}
 
-		return Success(input[:lastDigitPos], input[lastDigitPos:])
+		return Success(rune(input[0]), input[1:])
 	}
 }
 
-// HexDigit1 parses one or more ASCII hexadecimal characters: a-f, A-F, 0-9.
+// Control0 parses zero or more ASCII control characters: 0x00-0x1F and 0x7F.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func Control0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Control0")
+
+	return func(input Input) Result[Input, Input] {
+		return takeWhileBytes(input, IsControl, false, failure)
+	}
+}
+
+// Control1 parses one or more ASCII control characters: 0x00-0x1F and 0x7F.
 // In the cases where the input doesn't hold enough data, or a terminating character
 // is found before any matching ones were, the parser returns an error result.
-func HexDigit1[Input Bytes]() Parser[Input, Input] {
+func Control1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Control1")
+
 	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 {
-			return Failure[Input, Input](NewError(input, "HexDigit1"), input)
-		}
+		return takeWhileBytes(input, IsControl, true, failure)
+	}
+}
+
+// Printable parses a single printable ASCII character: 0x20-0x7E.
+func Printable[Input Bytes]() Parser[Input, rune] {
+	failure := newSharedError[Input]("Printable")
 
-		if !IsHexDigit(rune(input[0])) {
-			return Failure[Input, Input](NewError(input, "HexDigit1"), input)
+	return func(input Input) Result[rune, Input] {
+		if len(input) == 0 || !IsPrintable(rune(input[0])) {
+			return Failure[Input, rune](failure, input)
 		}
 
-		lastDigitPos := 1
-		for idx := 1; idx < len(input); idx++ {
-			if !IsHexDigit(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
-			}
+		return Success(rune(input[0]), input[1:])
+	}
+}
 
-			lastDigitPos++
-		}
+// Printable0 parses zero or more printable ASCII characters: 0x20-0x7E.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func Printable0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Printable0")
 
-		return Success(input[:lastDigitPos], input[lastDigitPos:])
+	return func(input Input) Result[Input, Input] {
+		return takeWhileBytes(input, IsPrintable, false, failure)
 	}
 }
 
-// Whitespace0 parses zero or more whitespace characters: ' ', '\t', '\n', '\r'.
+// Printable1 parses one or more printable ASCII characters: 0x20-0x7E.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func Printable1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Printable1")
+
+	return func(input Input) Result[Input, Input] {
+		return takeWhileBytes(input, IsPrintable, true, failure)
+	}
+}
+
+// takeWhileBytes consumes bytes for as long as they satisfy the provided predicate.
+// If `required` is true, at least one byte must be consumed. The provided
+// failure is reported when the input doesn't match.
+func takeWhileBytes[Input Bytes](input Input, predicate func(rune) bool, required bool, failure *Error[Input]) Result[Input, Input] {
+	pos := 0
+	for pos < len(input) && predicate(rune(input[pos])) {
+		pos++
+	}
+
+	if required && pos == 0 {
+		return Failure[Input, Input](failure, input)
+	}
+
+	return Success(input[:pos], input[pos:])
+}
+
+// AlphaUnicode0 parses zero or more Unicode letters, as defined by unicode.IsLetter.
+// Unlike Alpha0, the input is decoded as UTF-8, and is not restricted to ASCII.
 // In the cases where the input is empty, or no terminating character is found, the parser
 // returns the input as is.
-func Whitespace0[Input Bytes]() Parser[Input, Input] {
+func AlphaUnicode0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("AlphaUnicode0")
+
 	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 {
-			return Success(input, input)
-		}
+		return takeWhileRunes(input, unicode.IsLetter, false, failure)
+	}
+}
 
-		lastPos := 0
-		for idx := 0; idx < len(input); idx++ {
-			if !IsWhitespace(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
-			}
+// AlphaUnicode1 parses one or more Unicode letters, as defined by unicode.IsLetter.
+// Unlike Alpha1, the input is decoded as UTF-8, and is not restricted to ASCII.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func AlphaUnicode1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("AlphaUnicode1")
 
-			lastPos++
-		}
+	return func(input Input) Result[Input, Input] {
+		return takeWhileRunes(input, unicode.IsLetter, true, failure)
+	}
+}
+
+// DigitUnicode0 parses zero or more Unicode decimal digits, as defined by unicode.IsDigit.
+// Unlike Digit0, the input is decoded as UTF-8, and is not restricted to ASCII.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func DigitUnicode0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("DigitUnicode0")
 
-		return Success(input[:lastPos], input[lastPos:])
+	return func(input Input) Result[Input, Input] {
+		return takeWhileRunes(input, unicode.IsDigit, false, failure)
 	}
 }
 
-// Whitespace1 parses one or more whitespace characters: ' ', '\t', '\n', '\r'.
+// DigitUnicode1 parses one or more Unicode decimal digits, as defined by unicode.IsDigit.
+// Unlike Digit1, the input is decoded as UTF-8, and is not restricted to ASCII.
 // In the cases where the input doesn't hold enough data, or a terminating character
 // is found before any matching ones were, the parser returns an error result.
-func Whitespace1[Input Bytes]() Parser[Input, Input] {
+func DigitUnicode1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("DigitUnicode1")
+
 	return func(input Input) Result[Input, Input] {
-		if len(input) == 0 {
-			return Failure[Input, Input](NewError(input, "WhiteSpace1"), input)
-		}
+		return takeWhileRunes(input, unicode.IsDigit, true, failure)
+	}
+}
 
-		if !IsWhitespace(rune(input[0])) {
-			return Failure[Input, Input](NewError(input, "WhiteSpace1"), input)
-		}
+// AlphanumericUnicode0 parses zero or more Unicode letters or decimal digits.
+// Unlike Alphanumeric0, the input is decoded as UTF-8, and is not restricted to ASCII.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func AlphanumericUnicode0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("AlphanumericUnicode0")
 
-		lastPos := 1
-		for idx := 1; idx < len(input); idx++ {
-			if !IsWhitespace(rune(input[idx])) {
-				return Success(input[:idx], input[idx:])
-			}
+	return func(input Input) Result[Input, Input] {
+		return takeWhileRunes(input, isUnicodeAlphanumeric, false, failure)
+	}
+}
+
+// AlphanumericUnicode1 parses one or more Unicode letters or decimal digits.
+// Unlike Alphanumeric1, the input is decoded as UTF-8, and is not restricted to ASCII.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func AlphanumericUnicode1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("AlphanumericUnicode1")
+
+	return func(input Input) Result[Input, Input] {
+		return takeWhileRunes(input, isUnicodeAlphanumeric, true, failure)
+	}
+}
+
+// UnicodeWhitespace0 parses zero or more Unicode whitespace characters, as defined by
+// unicode.IsSpace, such as the no-break space or the ideographic space. Unlike
+// Whitespace0, the input is decoded as UTF-8, and is not restricted to ASCII.
+// In the cases where the input is empty, or no terminating character is found, the parser
+// returns the input as is.
+func UnicodeWhitespace0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("UnicodeWhitespace0")
 
-			lastPos++
+	return func(input Input) Result[Input, Input] {
+		return takeWhileRunes(input, unicode.IsSpace, false, failure)
+	}
+}
+
+// UnicodeWhitespace1 parses one or more Unicode whitespace characters, as defined by
+// unicode.IsSpace, such as the no-break space or the ideographic space. Unlike
+// Whitespace1, the input is decoded as UTF-8, and is not restricted to ASCII.
+// In the cases where the input doesn't hold enough data, or a terminating character
+// is found before any matching ones were, the parser returns an error result.
+func UnicodeWhitespace1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("UnicodeWhitespace1")
+
+	return func(input Input) Result[Input, Input] {
+		return takeWhileRunes(input, unicode.IsSpace, true, failure)
+	}
+}
+
+func isUnicodeAlphanumeric(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+// takeWhileRunes decodes the input as UTF-8, and consumes characters for as long
+// as they satisfy the provided predicate. Invalid encodings never satisfy it. If
+// `required` is true, at least one character must be consumed. The provided
+// failure is reported when the input doesn't match.
+func takeWhileRunes[Input Bytes](input Input, predicate func(rune) bool, required bool, failure *Error[Input]) Result[Input, Input] {
+	pos := 0
+	for pos < len(input) {
+		c, size := decodeRune(input[pos:])
+		if (c == utf8.RuneError && size <= 1) || !predicate(c) {
+			break
 		}
 
-		return Success(input[:lastPos], input[lastPos:])
+		pos += size
+	}
+
+	if required && pos == 0 {
+		return Failure[Input, Input](failure, input)
 	}
+
+	return Success(input[:pos], input[pos:])
 }
 
 // LF parses a line feed `\n` character.
 func LF[Input Bytes]() Parser[Input, rune] {
+	failure := newSharedError[Input]("LF")
+
 	return func(input Input) Result[rune, Input] {
 		if len(input) == 0 || input[0] != '\n' {
-			return Failure[Input, rune](NewError(input, "LF"), input)
+			return Failure[Input, rune](failure, input)
 		}
 
 		return Success(rune(input[0]), input[1:])
@@ -280,9 +624,11 @@ func LF[Input Bytes]() Parser[Input, rune] {
 
 // CR parses a carriage return `\r` character.
 func CR[Input Bytes]() Parser[Input, rune] {
+	failure := newSharedError[Input]("CR")
+
 	return func(input Input) Result[rune, Input] {
 		if len(input) == 0 || input[0] != '\r' {
-			return Failure[Input, rune](NewError(input, "CR"), input)
+			return Failure[Input, rune](failure, input)
 		}
 
 		return Success(rune(input[0]), input[1:])
@@ -291,52 +637,290 @@ func CR[Input Bytes]() Parser[Input, rune] {
 
 // CRLF parses the string `\r\n`.
 func CRLF[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("CRLF")
+
 	return func(input Input) Result[Input, Input] {
 		if len(input) < 2 || (input[0] != '\r' || input[1] != '\n') {
-			return Failure[Input, Input](NewError(input, "CRLF"), input)
+			return Failure[Input, Input](failure, input)
 		}
 
 		return Success(input[:2], input[2:])
 	}
 }
 
+// Newline parses either a line feed `\n` character, or a `\r\n` sequence, and
+// returns the part of the input that matched; allowing callers to tell which of
+// the two line endings was found.
+func Newline[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Newline")
+
+	return func(input Input) Result[Input, Input] {
+		if len(input) > 0 && input[0] == '\n' {
+			return Success(input[:1], input[1:])
+		}
+
+		if len(input) > 1 && input[0] == '\r' && input[1] == '\n' {
+			return Success(input[:2], input[2:])
+		}
+
+		return Failure[Input, Input](failure, input)
+	}
+}
+
+// LineEnding parses a line ending: either a `\r\n` sequence, a line feed `\n`, or a
+// lone carriage return `\r`, and returns the part of the input that matched. Paired
+// with NotLineEnding0 or NotLineEnding1, it allows parsing lines regardless of the
+// convention their endings follow.
+func LineEnding[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("LineEnding")
+
+	return func(input Input) Result[Input, Input] {
+		if len(input) > 1 && input[0] == '\r' && input[1] == '\n' {
+			return Success(input[:2], input[2:])
+		}
+
+		if len(input) > 0 && (input[0] == '\n' || input[0] == '\r') {
+			return Success(input[:1], input[1:])
+		}
+
+		return Failure[Input, Input](failure, input)
+	}
+}
+
+// NotLineEnding0 parses zero or more characters up to, but excluding, the next `\r`
+// or `\n` character. In the cases where the input is empty, or no line ending is
+// found, the parser returns the input as is.
+func NotLineEnding0[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("NotLineEnding0")
+
+	return func(input Input) Result[Input, Input] {
+		return takeTill(input, isLineEnding, false, failure)
+	}
+}
+
+// NotLineEnding1 parses one or more characters up to, but excluding, the next `\r`
+// or `\n` character. In the cases where the input is empty, or starts with a line
+// ending, the parser returns an error result.
+func NotLineEnding1[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("NotLineEnding1")
+
+	return func(input Input) Result[Input, Input] {
+		return takeTill(input, isLineEnding, true, failure)
+	}
+}
+
+// Line parses the contents of the current line, up to, but excluding, its line
+// ending, and consumes the line ending, if any: either a `\r\n` sequence, a line
+// feed `\n`, or a lone carriage return `\r`, as LineEnding does. The last line
+// of the input needs not be terminated. Line is the building block of line
+// oriented formats, such as logs, CSV files, or configuration files.
+// If the input is empty, the parser returns an error result.
+func Line[Input Bytes]() Parser[Input, Input] {
+	failure := newSharedError[Input]("Line")
+
+	return func(input Input) Result[Input, Input] {
+		if len(input) == 0 {
+			return Failure[Input, Input](failure, input)
+		}
+
+		end := 0
+		for end < len(input) && input[end] != '\n' && input[end] != '\r' {
+			end++
+		}
+
+		next := end
+		if next < len(input) {
+			next++
+			if input[end] == '\r' && next < len(input) && input[next] == '\n' {
+				next++
+			}
+		}
+
+		return Success(input[:end], input[next:])
+	}
+}
+
+func isLineEnding(c rune) bool {
+	return c == '\r' || c == '\n'
+}
+
 // OneOf parses a single character from the given set of characters.
+//
+// The set is turned into a bitmap when the parser is constructed, so that
+// checking the membership of a character doesn't depend on the set's size.
 func OneOf[Input Bytes](collection ...rune) Parser[Input, rune] {
+	set := newByteSet(collection)
+
+	failure := newSharedError[Input]("OneOf")
+
 	return func(input Input) Result[rune, Input] {
-		if len(input) == 0 {
-			return Failure[Input, rune](NewError(input, "OneOf"), input)
+		if len(input) == 0 || !set.contains(input[0]) {
+			return Failure[Input, rune](failure, input)
 		}
 
-		for _, c := range collection {
-			if rune(input[0]) == c {
-				return Success(rune(input[0]), input[1:])
-			}
+		return Success(rune(input[0]), input[1:])
+	}
+}
+
+// OneOfString parses a single character from the set of characters held
+// by the given string. It is equivalent to OneOf([]rune(collection)...).
+func OneOfString[Input Bytes](collection string) Parser[Input, rune] {
+	set := newByteSet([]rune(collection))
+
+	failure := newSharedError[Input]("OneOfString")
+
+	return func(input Input) Result[rune, Input] {
+		if len(input) == 0 || !set.contains(input[0]) {
+			return Failure[Input, rune](failure, input)
+		}
+
+		return Success(rune(input[0]), input[1:])
+	}
+}
+
+// byteSet is a 256-bit membership bitmap, holding one bit per byte value.
+type byteSet [4]uint64
+
+// newByteSet builds a byteSet from the provided characters. As parsers
+// relying on it compare single bytes, characters that don't fit in a byte
+// could never match, and are left out.
+func newByteSet(collection []rune) byteSet {
+	var set byteSet
+	for _, c := range collection {
+		if c >= 0 && c < 256 {
+			set[c>>6] |= 1 << (uint(c) & 63)
+		}
+	}
+
+	return set
+}
+
+// contains returns true if the byte is part of the set.
+func (s *byteSet) contains(b byte) bool {
+	return s[b>>6]&(1<<(b&63)) != 0
+}
+
+// Character classes, as recorded in the asciiClasses table.
+const (
+	classAlpha uint8 = 1 << iota
+	classDigit
+	classHexDigit
+	classOctDigit
+	classBinDigit
+	classWhitespace
+)
+
+// asciiClasses records the character classes each byte value belongs to, so
+// that the parsers consuming spans of a class test each byte with a single
+// lookup, rather than a chain of comparisons.
+var asciiClasses = func() [256]uint8 {
+	var table [256]uint8
+	for c := 0; c < 256; c++ {
+		r := rune(c)
+		if IsAlpha(r) {
+			table[c] |= classAlpha
+		}
+		if IsDigit(r) {
+			table[c] |= classDigit
+		}
+		if IsHexDigit(r) {
+			table[c] |= classHexDigit
+		}
+		if IsOctDigit(r) {
+			table[c] |= classOctDigit
+		}
+		if IsBinDigit(r) {
+			table[c] |= classBinDigit
+		}
+		if IsWhitespace(r) {
+			table[c] |= classWhitespace
 		}
+	}
+
+	return table
+}()
+
+// takeClass consumes bytes for as long as they belong to any of the provided
+// character classes. If `required` is true, at least one byte must be consumed.
+// The provided failure is reported when the input doesn't match.
+func takeClass[Input Bytes](input Input, class uint8, required bool, failure *Error[Input]) Result[Input, Input] {
+	pos := 0
+	if class == classDigit {
+		pos = digitsLength(input)
+	} else {
+		for pos < len(input) && asciiClasses[input[pos]]&class != 0 {
+			pos++
+		}
+	}
 
-		return Failure[Input, rune](NewError(input, "OneOf"), input)
+	if required && pos == 0 {
+		return Failure[Input, Input](failure, input)
+	}
+
+	return Success(input[:pos], input[pos:])
+}
+
+// NoneOf parses a single character, as long as it is not part of the given set
+// of characters.
+func NoneOf[Input Bytes](collection ...rune) Parser[Input, rune] {
+	set := newByteSet(collection)
+
+	failure := newSharedError[Input]("NoneOf")
+
+	return func(input Input) Result[rune, Input] {
+		if len(input) == 0 || set.contains(input[0]) {
+			return Failure[Input, rune](failure, input)
+		}
+
+		return Success(rune(input[0]), input[1:])
 	}
 }
 
 // Satisfy parses a single character, and ensures that it satisfies the given predicate.
 func Satisfy[Input Bytes](predicate func(rune) bool) Parser[Input, rune] {
+	failure := newSharedError[Input]("Satisfy")
+
 	return func(input Input) Result[rune, Input] {
 		if len(input) == 0 {
-			return Failure[Input, rune](NewError(input, "Satisfy"), input)
+			return Failure[Input, rune](failure, input)
 		}
 
 		if !predicate(rune(input[0])) {
-			return Failure[Input, rune](NewError(input, "Satisfy"), input)
+			return Failure[Input, rune](failure, input)
 		}
 
 		return Success(rune(input[0]), input[1:])
 	}
 }
 
+// SatisfyMap parses a single character, and converts it using the given function.
+// The function both classifies and converts the character: it returns the converted
+// value, and whether the character was accepted. It allows to avoid combining Satisfy
+// and Map when the same decision would otherwise be made twice.
+func SatisfyMap[Input Bytes, Output any](fn func(rune) (Output, bool)) Parser[Input, Output] {
+	failure := newSharedError[Input]("SatisfyMap")
+
+	return func(input Input) Result[Output, Input] {
+		if len(input) == 0 {
+			return Failure[Input, Output](failure, input)
+		}
+
+		output, ok := fn(rune(input[0]))
+		if !ok {
+			return Failure[Input, Output](failure, input)
+		}
+
+		return Success(output, input[1:])
+	}
+}
+
 // Space parses a space character.
 func Space[Input Bytes]() Parser[Input, rune] {
+	failure := newSharedError[Input]("Space")
+
 	return func(input Input) Result[rune, Input] {
 		if len(input) == 0 || input[0] != ' ' {
-			return Failure[Input, rune](NewError(input, "Space"), input)
+			return Failure[Input, rune](failure, input)
 		}
 
 		return Success(rune(input[0]), input[1:])
@@ -345,9 +929,11 @@ func Space[Input Bytes]() Parser[Input, rune] {
 
 // Tab parses a tab character.
 func Tab[Input Bytes]() Parser[Input, rune] {
+	failure := newSharedError[Input]("Tab")
+
 	return func(input Input) Result[rune, Input] {
 		if len(input) == 0 || input[0] != '\t' {
-			return Failure[Input, rune](NewError(input, "Tab"), input)
+			return Failure[Input, rune](failure, input)
 		}
 
 		return Success(rune(input[0]), input[1:])
@@ -357,59 +943,240 @@ func Tab[Input Bytes]() Parser[Input, rune] {
 // Int64 parses an integer from the input, and returns the part of the input that
 // matched the integer.
 func Int64[Input Bytes]() Parser[Input, int64] {
+	failure := newSharedError[Input]("Int64")
+
 	return func(input Input) Result[int64, Input] {
-		parser := Recognize(Sequence(Optional(Token[Input]("-")), Digit1[Input]()))
+		return signedInteger[Input, int64](input, 64, false, failure)
+	}
+}
 
-		result := parser(input)
-		if result.Err != nil {
-			return Failure[Input, int64](NewError(input, "Int64"), input)
-		}
+// IntFormat describes the syntax of the integers accepted by the Int64With,
+// sized integer, and GroupedInt parsers. Formats are combined using the bitwise
+// OR operator.
+type IntFormat uint8
 
-		n, err := strconv.ParseInt(string(result.Output), 10, 64)
-		if err != nil {
-			return Failure[Input, int64](NewError(input, "Int64"), input)
-		}
+const (
+	// IntDigitSeparators allows digits to be grouped using underscores, as in
+	// "1_000_000". Each underscore must sit between two digits; any other
+	// placement makes the parser fail.
+	IntDigitSeparators IntFormat = 1 << iota
+)
+
+// Int64With behaves like Int64, but accepts integers following the provided
+// format.
+func Int64With[Input Bytes](format IntFormat) Parser[Input, int64] {
+	separators := format&IntDigitSeparators != 0
+
+	failure := newSharedError[Input]("Int64With")
+
+	return func(input Input) Result[int64, Input] {
+		return signedInteger[Input, int64](input, 64, separators, failure)
+	}
+}
 
-		return Success(n, result.Remaining)
+// intFormat combines the provided formats into a single one.
+func intFormat(formats []IntFormat) IntFormat {
+	var format IntFormat
+	for _, f := range formats {
+		format |= f
 	}
+
+	return format
 }
 
 // Int8 parses an 8-bit integer from the input,
-// and returns the part of the input that matched the integer.
-func Int8[Input Bytes]() Parser[Input, int8] {
+// and returns the part of the input that matched the integer. The optional
+// formats describe the syntax of the integers it accepts.
+func Int8[Input Bytes](formats ...IntFormat) Parser[Input, int8] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
+
+	failure := newSharedError[Input]("Int8")
+
 	return func(input Input) Result[int8, Input] {
-		parser := Recognize(Sequence(Optional(Token[Input]("-")), Digit1[Input]()))
+		return signedInteger[Input, int8](input, 8, separators, failure)
+	}
+}
 
-		result := parser(input)
-		if result.Err != nil {
-			return Failure[Input, int8](NewError(input, "Int8"), input)
-		}
+// Int16 parses a 16-bit integer from the input,
+// and returns the part of the input that matched the integer. The optional
+// formats describe the syntax of the integers it accepts.
+func Int16[Input Bytes](formats ...IntFormat) Parser[Input, int16] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
 
-		n, err := strconv.ParseInt(string(result.Output), 10, 8)
-		if err != nil {
-			return Failure[Input, int8](NewError(input, "Int8"), input)
-		}
+	failure := newSharedError[Input]("Int16")
+
+	return func(input Input) Result[int16, Input] {
+		return signedInteger[Input, int16](input, 16, separators, failure)
+	}
+}
+
+// Int32 parses a 32-bit integer from the input,
+// and returns the part of the input that matched the integer. The optional
+// formats describe the syntax of the integers it accepts.
+func Int32[Input Bytes](formats ...IntFormat) Parser[Input, int32] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
 
-		return Success(int8(n), result.Remaining)
+	failure := newSharedError[Input]("Int32")
+
+	return func(input Input) Result[int32, Input] {
+		return signedInteger[Input, int32](input, 32, separators, failure)
+	}
+}
+
+// Int parses an integer, sized after the platform's int type, from the input,
+// and returns the part of the input that matched the integer. The optional
+// formats describe the syntax of the integers it accepts.
+func Int[Input Bytes](formats ...IntFormat) Parser[Input, int] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
+
+	failure := newSharedError[Input]("Int")
+
+	return func(input Input) Result[int, Input] {
+		return signedInteger[Input, int](input, strconv.IntSize, separators, failure)
 	}
 }
 
-// UInt8 parses an 8-bit integer from the input,
-// and returns the part of the input that matched the integer.
-func UInt8[Input Bytes]() Parser[Input, uint8] {
+// UInt8 parses an 8-bit unsigned integer from the input,
+// and returns the part of the input that matched the integer. The optional
+// formats describe the syntax of the integers it accepts.
+func UInt8[Input Bytes](formats ...IntFormat) Parser[Input, uint8] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
+
+	failure := newSharedError[Input]("UInt8")
+
 	return func(input Input) Result[uint8, Input] {
-		result := Digit1[Input]()(input)
-		if result.Err != nil {
-			return Failure[Input, uint8](NewError(input, "UInt8"), input)
+		return unsignedInteger[Input, uint8](input, 8, separators, failure)
+	}
+}
+
+// UInt16 parses a 16-bit unsigned integer from the input,
+// and returns the part of the input that matched the integer. The optional
+// formats describe the syntax of the integers it accepts.
+func UInt16[Input Bytes](formats ...IntFormat) Parser[Input, uint16] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
+
+	failure := newSharedError[Input]("UInt16")
+
+	return func(input Input) Result[uint16, Input] {
+		return unsignedInteger[Input, uint16](input, 16, separators, failure)
+	}
+}
+
+// UInt32 parses a 32-bit unsigned integer from the input,
+// and returns the part of the input that matched the integer. The optional
+// formats describe the syntax of the integers it accepts.
+func UInt32[Input Bytes](formats ...IntFormat) Parser[Input, uint32] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
+
+	failure := newSharedError[Input]("UInt32")
+
+	return func(input Input) Result[uint32, Input] {
+		return unsignedInteger[Input, uint32](input, 32, separators, failure)
+	}
+}
+
+// UInt64 parses a 64-bit unsigned integer from the input,
+// and returns the part of the input that matched the integer. The optional
+// formats describe the syntax of the integers it accepts.
+func UInt64[Input Bytes](formats ...IntFormat) Parser[Input, uint64] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
+
+	failure := newSharedError[Input]("UInt64")
+
+	return func(input Input) Result[uint64, Input] {
+		return unsignedInteger[Input, uint64](input, 64, separators, failure)
+	}
+}
+
+// signedInteger parses an optionally negative decimal integer, which must fit
+// into `bitSize` bits, from the input. If `separators` is true, digits can be
+// grouped using underscores. The provided failure is reported when the input
+// doesn't match.
+//
+// The digits are accumulated while scanning the input, so that parsing doesn't
+// allocate unless it fails.
+func signedInteger[Input Bytes, Output Integer](
+	input Input,
+	bitSize int,
+	separators bool,
+	failure *Error[Input],
+) Result[Output, Input] {
+	negative := len(input) > 0 && input[0] == '-'
+
+	length := 0
+	if negative {
+		length++
+	}
+
+	digits, ok := separatedDigitsLength(input[length:], separators)
+	if !ok || digits == 0 {
+		return Failure[Input, Output](failure, input)
+	}
+
+	// The magnitude of a negative integer can exceed the largest positive
+	// one by one.
+	limit := uint64(1)<<(bitSize-1) - 1
+	if negative {
+		limit++
+	}
+
+	n, ok := accumulateDigits(input[length:length+digits], limit)
+	length += digits
+	if !ok {
+		return Failure[Input, Output](newRangeError(input, string(input[:length]), failure.Expected[0]), input)
+	}
+
+	value := int64(n)
+	if negative {
+		value = -value
+	}
+
+	return Success(Output(value), input[length:])
+}
+
+// unsignedInteger parses a decimal integer, which must fit into `bitSize` bits,
+// from the input. If `separators` is true, digits can be grouped using
+// underscores. The provided failure is reported when the input doesn't match.
+//
+// The digits are accumulated while scanning the input, so that parsing doesn't
+// allocate unless it fails.
+func unsignedInteger[Input Bytes, Output Integer](
+	input Input,
+	bitSize int,
+	separators bool,
+	failure *Error[Input],
+) Result[Output, Input] {
+	length, ok := separatedDigitsLength(input, separators)
+	if !ok || length == 0 {
+		return Failure[Input, Output](failure, input)
+	}
+
+	n, ok := accumulateDigits(input[:length], ^uint64(0)>>(64-bitSize))
+	if !ok {
+		return Failure[Input, Output](newRangeError(input, string(input[:length]), failure.Expected[0]), input)
+	}
+
+	return Success(Output(n), input[length:])
+}
+
+// accumulateDigits computes the value of the provided decimal digits, skipping
+// any underscore separating them. It returns false if the value exceeds limit.
+func accumulateDigits[Input Bytes](digits Input, limit uint64) (uint64, bool) {
+	var n uint64
+	for idx := 0; idx < len(digits); idx++ {
+		if digits[idx] == '_' {
+			continue
 		}
 
-		n, err := strconv.ParseUint(string(result.Output), 10, 8)
-		if err != nil {
-			return Failure[Input, uint8](NewError(input, "UInt8"), input)
+		digit := uint64(digits[idx] - '0')
+		if n > (limit-digit)/10 {
+			return 0, false
 		}
 
-		return Success(uint8(n), result.Remaining)
+		n = n*10 + digit
 	}
+
+	return n, true
 }
 
 // IsAlpha returns true if the rune is an alphabetic character.
@@ -442,11 +1209,44 @@ func IsHexDigit(c rune) bool {
 	return IsDigit(c) || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
 }
 
+// IsOctDigit returns true if the rune is an octal digit.
+func IsOctDigit(c rune) bool {
+	return c >= '0' && c <= '7'
+}
+
+// IsBinDigit returns true if the rune is a binary digit.
+func IsBinDigit(c rune) bool {
+	return c == '0' || c == '1'
+}
+
 // IsControl returns true if the rune is a control character.
 func IsControl(c rune) bool {
 	return (c >= 0 && c < 32) || c == 127
 }
 
+// IsPrintable returns true if the rune is a printable ASCII character.
+func IsPrintable(c rune) bool {
+	return c >= 32 && c < 127
+}
+
 func IsWhitespace(c rune) bool {
 	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
 }
+
+// decodeRune decodes the first UTF-8 encoded character of the input, and returns
+// it alongside its width in bytes. It returns (utf8.RuneError, 1) if the input
+// does not start with a valid encoding, and (utf8.RuneError, 0) if it is empty.
+func decodeRune[Input Bytes](input Input) (rune, int) {
+	if len(input) > 0 && input[0] < utf8.RuneSelf {
+		return rune(input[0]), 1
+	}
+
+	switch typed := any(input).(type) {
+	case string:
+		return utf8.DecodeRuneInString(typed)
+	case []byte:
+		return utf8.DecodeRune(typed)
+	}
+
+	return utf8.RuneError, 0
+}
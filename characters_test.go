@@ -1,7 +1,14 @@
 package gomme
 
 import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
 	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestChar(t *testing.T) {
@@ -74,12 +81,27 @@ func TestChar(t *testing.T) {
 func BenchmarkChar(b *testing.B) {
 	parser := Char[string]('a')
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		parser("a")
 	}
 }
 
-func TestAnyChar(t *testing.T) {
+func TestCharFailureAllocations(t *testing.T) {
+	parser := Char[string]('a')
+
+	allocs := testing.AllocsPerRun(100, func() {
+		parser("b")
+	})
+
+	// Char shares its error across failures.
+	if allocs != 0 {
+		t.Errorf("got %v allocations per run, want 0", allocs)
+	}
+}
+
+func TestCharRange(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -91,31 +113,62 @@ func TestAnyChar(t *testing.T) {
 		wantRemaining string
 	}{
 		{
-			name:          "parsing any char from single entry input should succeed",
-			parser:        AnyChar[string](),
+			name:          "parsing char within range should succeed",
+			parser:        CharRange[string]('a', 'f'),
+			input:         "cafe",
+			wantErr:       false,
+			wantOutput:    'c',
+			wantRemaining: "afe",
+		},
+		{
+			name:          "parsing char matching the lower bound should succeed",
+			parser:        CharRange[string]('a', 'f'),
 			input:         "a",
 			wantErr:       false,
 			wantOutput:    'a',
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing valid any char from longer input should succeed",
-			parser:        AnyChar[string](),
-			input:         "abc",
+			name:          "parsing char matching the upper bound should succeed",
+			parser:        CharRange[string]('a', 'f'),
+			input:         "f",
 			wantErr:       false,
-			wantOutput:    'a',
-			wantRemaining: "bc",
+			wantOutput:    'f',
+			wantRemaining: "",
 		},
 		{
-			name:          "parsing any char from empty input should fail",
-			parser:        AnyChar[string](),
+			name:          "parsing char out of range should fail",
+			parser:        CharRange[string]('a', 'f'),
+			input:         "g",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "g",
+		},
+		{
+			name:          "parsing multi-byte char within range should succeed",
+			parser:        CharRange[string](0x4E00, 0x9FFF),
+			input:         "中文",
+			wantErr:       false,
+			wantOutput:    '中',
+			wantRemaining: "文",
+		},
+		{
+			name:          "parsing multi-byte char out of range should fail",
+			parser:        CharRange[string](0x4E00, 0x9FFF),
+			input:         "été",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "été",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CharRange[string]('a', 'f'),
 			input:         "",
 			wantErr:       true,
 			wantOutput:    rune(0),
 			wantRemaining: "",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -138,67 +191,84 @@ func TestAnyChar(t *testing.T) {
 	}
 }
 
-func BenchmarkAnyChar(b *testing.B) {
-	parser := AnyChar[string]()
+func BenchmarkCharRange(b *testing.B) {
+	parser := CharRange[string]('a', 'f')
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("a")
+		parser("cafe")
 	}
 }
 
-func TestAlpha0(t *testing.T) {
+func TestCharRanges(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, string]
+		parser        Parser[string, rune]
 		input         string
 		wantErr       bool
-		wantOutput    string
+		wantOutput    rune
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single alpha char from single alpha input should succeed",
-			parser:        Alpha0[string](),
-			input:         "a",
+			name:          "parsing char within the first range should succeed",
+			parser:        CharRanges[string](RuneRange{'a', 'f'}, RuneRange{'0', '9'}),
+			input:         "c0",
 			wantErr:       false,
-			wantOutput:    "a",
-			wantRemaining: "",
+			wantOutput:    'c',
+			wantRemaining: "0",
 		},
 		{
-			name:          "parsing alpha chars from multiple alpha input should succeed",
-			parser:        Alpha0[string](),
-			input:         "abc",
+			name:          "parsing char within the second range should succeed",
+			parser:        CharRanges[string](RuneRange{'a', 'f'}, RuneRange{'0', '9'}),
+			input:         "0c",
 			wantErr:       false,
-			wantOutput:    "abc",
-			wantRemaining: "",
+			wantOutput:    '0',
+			wantRemaining: "c",
 		},
 		{
-			name:          "parsing alpha chars until terminating char should succeed",
-			parser:        Alpha0[string](),
-			input:         "abc123",
-			wantErr:       false,
-			wantOutput:    "abc",
-			wantRemaining: "123",
+			name:          "parsing char out of all ranges should fail",
+			parser:        CharRanges[string](RuneRange{'a', 'f'}, RuneRange{'0', '9'}),
+			input:         "z",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "z",
 		},
 		{
-			name:          "parsing an empty input should succeed",
-			parser:        Alpha0[string](),
+			name:          "parsing without ranges should fail",
+			parser:        CharRanges[string](),
+			input:         "a",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "a",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CharRanges[string](RuneRange{'a', 'f'}, RuneRange{'0', '9'}),
 			input:         "",
-			wantErr:       false,
-			wantOutput:    "",
+			wantErr:       true,
+			wantOutput:    rune(0),
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing non alpha chars should succeed",
-			parser:        Alpha0[string](),
-			input:         "123",
+			name:          "parsing invalid UTF-8 should fail, even if a range holds the replacement character",
+			parser:        CharRanges[string](RuneRange{Low: 0, High: unicode.MaxRune}),
+			input:         "\xffabc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "\xffabc",
+		},
+		{
+			name:          "parsing an encoded replacement character within a range should succeed",
+			parser:        CharRanges[string](RuneRange{Low: 0, High: unicode.MaxRune}),
+			input:         "\uFFFDabc",
 			wantErr:       false,
-			wantOutput:    "",
-			wantRemaining: "123",
+			wantOutput:    '\uFFFD',
+			wantRemaining: "abc",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -221,75 +291,52 @@ func TestAlpha0(t *testing.T) {
 	}
 }
 
-func BenchmarkAlpha0(b *testing.B) {
-	parser := Alpha0[string]()
+func BenchmarkCharRanges(b *testing.B) {
+	parser := CharRanges[string](RuneRange{'a', 'f'}, RuneRange{'0', '9'})
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("abc")
+		parser("0c")
 	}
 }
 
-func TestAlpha1(t *testing.T) {
+func TestNotChar(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, string]
+		parser        Parser[string, rune]
 		input         string
 		wantErr       bool
-		wantOutput    string
+		wantOutput    rune
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single alpha char from single alpha input should succeed",
-			parser:        Alpha1[string](),
-			input:         "a",
-			wantErr:       false,
-			wantOutput:    "a",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing alpha chars from multiple alpha input should succeed",
-			parser:        Alpha1[string](),
-			input:         "abc",
+			name:          "parsing a different char should succeed",
+			parser:        NotChar[string](','),
+			input:         "a,b",
 			wantErr:       false,
-			wantOutput:    "abc",
-			wantRemaining: "",
+			wantOutput:    'a',
+			wantRemaining: ",b",
 		},
 		{
-			name:          "parsing alpha chars until terminating char should succeed",
-			parser:        Alpha1[string](),
-			input:         "abc123",
-			wantErr:       false,
-			wantOutput:    "abc",
-			wantRemaining: "123",
+			name:          "parsing the excluded char should fail",
+			parser:        NotChar[string](','),
+			input:         ",b",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: ",b",
 		},
 		{
-			name:          "parsing an empty input should fail",
-			parser:        Alpha1[string](),
+			name:          "parsing empty input should fail",
+			parser:        NotChar[string](','),
 			input:         "",
 			wantErr:       true,
-			wantOutput:    "",
+			wantOutput:    rune(0),
 			wantRemaining: "",
 		},
-		{
-			name:          "parsing input not starting with an alpha char should fail",
-			parser:        Alpha1[string](),
-			input:         "1c",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "1c",
-		},
-		{
-			name:          "parsing non alpha chars should fail",
-			parser:        Alpha1[string](),
-			input:         "123",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "123",
-		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -312,67 +359,76 @@ func TestAlpha1(t *testing.T) {
 	}
 }
 
-func BenchmarkAlpha1(b *testing.B) {
-	parser := Alpha1[string]()
+func BenchmarkNotChar(b *testing.B) {
+	parser := NotChar[string](',')
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("abc")
+		parser("a,b")
 	}
 }
 
-func TestDigit0(t *testing.T) {
+func TestCharNoCase(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, string]
+		parser        Parser[string, rune]
 		input         string
 		wantErr       bool
-		wantOutput    string
+		wantOutput    rune
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single digit char from single digit input should succeed",
-			parser:        Digit0[string](),
-			input:         "1",
+			name:          "parsing same case char should succeed",
+			parser:        CharNoCase[string]('a'),
+			input:         "abc",
 			wantErr:       false,
-			wantOutput:    "1",
-			wantRemaining: "",
+			wantOutput:    'a',
+			wantRemaining: "bc",
 		},
 		{
-			name:          "parsing digit chars from multiple digit input should succeed",
-			parser:        Digit0[string](),
-			input:         "123",
+			name:          "parsing different case char should succeed",
+			parser:        CharNoCase[string]('a'),
+			input:         "Abc",
 			wantErr:       false,
-			wantOutput:    "123",
-			wantRemaining: "",
+			wantOutput:    'A',
+			wantRemaining: "bc",
 		},
 		{
-			name:          "parsing digit chars until terminating char should succeed",
-			parser:        Digit0[string](),
-			input:         "123abc",
+			name:          "parsing unicode different case char should succeed",
+			parser:        CharNoCase[string]('é'),
+			input:         "Été",
 			wantErr:       false,
-			wantOutput:    "123",
-			wantRemaining: "abc",
+			wantOutput:    'É',
+			wantRemaining: "té",
 		},
 		{
-			name:          "parsing an empty input should succeed",
-			parser:        Digit0[string](),
-			input:         "",
+			name:          "parsing case folded equivalent char should succeed",
+			parser:        CharNoCase[string]('k'),
+			input:         "\u212a",
 			wantErr:       false,
-			wantOutput:    "",
+			wantOutput:    '\u212a',
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing non digit chars should succeed",
-			parser:        Digit0[string](),
-			input:         "abc",
-			wantErr:       false,
-			wantOutput:    "",
-			wantRemaining: "abc",
+			name:          "parsing different char should fail",
+			parser:        CharNoCase[string]('a'),
+			input:         "bcd",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "bcd",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CharNoCase[string]('a'),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -395,73 +451,51 @@ func TestDigit0(t *testing.T) {
 	}
 }
 
-func BenchmarkDigit0(b *testing.B) {
-	parser := Digit0[string]()
+func BenchmarkCharNoCase(b *testing.B) {
+	parser := CharNoCase[string]('a')
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("123")
+		parser("Abc")
 	}
 }
 
-func TestDigit1(t *testing.T) {
+func TestAnyChar(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, string]
+		parser        Parser[string, rune]
 		input         string
 		wantErr       bool
-		wantOutput    string
+		wantOutput    rune
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single digit char from single digit input should succeed",
-			parser:        Digit1[string](),
-			input:         "1",
-			wantErr:       false,
-			wantOutput:    "1",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing digit chars from multiple digit input should succeed",
-			parser:        Digit1[string](),
-			input:         "123",
+			name:          "parsing any char from single entry input should succeed",
+			parser:        AnyChar[string](),
+			input:         "a",
 			wantErr:       false,
-			wantOutput:    "123",
+			wantOutput:    'a',
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing digit chars until terminating char should succeed",
-			parser:        Digit1[string](),
-			input:         "123abc",
+			name:          "parsing valid any char from longer input should succeed",
+			parser:        AnyChar[string](),
+			input:         "abc",
 			wantErr:       false,
-			wantOutput:    "123",
-			wantRemaining: "abc",
+			wantOutput:    'a',
+			wantRemaining: "bc",
 		},
 		{
-			name:          "parsing an empty input should fail",
-			parser:        Digit1[string](),
+			name:          "parsing any char from empty input should fail",
+			parser:        AnyChar[string](),
 			input:         "",
 			wantErr:       true,
-			wantOutput:    "",
+			wantOutput:    rune(0),
 			wantRemaining: "",
 		},
-		{
-			name:          "parsing input not starting with an digit char should fail",
-			parser:        Digit1[string](),
-			input:         "c1",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "c1",
-		},
-		{
-			name:          "parsing non digit chars should fail",
-			parser:        Digit1[string](),
-			input:         "abc",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "abc",
-		},
 	}
 
 	for _, tc := range testCases {
@@ -486,67 +520,76 @@ func TestDigit1(t *testing.T) {
 	}
 }
 
-func BenchmarkDigit1(b *testing.B) {
-	parser := Digit1[string]()
+func BenchmarkAnyChar(b *testing.B) {
+	parser := AnyChar[string]()
 
-	for i := 0; i < b.N; i++ {
-		parser("123")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a")
 	}
 }
 
-func TestHexDigit0(t *testing.T) {
+func TestAnyRune(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, string]
+		parser        Parser[string, rune]
 		input         string
 		wantErr       bool
-		wantOutput    string
+		wantOutput    rune
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single hex digit char from single hex digit input should succeed",
-			parser:        HexDigit0[string](),
-			input:         "1",
+			name:          "parsing an ASCII character should succeed",
+			parser:        AnyRune[string](),
+			input:         "abc",
 			wantErr:       false,
-			wantOutput:    "1",
-			wantRemaining: "",
+			wantOutput:    'a',
+			wantRemaining: "bc",
 		},
 		{
-			name:          "parsing hex digit chars from multiple hex digit input should succeed",
-			parser:        HexDigit0[string](),
-			input:         "1f3",
+			name:          "parsing a multi-byte character should succeed",
+			parser:        AnyRune[string](),
+			input:         "été",
 			wantErr:       false,
-			wantOutput:    "1f3",
-			wantRemaining: "",
+			wantOutput:    'é',
+			wantRemaining: "té",
 		},
 		{
-			name:          "parsing hex digit chars until terminating char should succeed",
-			parser:        HexDigit0[string](),
-			input:         "1f3z",
+			name:          "parsing a four-byte character should succeed",
+			parser:        AnyRune[string](),
+			input:         "😀!",
 			wantErr:       false,
-			wantOutput:    "1f3",
-			wantRemaining: "z",
+			wantOutput:    '😀',
+			wantRemaining: "!",
 		},
 		{
-			name:          "parsing an empty input should succeed",
-			parser:        HexDigit0[string](),
-			input:         "",
-			wantErr:       false,
-			wantOutput:    "",
-			wantRemaining: "",
+			name:          "parsing an invalid encoding should fail",
+			parser:        AnyRune[string](),
+			input:         "\xffabc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "\xffabc",
 		},
 		{
-			name:          "parsing non hex digit chars should succeed",
-			parser:        HexDigit0[string](),
-			input:         "ghi",
-			wantErr:       false,
-			wantOutput:    "",
-			wantRemaining: "ghi",
+			name:          "parsing a truncated encoding should fail",
+			parser:        AnyRune[string](),
+			input:         "\xc3",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "\xc3",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        AnyRune[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -569,15 +612,27 @@ func TestHexDigit0(t *testing.T) {
 	}
 }
 
-func BenchmarkHexDigit0(b *testing.B) {
-	parser := HexDigit0[string]()
+func BenchmarkAnyRune(b *testing.B) {
+	parser := AnyRune[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("1f3")
+		parser("été")
 	}
 }
 
-func TestHexDigit1(t *testing.T) {
+func TestAnyRuneInvalidEncodingError(t *testing.T) {
+	t.Parallel()
+
+	result := AnyRune[[]byte]()([]byte{0xff, 'a'})
+
+	assert.Error(t, result.Err)
+	assert.Equal(t, "expected valid UTF-8 rune", result.Err.Error())
+	assert.Equal(t, []byte{0xff, 'a'}, result.Remaining)
+}
+
+func TestAlpha0(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -589,52 +644,44 @@ func TestHexDigit1(t *testing.T) {
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single hex digit char from single hex digit input should succeed",
-			parser:        HexDigit1[string](),
-			input:         "1",
+			name:          "parsing single alpha char from single alpha input should succeed",
+			parser:        Alpha0[string](),
+			input:         "a",
 			wantErr:       false,
-			wantOutput:    "1",
+			wantOutput:    "a",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing hex digit chars from multiple hex digit input should succeed",
-			parser:        HexDigit1[string](),
-			input:         "1f3",
+			name:          "parsing alpha chars from multiple alpha input should succeed",
+			parser:        Alpha0[string](),
+			input:         "abc",
 			wantErr:       false,
-			wantOutput:    "1f3",
+			wantOutput:    "abc",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing hex digit chars until terminating char should succeed",
-			parser:        HexDigit1[string](),
-			input:         "1f3ghi",
+			name:          "parsing alpha chars until terminating char should succeed",
+			parser:        Alpha0[string](),
+			input:         "abc123",
 			wantErr:       false,
-			wantOutput:    "1f3",
-			wantRemaining: "ghi",
+			wantOutput:    "abc",
+			wantRemaining: "123",
 		},
 		{
-			name:          "parsing an empty input should fail",
-			parser:        HexDigit1[string](),
+			name:          "parsing an empty input should succeed",
+			parser:        Alpha0[string](),
 			input:         "",
-			wantErr:       true,
+			wantErr:       false,
 			wantOutput:    "",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing input not starting with a hex digit char should fail",
-			parser:        HexDigit1[string](),
-			input:         "h1",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "h1",
-		},
-		{
-			name:          "parsing non hex digit chars should fail",
-			parser:        HexDigit1[string](),
-			input:         "ghi",
-			wantErr:       true,
+			name:          "parsing non alpha chars should succeed",
+			parser:        Alpha0[string](),
+			input:         "123",
+			wantErr:       false,
 			wantOutput:    "",
-			wantRemaining: "ghi",
+			wantRemaining: "123",
 		},
 	}
 
@@ -660,15 +707,17 @@ func TestHexDigit1(t *testing.T) {
 	}
 }
 
-func BenchmarkHexDigit1(b *testing.B) {
-	parser := HexDigit1[string]()
+func BenchmarkAlpha0(b *testing.B) {
+	parser := Alpha0[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("1f3")
+		parser("abc")
 	}
 }
 
-func TestWhitespace0(t *testing.T) {
+func TestAlpha1(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -680,84 +729,52 @@ func TestWhitespace0(t *testing.T) {
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single whitespace from single ' ' input should succeed",
-			parser:        Whitespace0[string](),
-			input:         " ",
-			wantErr:       false,
-			wantOutput:    " ",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing single whitespace from single '\t' input should succeed",
-			parser:        Whitespace0[string](),
-			input:         "\t",
-			wantErr:       false,
-			wantOutput:    "\t",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing single whitespace from single '\n' input should succeed",
-			parser:        Whitespace0[string](),
-			input:         "\n",
-			wantErr:       false,
-			wantOutput:    "\n",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing single whitespace from single '\r' input should succeed",
-			parser:        Whitespace0[string](),
-			input:         "\r",
+			name:          "parsing single alpha char from single alpha input should succeed",
+			parser:        Alpha1[string](),
+			input:         "a",
 			wantErr:       false,
-			wantOutput:    "\r",
+			wantOutput:    "a",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing multiple whitespace chars from multiple whitespace chars input should succeed",
-			parser:        Whitespace0[string](),
-			input:         " \t\n\r",
+			name:          "parsing alpha chars from multiple alpha input should succeed",
+			parser:        Alpha1[string](),
+			input:         "abc",
 			wantErr:       false,
-			wantOutput:    " \t\n\r",
+			wantOutput:    "abc",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing multiple whitespace chars from multiple whitespace chars with suffix input should succeed",
-			parser:        Whitespace0[string](),
-			input:         " \t\n\rabc",
+			name:          "parsing alpha chars until terminating char should succeed",
+			parser:        Alpha1[string](),
+			input:         "abc123",
 			wantErr:       false,
-			wantOutput:    " \t\n\r",
-			wantRemaining: "abc",
+			wantOutput:    "abc",
+			wantRemaining: "123",
 		},
 		{
-			name:          "parsing an empty input should succeed",
-			parser:        Whitespace0[string](),
+			name:          "parsing an empty input should fail",
+			parser:        Alpha1[string](),
 			input:         "",
-			wantErr:       false,
+			wantErr:       true,
 			wantOutput:    "",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing a single non-whitespace char input should succeed",
-			parser:        Whitespace0[string](),
-			input:         "a",
-			wantErr:       false,
-			wantOutput:    "",
-			wantRemaining: "a",
-		},
-		{
-			name:          "parsing input starting with a non-whitespace char should succeed",
-			parser:        Whitespace0[string](),
-			input:         "a \t\n\r",
-			wantErr:       false,
+			name:          "parsing input not starting with an alpha char should fail",
+			parser:        Alpha1[string](),
+			input:         "1c",
+			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "a \t\n\r",
+			wantRemaining: "1c",
 		},
 		{
-			name:          "parsing non-whitespace chars should succeed",
-			parser:        Whitespace0[string](),
-			input:         "ghi",
-			wantErr:       false,
+			name:          "parsing non alpha chars should fail",
+			parser:        Alpha1[string](),
+			input:         "123",
+			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "ghi",
+			wantRemaining: "123",
 		},
 	}
 
@@ -783,17 +800,17 @@ func TestWhitespace0(t *testing.T) {
 	}
 }
 
-func BenchmarkWhitespace0(b *testing.B) {
-	b.ReportAllocs()
-	parser := Whitespace0[string]()
+func BenchmarkAlpha1(b *testing.B) {
+	parser := Alpha1[string]()
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser(" \t\n\r")
+		parser("abc")
 	}
 }
 
-func TestWhitespace1(t *testing.T) {
+func TestDigit0(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -805,84 +822,44 @@ func TestWhitespace1(t *testing.T) {
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single whitespace from single ' ' input should succeed",
-			parser:        Whitespace1[string](),
-			input:         " ",
+			name:          "parsing single digit char from single digit input should succeed",
+			parser:        Digit0[string](),
+			input:         "1",
 			wantErr:       false,
-			wantOutput:    " ",
+			wantOutput:    "1",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single whitespace from single '\t' input should succeed",
-			parser:        Whitespace1[string](),
-			input:         "\t",
+			name:          "parsing digit chars from multiple digit input should succeed",
+			parser:        Digit0[string](),
+			input:         "123",
 			wantErr:       false,
-			wantOutput:    "\t",
+			wantOutput:    "123",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single whitespace from single '\n' input should succeed",
-			parser:        Whitespace1[string](),
-			input:         "\n",
+			name:          "parsing digit chars until terminating char should succeed",
+			parser:        Digit0[string](),
+			input:         "123abc",
 			wantErr:       false,
-			wantOutput:    "\n",
-			wantRemaining: "",
+			wantOutput:    "123",
+			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing single whitespace from single '\r' input should succeed",
-			parser:        Whitespace1[string](),
-			input:         "\r",
+			name:          "parsing an empty input should succeed",
+			parser:        Digit0[string](),
+			input:         "",
 			wantErr:       false,
-			wantOutput:    "\r",
+			wantOutput:    "",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing multiple whitespace chars from multiple whitespace chars input should succeed",
-			parser:        Whitespace1[string](),
-			input:         " \t\n\r",
-			wantErr:       false,
-			wantOutput:    " \t\n\r",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing multiple whitespace chars from multiple whitespace chars with suffix input should succeed",
-			parser:        Whitespace1[string](),
-			input:         " \t\n\rabc",
+			name:          "parsing non digit chars should succeed",
+			parser:        Digit0[string](),
+			input:         "abc",
 			wantErr:       false,
-			wantOutput:    " \t\n\r",
-			wantRemaining: "abc",
-		},
-		{
-			name:          "parsing an empty input should fail",
-			parser:        Whitespace1[string](),
-			input:         "",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing a single non-whitespace char input should fail",
-			parser:        Whitespace1[string](),
-			input:         "a",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "a",
-		},
-		{
-			name:          "parsing input starting with a non-whitespace char should fail",
-			parser:        Whitespace1[string](),
-			input:         "a \t\n\r",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "a \t\n\r",
-		},
-		{
-			name:          "parsing non-whitespace chars should fail",
-			parser:        Whitespace1[string](),
-			input:         "ghi",
-			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "ghi",
+			wantRemaining: "abc",
 		},
 	}
 
@@ -908,18 +885,17 @@ func TestWhitespace1(t *testing.T) {
 	}
 }
 
-func BenchmarkWhitespace1(b *testing.B) {
-	b.ReportAllocs()
-
-	parser := Whitespace1[string]()
+func BenchmarkDigit0(b *testing.B) {
+	parser := Digit0[string]()
 
+	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser(" \t\n\r")
+		parser("123")
 	}
 }
 
-func TestAlphanumeric0(t *testing.T) {
+func TestDigit1(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -931,84 +907,76 @@ func TestAlphanumeric0(t *testing.T) {
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single alpha char from single alphanumerical input should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "a",
-			wantErr:       false,
-			wantOutput:    "a",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing single digit char from single alphanumerical input should succeed",
-			parser:        Alphanumeric0[string](),
+			name:          "parsing single digit char from single digit input should succeed",
+			parser:        Digit1[string](),
 			input:         "1",
 			wantErr:       false,
 			wantOutput:    "1",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing alpha chars from multiple alphanumerical input should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "abc",
+			name:          "parsing digit chars from multiple digit input should succeed",
+			parser:        Digit1[string](),
+			input:         "123",
 			wantErr:       false,
-			wantOutput:    "abc",
+			wantOutput:    "123",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing digit chars from multiple alphanumerical input should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "123",
+			name:          "parsing digit chars until terminating char should succeed",
+			parser:        Digit1[string](),
+			input:         "123abc",
 			wantErr:       false,
 			wantOutput:    "123",
-			wantRemaining: "",
+			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing multiple alphanumerical input should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "a1b2c3",
-			wantErr:       false,
-			wantOutput:    "a1b2c3",
+			name:          "parsing an empty input should fail",
+			parser:        Digit1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing alph chars until terminating char should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "abc$%^",
-			wantErr:       false,
-			wantOutput:    "abc",
-			wantRemaining: "$%^",
+			name:          "parsing input not starting with an digit char should fail",
+			parser:        Digit1[string](),
+			input:         "c1",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "c1",
 		},
 		{
-			name:          "parsing digit chars until terminating char should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "123$%^",
-			wantErr:       false,
-			wantOutput:    "123",
-			wantRemaining: "$%^",
+			name:          "parsing non digit chars should fail",
+			parser:        Digit1[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing alphanumerical chars until terminating char should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "a1b2c3$%^",
+			name:          "parsing digits spanning several eight bytes blocks should succeed",
+			parser:        Digit1[string](),
+			input:         "12345678901234567890abc",
 			wantErr:       false,
-			wantOutput:    "a1b2c3",
-			wantRemaining: "$%^",
+			wantOutput:    "12345678901234567890",
+			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing an empty input should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "",
+			name:          "parsing digits up to a non digit char within an eight bytes block should succeed",
+			parser:        Digit1[string](),
+			input:         "1234:6789",
 			wantErr:       false,
-			wantOutput:    "",
-			wantRemaining: "",
+			wantOutput:    "1234",
+			wantRemaining: ":6789",
 		},
 		{
-			name:          "parsing non alphanumerical chars should succeed",
-			parser:        Alphanumeric0[string](),
-			input:         "$%^",
+			name:          "parsing digits up to a char adjacent to the digits range should succeed",
+			parser:        Digit1[string](),
+			input:         "12345678/12345678",
 			wantErr:       false,
-			wantOutput:    "",
-			wantRemaining: "$%^",
+			wantOutput:    "12345678",
+			wantRemaining: "/12345678",
 		},
 	}
 
@@ -1034,15 +1002,28 @@ func TestAlphanumeric0(t *testing.T) {
 	}
 }
 
-func BenchmarkAlphanumeric0(b *testing.B) {
-	parser := Alphanumeric0[string]()
+func BenchmarkDigit1(b *testing.B) {
+	parser := Digit1[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("a1b2c3")
+		parser("123")
 	}
 }
 
-func TestAlphanumeric1(t *testing.T) {
+func BenchmarkDigit1Long(b *testing.B) {
+	parser := Digit1[string]()
+	input := strings.Repeat("1234567890", 100) + "abc"
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(input)
+	}
+}
+
+func TestHexDigit0(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -1054,92 +1035,44 @@ func TestAlphanumeric1(t *testing.T) {
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single alpha char from single alphanumerical input should succeed",
-			parser:        Alphanumeric1[string](),
-			input:         "a",
-			wantErr:       false,
-			wantOutput:    "a",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing single digit char from single alphanumerical input should succeed",
-			parser:        Alphanumeric1[string](),
+			name:          "parsing single hex digit char from single hex digit input should succeed",
+			parser:        HexDigit0[string](),
 			input:         "1",
 			wantErr:       false,
 			wantOutput:    "1",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing alpha chars from multiple alphanumerical input should succeed",
-			parser:        Alphanumeric1[string](),
-			input:         "abc",
-			wantErr:       false,
-			wantOutput:    "abc",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing digit chars from multiple alphanumerical input should succeed",
-			parser:        Alphanumeric1[string](),
-			input:         "123",
-			wantErr:       false,
-			wantOutput:    "123",
-			wantRemaining: "",
-		},
-		{
-			name:          "parsing alphanumerical chars from multiple alphanumerical input should succeed",
-			parser:        Alphanumeric1[string](),
-			input:         "a1b2c3",
+			name:          "parsing hex digit chars from multiple hex digit input should succeed",
+			parser:        HexDigit0[string](),
+			input:         "1f3",
 			wantErr:       false,
-			wantOutput:    "a1b2c3",
+			wantOutput:    "1f3",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing alpha chars until terminating char should succeed",
-			parser:        Alphanumeric1[string](),
-			input:         "abc$%^",
-			wantErr:       false,
-			wantOutput:    "abc",
-			wantRemaining: "$%^",
-		},
-		{
-			name:          "parsing digit chars until terminating char should succeed",
-			parser:        Alphanumeric1[string](),
-			input:         "123$%^",
-			wantErr:       false,
-			wantOutput:    "123",
-			wantRemaining: "$%^",
-		},
-		{
-			name:          "parsing alphanumerical chars until terminating char should succeed",
-			parser:        Alphanumeric1[string](),
-			input:         "a1b2c3$%^",
+			name:          "parsing hex digit chars until terminating char should succeed",
+			parser:        HexDigit0[string](),
+			input:         "1f3z",
 			wantErr:       false,
-			wantOutput:    "a1b2c3",
-			wantRemaining: "$%^",
+			wantOutput:    "1f3",
+			wantRemaining: "z",
 		},
 		{
-			name:          "parsing an empty input should fail",
-			parser:        Alphanumeric1[string](),
+			name:          "parsing an empty input should succeed",
+			parser:        HexDigit0[string](),
 			input:         "",
-			wantErr:       true,
+			wantErr:       false,
 			wantOutput:    "",
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing input not starting with an alphanumeric char should fail",
-			parser:        Alphanumeric1[string](),
-			input:         "$1",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "$1",
-		},
-		{
-			name:          "parsing non digit chars should fail",
-			parser:        Alphanumeric1[string](),
-			input:         "$%^",
-			wantErr:       true,
+			name:          "parsing non hex digit chars should succeed",
+			parser:        HexDigit0[string](),
+			input:         "ghi",
+			wantErr:       false,
 			wantOutput:    "",
-			wantRemaining: "$%^",
+			wantRemaining: "ghi",
 		},
 	}
 
@@ -1165,60 +1098,3184 @@ func TestAlphanumeric1(t *testing.T) {
 	}
 }
 
-func BenchmarkAlphanumeric1(b *testing.B) {
-	parser := Alphanumeric1[string]()
+func BenchmarkHexDigit0(b *testing.B) {
+	parser := HexDigit0[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("a1b2c3")
+		parser("1f3")
 	}
 }
 
-func TestLF(t *testing.T) {
+func TestHexDigit1(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, rune]
+		parser        Parser[string, string]
 		input         string
 		wantErr       bool
-		wantOutput    rune
+		wantOutput    string
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single line-feed char from single line-feed input should succeed",
-			parser:        LF[string](),
+			name:          "parsing single hex digit char from single hex digit input should succeed",
+			parser:        HexDigit1[string](),
+			input:         "1",
+			wantErr:       false,
+			wantOutput:    "1",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing hex digit chars from multiple hex digit input should succeed",
+			parser:        HexDigit1[string](),
+			input:         "1f3",
+			wantErr:       false,
+			wantOutput:    "1f3",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing hex digit chars until terminating char should succeed",
+			parser:        HexDigit1[string](),
+			input:         "1f3ghi",
+			wantErr:       false,
+			wantOutput:    "1f3",
+			wantRemaining: "ghi",
+		},
+		{
+			name:          "parsing an empty input should fail",
+			parser:        HexDigit1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input not starting with a hex digit char should fail",
+			parser:        HexDigit1[string](),
+			input:         "h1",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "h1",
+		},
+		{
+			name:          "parsing non hex digit chars should fail",
+			parser:        HexDigit1[string](),
+			input:         "ghi",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "ghi",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkHexDigit1(b *testing.B) {
+	parser := HexDigit1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1f3")
+	}
+}
+
+func TestOctDigit0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing octal digits should succeed",
+			parser:        OctDigit0[string](),
+			input:         "0755 file",
+			wantErr:       false,
+			wantOutput:    "0755",
+			wantRemaining: " file",
+		},
+		{
+			name:          "parsing digits beyond octal should stop",
+			parser:        OctDigit0[string](),
+			input:         "1289",
+			wantErr:       false,
+			wantOutput:    "12",
+			wantRemaining: "89",
+		},
+		{
+			name:          "parsing no octal digits should succeed",
+			parser:        OctDigit0[string](),
+			input:         "89",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "89",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        OctDigit0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkOctDigit0(b *testing.B) {
+	parser := OctDigit0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("0755 file")
+	}
+}
+
+func TestOctDigit1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing octal digits should succeed",
+			parser:        OctDigit1[string](),
+			input:         "0755 file",
+			wantErr:       false,
+			wantOutput:    "0755",
+			wantRemaining: " file",
+		},
+		{
+			name:          "parsing full octal input should succeed",
+			parser:        OctDigit1[string](),
+			input:         "644",
+			wantErr:       false,
+			wantOutput:    "644",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing no octal digits should fail",
+			parser:        OctDigit1[string](),
+			input:         "89",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "89",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        OctDigit1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkOctDigit1(b *testing.B) {
+	parser := OctDigit1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("0755 file")
+	}
+}
+
+func TestBinDigit0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing binary digits should succeed",
+			parser:        BinDigit0[string](),
+			input:         "1010 mask",
+			wantErr:       false,
+			wantOutput:    "1010",
+			wantRemaining: " mask",
+		},
+		{
+			name:          "parsing digits beyond binary should stop",
+			parser:        BinDigit0[string](),
+			input:         "1021",
+			wantErr:       false,
+			wantOutput:    "10",
+			wantRemaining: "21",
+		},
+		{
+			name:          "parsing no binary digits should succeed",
+			parser:        BinDigit0[string](),
+			input:         "23",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "23",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        BinDigit0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkBinDigit0(b *testing.B) {
+	parser := BinDigit0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1010 mask")
+	}
+}
+
+func TestBinDigit1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing binary digits should succeed",
+			parser:        BinDigit1[string](),
+			input:         "1010 mask",
+			wantErr:       false,
+			wantOutput:    "1010",
+			wantRemaining: " mask",
+		},
+		{
+			name:          "parsing full binary input should succeed",
+			parser:        BinDigit1[string](),
+			input:         "0110",
+			wantErr:       false,
+			wantOutput:    "0110",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing no binary digits should fail",
+			parser:        BinDigit1[string](),
+			input:         "23",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "23",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BinDigit1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkBinDigit1(b *testing.B) {
+	parser := BinDigit1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1010 mask")
+	}
+}
+
+func TestWhitespace0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single whitespace from single ' ' input should succeed",
+			parser:        Whitespace0[string](),
+			input:         " ",
+			wantErr:       false,
+			wantOutput:    " ",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single whitespace from single '\t' input should succeed",
+			parser:        Whitespace0[string](),
+			input:         "\t",
+			wantErr:       false,
+			wantOutput:    "\t",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single whitespace from single '\n' input should succeed",
+			parser:        Whitespace0[string](),
+			input:         "\n",
+			wantErr:       false,
+			wantOutput:    "\n",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single whitespace from single '\r' input should succeed",
+			parser:        Whitespace0[string](),
+			input:         "\r",
+			wantErr:       false,
+			wantOutput:    "\r",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing multiple whitespace chars from multiple whitespace chars input should succeed",
+			parser:        Whitespace0[string](),
+			input:         " \t\n\r",
+			wantErr:       false,
+			wantOutput:    " \t\n\r",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing multiple whitespace chars from multiple whitespace chars with suffix input should succeed",
+			parser:        Whitespace0[string](),
+			input:         " \t\n\rabc",
+			wantErr:       false,
+			wantOutput:    " \t\n\r",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing an empty input should succeed",
+			parser:        Whitespace0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a single non-whitespace char input should succeed",
+			parser:        Whitespace0[string](),
+			input:         "a",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "a",
+		},
+		{
+			name:          "parsing input starting with a non-whitespace char should succeed",
+			parser:        Whitespace0[string](),
+			input:         "a \t\n\r",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "a \t\n\r",
+		},
+		{
+			name:          "parsing non-whitespace chars should succeed",
+			parser:        Whitespace0[string](),
+			input:         "ghi",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "ghi",
+		},
+		{
+			name:          "parsing horizontal whitespace with HorizontalWhitespace should stop before line endings",
+			parser:        Whitespace0[string](HorizontalWhitespace),
+			input:         " \t\nabc",
+			wantErr:       false,
+			wantOutput:    " \t",
+			wantRemaining: "\nabc",
+		},
+		{
+			name:          "parsing a line ending with HorizontalWhitespace should succeed without consuming it",
+			parser:        Whitespace0[string](HorizontalWhitespace),
+			input:         "\nabc",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "\nabc",
+		},
+		{
+			name:          "parsing whitespace with a predicate policy should succeed",
+			parser:        Whitespace0[string](unicode.IsSpace),
+			input:         "\u00a0\u2003 abc",
+			wantErr:       false,
+			wantOutput:    "\u00a0\u2003 ",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing whitespace with several policies should consume the characters any of them accepts",
+			parser:        Whitespace0[string](HorizontalWhitespace, WhitespaceRunes(',')),
+			input:         " ,\t,\nabc",
+			wantErr:       false,
+			wantOutput:    " ,\t,",
+			wantRemaining: "\nabc",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkWhitespace0(b *testing.B) {
+	b.ReportAllocs()
+	parser := Whitespace0[string]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(" \t\n\r")
+	}
+}
+
+func TestWhitespace1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single whitespace from single ' ' input should succeed",
+			parser:        Whitespace1[string](),
+			input:         " ",
+			wantErr:       false,
+			wantOutput:    " ",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single whitespace from single '\t' input should succeed",
+			parser:        Whitespace1[string](),
+			input:         "\t",
+			wantErr:       false,
+			wantOutput:    "\t",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single whitespace from single '\n' input should succeed",
+			parser:        Whitespace1[string](),
+			input:         "\n",
+			wantErr:       false,
+			wantOutput:    "\n",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single whitespace from single '\r' input should succeed",
+			parser:        Whitespace1[string](),
+			input:         "\r",
+			wantErr:       false,
+			wantOutput:    "\r",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing multiple whitespace chars from multiple whitespace chars input should succeed",
+			parser:        Whitespace1[string](),
+			input:         " \t\n\r",
+			wantErr:       false,
+			wantOutput:    " \t\n\r",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing multiple whitespace chars from multiple whitespace chars with suffix input should succeed",
+			parser:        Whitespace1[string](),
+			input:         " \t\n\rabc",
+			wantErr:       false,
+			wantOutput:    " \t\n\r",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing an empty input should fail",
+			parser:        Whitespace1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a single non-whitespace char input should fail",
+			parser:        Whitespace1[string](),
+			input:         "a",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "a",
+		},
+		{
+			name:          "parsing input starting with a non-whitespace char should fail",
+			parser:        Whitespace1[string](),
+			input:         "a \t\n\r",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "a \t\n\r",
+		},
+		{
+			name:          "parsing non-whitespace chars should fail",
+			parser:        Whitespace1[string](),
+			input:         "ghi",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "ghi",
+		},
+		{
+			name:          "parsing horizontal whitespace with HorizontalWhitespace should succeed",
+			parser:        Whitespace1[string](HorizontalWhitespace),
+			input:         "\t \r\n",
+			wantErr:       false,
+			wantOutput:    "\t ",
+			wantRemaining: "\r\n",
+		},
+		{
+			name:          "parsing a line ending with HorizontalWhitespace should fail",
+			parser:        Whitespace1[string](HorizontalWhitespace),
+			input:         "\nabc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\nabc",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkWhitespace1(b *testing.B) {
+	b.ReportAllocs()
+
+	parser := Whitespace1[string]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(" \t\n\r")
+	}
+}
+
+func BenchmarkWhitespace1Long(b *testing.B) {
+	parser := Whitespace1[string]()
+	input := strings.Repeat(" \t\n\r", 250) + "abc"
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(input)
+	}
+}
+
+func BenchmarkWhitespace0Policy(b *testing.B) {
+	parser := Whitespace0[string](HorizontalWhitespace)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(" \t \nabc")
+	}
+}
+
+func TestWhitespaceRunes(t *testing.T) {
+	t.Parallel()
+
+	policy := WhitespaceRunes(' ', '\u00a0', '\u3000')
+
+	testCases := []struct {
+		name string
+		c    rune
+		want bool
+	}{
+		{name: "an ASCII rune from the set should be whitespace", c: ' ', want: true},
+		{name: "a non-ASCII rune from the set should be whitespace", c: '\u00a0', want: true},
+		{name: "a wide rune from the set should be whitespace", c: '\u3000', want: true},
+		{name: "an ASCII rune outside the set should not be whitespace", c: '\t', want: false},
+		{name: "a non-ASCII rune outside the set should not be whitespace", c: '\u2003', want: false},
+		{name: "a negative rune should not be whitespace", c: -1, want: false},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, policy(tc.c))
+		})
+	}
+}
+
+func BenchmarkWhitespaceRunes(b *testing.B) {
+	policy := WhitespaceRunes(' ', '\t', '\u00a0')
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		policy('\u00a0')
+	}
+}
+
+func TestASCIIClassesMatchPredicates(t *testing.T) {
+	t.Parallel()
+
+	predicates := []struct {
+		class     uint8
+		predicate func(rune) bool
+	}{
+		{classAlpha, IsAlpha},
+		{classDigit, IsDigit},
+		{classHexDigit, IsHexDigit},
+		{classOctDigit, IsOctDigit},
+		{classBinDigit, IsBinDigit},
+		{classWhitespace, IsWhitespace},
+	}
+
+	for c := 0; c < 256; c++ {
+		for _, p := range predicates {
+			if got, want := asciiClasses[c]&p.class != 0, p.predicate(rune(c)); got != want {
+				t.Errorf("byte %#x in class %#x: got %v, want %v", c, p.class, got, want)
+			}
+		}
+
+		// Place the byte at each position of an eight bytes block of digits,
+		// and check the block is reported as digits only if the byte is one.
+		for pos := 0; pos < 8; pos++ {
+			block := []byte("01234567")
+			block[pos] = byte(c)
+
+			if got, want := allDigits(load64(block)), IsDigit(rune(c)); got != want {
+				t.Errorf("byte %#x at position %d: got %v, want %v", c, pos, got, want)
+			}
+		}
+	}
+}
+
+func TestControl(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a control character should succeed",
+			parser:        Control[string](),
+			input:         "\x01abc",
+			wantErr:       false,
+			wantOutput:    '\x01',
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing delete should succeed",
+			parser:        Control[string](),
+			input:         "\x7f",
+			wantErr:       false,
+			wantOutput:    '\x7f',
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a printable character should fail",
+			parser:        Control[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Control[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkControl(b *testing.B) {
+	parser := Control[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\x01abc")
+	}
+}
+
+func TestControl0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing control characters should succeed",
+			parser:        Control0[string](),
+			input:         "\t\x00\x1fabc",
+			wantErr:       false,
+			wantOutput:    "\t\x00\x1f",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing no control characters should succeed",
+			parser:        Control0[string](),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        Control0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkControl0(b *testing.B) {
+	parser := Control0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\t\x00\x1fabc")
+	}
+}
+
+func TestControl1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing control characters should succeed",
+			parser:        Control1[string](),
+			input:         "\t\x00\x1fabc",
+			wantErr:       false,
+			wantOutput:    "\t\x00\x1f",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing no control characters should fail",
+			parser:        Control1[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Control1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkControl1(b *testing.B) {
+	parser := Control1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\t\x00\x1fabc")
+	}
+}
+
+func TestPrintable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a printable character should succeed",
+			parser:        Printable[string](),
+			input:         "a\x01",
+			wantErr:       false,
+			wantOutput:    'a',
+			wantRemaining: "\x01",
+		},
+		{
+			name:          "parsing a space should succeed",
+			parser:        Printable[string](),
+			input:         " ",
+			wantErr:       false,
+			wantOutput:    ' ',
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a control character should fail",
+			parser:        Printable[string](),
+			input:         "\x7f",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "\x7f",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Printable[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkPrintable(b *testing.B) {
+	parser := Printable[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a\x01")
+	}
+}
+
+func TestPrintable0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing printable characters should succeed",
+			parser:        Printable0[string](),
+			input:         "GET /\r\n",
+			wantErr:       false,
+			wantOutput:    "GET /",
+			wantRemaining: "\r\n",
+		},
+		{
+			name:          "parsing non-ASCII characters should stop",
+			parser:        Printable0[string](),
+			input:         "café",
+			wantErr:       false,
+			wantOutput:    "caf",
+			wantRemaining: "é",
+		},
+		{
+			name:          "parsing no printable characters should succeed",
+			parser:        Printable0[string](),
+			input:         "\r\n",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "\r\n",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        Printable0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkPrintable0(b *testing.B) {
+	parser := Printable0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("GET /\r\n")
+	}
+}
+
+func TestPrintable1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing printable characters should succeed",
+			parser:        Printable1[string](),
+			input:         "GET /\r\n",
+			wantErr:       false,
+			wantOutput:    "GET /",
+			wantRemaining: "\r\n",
+		},
+		{
+			name:          "parsing no printable characters should fail",
+			parser:        Printable1[string](),
+			input:         "\r\n",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\r\n",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Printable1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkPrintable1(b *testing.B) {
+	parser := Printable1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("GET /\r\n")
+	}
+}
+
+func TestAlphaUnicode0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing unicode letters should succeed",
+			parser:        AlphaUnicode0[string](),
+			input:         "Ελληνικά123",
+			wantErr:       false,
+			wantOutput:    "Ελληνικά",
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing ascii letters should succeed",
+			parser:        AlphaUnicode0[string](),
+			input:         "abc123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing no letters should succeed",
+			parser:        AlphaUnicode0[string](),
+			input:         "123",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing invalid encoding should stop",
+			parser:        AlphaUnicode0[string](),
+			input:         "ab\xffc",
+			wantErr:       false,
+			wantOutput:    "ab",
+			wantRemaining: "\xffc",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        AlphaUnicode0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkAlphaUnicode0(b *testing.B) {
+	parser := AlphaUnicode0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("Ελληνικά123")
+	}
+}
+
+func TestAlphaUnicode1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing unicode letters should succeed",
+			parser:        AlphaUnicode1[string](),
+			input:         "日本語 text",
+			wantErr:       false,
+			wantOutput:    "日本語",
+			wantRemaining: " text",
+		},
+		{
+			name:          "parsing no letters should fail",
+			parser:        AlphaUnicode1[string](),
+			input:         "123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        AlphaUnicode1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkAlphaUnicode1(b *testing.B) {
+	parser := AlphaUnicode1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("日本語 text")
+	}
+}
+
+func TestDigitUnicode0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing unicode digits should succeed",
+			parser:        DigitUnicode0[string](),
+			input:         "١٢٣abc",
+			wantErr:       false,
+			wantOutput:    "١٢٣",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing no digits should succeed",
+			parser:        DigitUnicode0[string](),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        DigitUnicode0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkDigitUnicode0(b *testing.B) {
+	parser := DigitUnicode0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("١٢٣abc")
+	}
+}
+
+func TestDigitUnicode1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing unicode digits should succeed",
+			parser:        DigitUnicode1[string](),
+			input:         "12٣abc",
+			wantErr:       false,
+			wantOutput:    "12٣",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing no digits should fail",
+			parser:        DigitUnicode1[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        DigitUnicode1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkDigitUnicode1(b *testing.B) {
+	parser := DigitUnicode1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("12٣abc")
+	}
+}
+
+func TestAlphanumericUnicode0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing unicode letters and digits should succeed",
+			parser:        AlphanumericUnicode0[string](),
+			input:         "été٣2 abc",
+			wantErr:       false,
+			wantOutput:    "été٣2",
+			wantRemaining: " abc",
+		},
+		{
+			name:          "parsing no letters or digits should succeed",
+			parser:        AlphanumericUnicode0[string](),
+			input:         " abc",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: " abc",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        AlphanumericUnicode0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkAlphanumericUnicode0(b *testing.B) {
+	parser := AlphanumericUnicode0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("été٣2 abc")
+	}
+}
+
+func TestAlphanumericUnicode1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing unicode letters and digits should succeed",
+			parser:        AlphanumericUnicode1[string](),
+			input:         "naïve42!",
+			wantErr:       false,
+			wantOutput:    "naïve42",
+			wantRemaining: "!",
+		},
+		{
+			name:          "parsing no letters or digits should fail",
+			parser:        AlphanumericUnicode1[string](),
+			input:         "!abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "!abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        AlphanumericUnicode1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkAlphanumericUnicode1(b *testing.B) {
+	parser := AlphanumericUnicode1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("naïve42!")
+	}
+}
+
+func TestUnicodeWhitespace0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing ascii and unicode whitespace should succeed",
+			parser:        UnicodeWhitespace0[string](),
+			input:         " \t\u00a0\u3000abc",
+			wantErr:       false,
+			wantOutput:    " \t\u00a0\u3000",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing no whitespace should succeed",
+			parser:        UnicodeWhitespace0[string](),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        UnicodeWhitespace0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkUnicodeWhitespace0(b *testing.B) {
+	parser := UnicodeWhitespace0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(" \t\u00a0\u3000abc")
+	}
+}
+
+func TestUnicodeWhitespace1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing unicode whitespace should succeed",
+			parser:        UnicodeWhitespace1[string](),
+			input:         "\u00a0\n abc",
+			wantErr:       false,
+			wantOutput:    "\u00a0\n ",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing no whitespace should fail",
+			parser:        UnicodeWhitespace1[string](),
+			input:         "abc ",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc ",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        UnicodeWhitespace1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkUnicodeWhitespace1(b *testing.B) {
+	parser := UnicodeWhitespace1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\u00a0\n abc")
+	}
+}
+
+func TestAlphanumeric0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single alpha char from single alphanumerical input should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "a",
+			wantErr:       false,
+			wantOutput:    "a",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single digit char from single alphanumerical input should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "1",
+			wantErr:       false,
+			wantOutput:    "1",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing alpha chars from multiple alphanumerical input should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing digit chars from multiple alphanumerical input should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "123",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing multiple alphanumerical input should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "a1b2c3",
+			wantErr:       false,
+			wantOutput:    "a1b2c3",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing alph chars until terminating char should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "abc$%^",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "$%^",
+		},
+		{
+			name:          "parsing digit chars until terminating char should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "123$%^",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "$%^",
+		},
+		{
+			name:          "parsing alphanumerical chars until terminating char should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "a1b2c3$%^",
+			wantErr:       false,
+			wantOutput:    "a1b2c3",
+			wantRemaining: "$%^",
+		},
+		{
+			name:          "parsing an empty input should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing non alphanumerical chars should succeed",
+			parser:        Alphanumeric0[string](),
+			input:         "$%^",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "$%^",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkAlphanumeric0(b *testing.B) {
+	parser := Alphanumeric0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a1b2c3")
+	}
+}
+
+func TestAlphanumeric1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single alpha char from single alphanumerical input should succeed",
+			parser:        Alphanumeric1[string](),
+			input:         "a",
+			wantErr:       false,
+			wantOutput:    "a",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single digit char from single alphanumerical input should succeed",
+			parser:        Alphanumeric1[string](),
+			input:         "1",
+			wantErr:       false,
+			wantOutput:    "1",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing alpha chars from multiple alphanumerical input should succeed",
+			parser:        Alphanumeric1[string](),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing digit chars from multiple alphanumerical input should succeed",
+			parser:        Alphanumeric1[string](),
+			input:         "123",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing alphanumerical chars from multiple alphanumerical input should succeed",
+			parser:        Alphanumeric1[string](),
+			input:         "a1b2c3",
+			wantErr:       false,
+			wantOutput:    "a1b2c3",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing alpha chars until terminating char should succeed",
+			parser:        Alphanumeric1[string](),
+			input:         "abc$%^",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "$%^",
+		},
+		{
+			name:          "parsing digit chars until terminating char should succeed",
+			parser:        Alphanumeric1[string](),
+			input:         "123$%^",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "$%^",
+		},
+		{
+			name:          "parsing alphanumerical chars until terminating char should succeed",
+			parser:        Alphanumeric1[string](),
+			input:         "a1b2c3$%^",
+			wantErr:       false,
+			wantOutput:    "a1b2c3",
+			wantRemaining: "$%^",
+		},
+		{
+			name:          "parsing an empty input should fail",
+			parser:        Alphanumeric1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input not starting with an alphanumeric char should fail",
+			parser:        Alphanumeric1[string](),
+			input:         "$1",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "$1",
+		},
+		{
+			name:          "parsing non digit chars should fail",
+			parser:        Alphanumeric1[string](),
+			input:         "$%^",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "$%^",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkAlphanumeric1(b *testing.B) {
+	parser := Alphanumeric1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a1b2c3")
+	}
+}
+
+func TestLF(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single line-feed char from single line-feed input should succeed",
+			parser:        LF[string](),
+			input:         "\n",
+			wantErr:       false,
+			wantOutput:    rune('\n'),
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single line-feed char from multiple char input should succeed",
+			parser:        LF[string](),
+			input:         "\nabc",
+			wantErr:       false,
+			wantOutput:    rune('\n'),
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LF[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single line-feed char from single non-line-feed input should fail",
+			parser:        LF[string](),
+			input:         "1",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "1",
+		},
+		{
+			name:          "parsing single line-feed from multiple non-line-feed input should fail",
+			parser:        LF[string](),
+			input:         "123",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "123",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkLF(b *testing.B) {
+	parser := LF[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\n")
+	}
+}
+
+func TestCR(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single carriage-return char from single carriage-return input should succeed",
+			parser:        CR[string](),
+			input:         "\r",
+			wantErr:       false,
+			wantOutput:    rune('\r'),
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single carriage-return char from multiple char input should succeed",
+			parser:        CR[string](),
+			input:         "\rabc",
+			wantErr:       false,
+			wantOutput:    rune('\r'),
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CR[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single carriage-return char from single non-carriage-return input should fail",
+			parser:        CR[string](),
+			input:         "1",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "1",
+		},
+		{
+			name:          "parsing single carriage-return from multiple non-carriage-return input should fail",
+			parser:        CR[string](),
+			input:         "123",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "123",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkCR(b *testing.B) {
+	parser := CR[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\r")
+	}
+}
+
+func TestCRLF(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single CRLF char from single CRLF input should succeed",
+			parser:        CRLF[string](),
+			input:         "\r\n",
+			wantErr:       false,
+			wantOutput:    "\r\n",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single CRLF char from multiple char input should succeed",
+			parser:        CRLF[string](),
+			input:         "\r\nabc",
+			wantErr:       false,
+			wantOutput:    "\r\n",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        CRLF[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing incomplete CRLF input should fail",
+			parser:        CRLF[string](),
+			input:         "\r",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\r",
+		},
+		{
+			name:          "parsing single CRLF char from single non-CRLF input should fail",
+			parser:        CRLF[string](),
+			input:         "1",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "1",
+		},
+		{
+			name:          "parsing single CRLF from multiple non-CRLF input should fail",
+			parser:        CRLF[string](),
+			input:         "123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "123",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkCRLF(b *testing.B) {
+	parser := CRLF[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\r\n")
+	}
+}
+
+func TestNewline(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a line feed should succeed",
+			parser:        Newline[string](),
+			input:         "\nabc",
+			wantErr:       false,
+			wantOutput:    "\n",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a carriage return followed by a line feed should succeed",
+			parser:        Newline[string](),
+			input:         "\r\nabc",
+			wantErr:       false,
+			wantOutput:    "\r\n",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a lone carriage return should fail",
+			parser:        Newline[string](),
+			input:         "\rabc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\rabc",
+		},
+		{
+			name:          "parsing non-matching input should fail",
+			parser:        Newline[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Newline[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkNewline(b *testing.B) {
+	parser := Newline[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\r\n")
+	}
+}
+
+func TestLineEnding(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a line feed should succeed",
+			parser:        LineEnding[string](),
+			input:         "\nabc",
+			wantErr:       false,
+			wantOutput:    "\n",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a carriage return followed by a line feed should succeed",
+			parser:        LineEnding[string](),
+			input:         "\r\nabc",
+			wantErr:       false,
+			wantOutput:    "\r\n",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a lone carriage return should succeed",
+			parser:        LineEnding[string](),
+			input:         "\rabc",
+			wantErr:       false,
+			wantOutput:    "\r",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing non-matching input should fail",
+			parser:        LineEnding[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LineEnding[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkLineEnding(b *testing.B) {
+	parser := LineEnding[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\r\n")
+	}
+}
+
+func TestNotLineEnding0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing up to a line feed should succeed",
+			parser:        NotLineEnding0[string](),
+			input:         "key = value\nnext",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "\nnext",
+		},
+		{
+			name:          "parsing up to a carriage return should succeed",
+			parser:        NotLineEnding0[string](),
+			input:         "key = value\r\nnext",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "\r\nnext",
+		},
+		{
+			name:          "parsing input without line ending should succeed",
+			parser:        NotLineEnding0[string](),
+			input:         "key = value",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input starting with a line ending should succeed",
+			parser:        NotLineEnding0[string](),
+			input:         "\nnext",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "\nnext",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        NotLineEnding0[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkNotLineEnding0(b *testing.B) {
+	parser := NotLineEnding0[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("key = value\r\n")
+	}
+}
+
+func TestNotLineEnding1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing up to a line ending should succeed",
+			parser:        NotLineEnding1[string](),
+			input:         "key = value\r\nnext",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "\r\nnext",
+		},
+		{
+			name:          "parsing input without line ending should succeed",
+			parser:        NotLineEnding1[string](),
+			input:         "key = value",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input starting with a line ending should fail",
+			parser:        NotLineEnding1[string](),
+			input:         "\nnext",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\nnext",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        NotLineEnding1[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkNotLineEnding1(b *testing.B) {
+	parser := NotLineEnding1[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("key = value\r\n")
+	}
+}
+
+func TestLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a line terminated by a line feed should succeed",
+			parser:        Line[string](),
+			input:         "key = value\nnext",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "next",
+		},
+		{
+			name:          "parsing a line terminated by CRLF should succeed",
+			parser:        Line[string](),
+			input:         "key = value\r\nnext",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "next",
+		},
+		{
+			name:          "parsing a line terminated by a lone carriage return should succeed",
+			parser:        Line[string](),
+			input:         "key = value\rnext",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "next",
+		},
+		{
+			name:          "parsing an unterminated last line should succeed",
+			parser:        Line[string](),
+			input:         "key = value",
+			wantErr:       false,
+			wantOutput:    "key = value",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an empty line should succeed",
+			parser:        Line[string](),
+			input:         "\n\nnext",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "\nnext",
+		},
+		{
+			name:          "parsing a line terminated by a carriage return at the end of the input should succeed",
+			parser:        Line[string](),
+			input:         "abc\r",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Line[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestLineMany(t *testing.T) {
+	t.Parallel()
+
+	result := Many0(Line[string]())("a\r\n\nb\rc")
+	assert.Nil(t, result.Err)
+	assert.Equal(t, []string{"a", "", "b", "c"}, result.Output)
+	assert.Equal(t, "", result.Remaining)
+}
+
+func BenchmarkLine(b *testing.B) {
+	parser := Line[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("key = value\r\nnext")
+	}
+}
+
+func TestOneOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing matched char should succeed",
+			parser:        OneOf[string]('a', '1', '+'),
+			input:         "+",
+			wantErr:       false,
+			wantOutput:    '+',
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing input not containing any of the sought chars should fail",
+			parser:        OneOf[string]('a', '1', '+'),
+			input:         "b",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "b",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        OneOf[string]('a', '1', '+'),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkOneOf(b *testing.B) {
+	parser := OneOf[string]('a', '1', '+')
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("+")
+	}
+}
+
+func TestOneOfString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a character from the set should succeed",
+			parser:        OneOfString[string](" \t\r\n"),
+			input:         "\tabc",
+			wantErr:       false,
+			wantOutput:    '\t',
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing the last character from the set should succeed",
+			parser:        OneOfString[string](" \t\r\n"),
 			input:         "\n",
 			wantErr:       false,
-			wantOutput:    rune('\n'),
+			wantOutput:    '\n',
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a character outside of the set should fail",
+			parser:        OneOfString[string](" \t\r\n"),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing with an empty set should fail",
+			parser:        OneOfString[string](""),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        OneOfString[string](" \t\r\n"),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkOneOfString(b *testing.B) {
+	parser := OneOfString[string](" \t\r\n")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\n")
+	}
+}
+
+func TestNoneOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing char not in the set should succeed",
+			parser:        NoneOf[string]('"', '\\'),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    'a',
+			wantRemaining: "bc",
+		},
+		{
+			name:          "parsing char in the set should fail",
+			parser:        NoneOf[string]('"', '\\'),
+			input:         "\\abc",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "\\abc",
+		},
+		{
+			name:          "parsing with an empty set should succeed",
+			parser:        NoneOf[string](),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    'a',
+			wantRemaining: "bc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        NoneOf[string]('"', '\\'),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkNoneOf(b *testing.B) {
+	parser := NoneOf[string]('"', '\\')
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("abc")
+	}
+}
+
+func TestSatisfy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single alpha char satisfying constraint should succeed",
+			parser:        Satisfy[string](IsAlpha),
+			input:         "a",
+			wantErr:       false,
+			wantOutput:    'a',
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing alpha char satisfying constraint from mixed input should succeed",
+			parser:        Satisfy[string](IsAlpha),
+			input:         "a1",
+			wantErr:       false,
+			wantOutput:    'a',
+			wantRemaining: "1",
+		},
+		{
+			name:          "parsing char not satisfying constraint should succeed",
+			parser:        Satisfy[string](IsAlpha),
+			input:         "1",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "1",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        Satisfy[string](IsAlpha),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSatisfy(b *testing.B) {
+	parser := Satisfy[string](IsAlpha)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a")
+	}
+}
+
+func TestSatisfyMap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int]
+		input         string
+		wantErr       bool
+		wantOutput    int
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a matching character should succeed",
+			parser:        SatisfyMap[string](digitValue),
+			input:         "7abc",
+			wantErr:       false,
+			wantOutput:    7,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a non-matching character should fail",
+			parser:        SatisfyMap[string](digitValue),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        SatisfyMap[string](digitValue),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSatisfyMap(b *testing.B) {
+	parser := SatisfyMap[string](digitValue)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("7")
+	}
+}
+
+func digitValue(c rune) (int, bool) {
+	if !IsDigit(c) {
+		return 0, false
+	}
+
+	return int(c - '0'), true
+}
+
+func TestSpace(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, rune]
+		input         string
+		wantErr       bool
+		wantOutput    rune
+		wantRemaining string
+	}{
+		{
+			name:          "parsing single space char from single space input should succeed",
+			parser:        Space[string](),
+			input:         " ",
+			wantErr:       false,
+			wantOutput:    rune(' '),
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single line-feed char from multiple char input should succeed",
-			parser:        LF[string](),
-			input:         "\nabc",
+			name:          "parsing single space char from multiple char input should succeed",
+			parser:        Space[string](),
+			input:         " abc",
 			wantErr:       false,
-			wantOutput:    rune('\n'),
+			wantOutput:    rune(' '),
 			wantRemaining: "abc",
 		},
 		{
 			name:          "parsing empty input should fail",
-			parser:        LF[string](),
+			parser:        Space[string](),
 			input:         "",
 			wantErr:       true,
 			wantOutput:    rune(0),
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single line-feed char from single non-line-feed input should fail",
-			parser:        LF[string](),
+			name:          "parsing single space char from single non-space input should fail",
+			parser:        Space[string](),
 			input:         "1",
 			wantErr:       true,
 			wantOutput:    rune(0),
 			wantRemaining: "1",
 		},
 		{
-			name:          "parsing single line-feed from multiple non-line-feed input should fail",
-			parser:        LF[string](),
+			name:          "parsing single space from multiple non-space input should fail",
+			parser:        Space[string](),
 			input:         "123",
 			wantErr:       true,
 			wantOutput:    rune(0),
@@ -1248,15 +4305,17 @@ func TestLF(t *testing.T) {
 	}
 }
 
-func BenchmarkLF(b *testing.B) {
-	parser := LF[string]()
+func BenchmarkSpace(b *testing.B) {
+	parser := Space[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("\n")
+		parser(" ")
 	}
 }
 
-func TestCR(t *testing.T) {
+func TestTab(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
@@ -1268,47 +4327,280 @@ func TestCR(t *testing.T) {
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single carriage-return char from single carriage-return input should succeed",
-			parser:        CR[string](),
-			input:         "\r",
+			name:          "parsing single space char from single space input should succeed",
+			parser:        Tab[string](),
+			input:         "\t",
 			wantErr:       false,
-			wantOutput:    rune('\r'),
+			wantOutput:    rune('\t'),
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single space char from multiple char input should succeed",
+			parser:        Tab[string](),
+			input:         "\tabc",
+			wantErr:       false,
+			wantOutput:    rune('\t'),
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Tab[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing single space char from single non-space input should fail",
+			parser:        Tab[string](),
+			input:         "1",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "1",
+		},
+		{
+			name:          "parsing single space from multiple non-space input should fail",
+			parser:        Tab[string](),
+			input:         "123",
+			wantErr:       true,
+			wantOutput:    rune(0),
+			wantRemaining: "123",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkTab(b *testing.B) {
+	parser := Tab[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("\t")
+	}
+}
+
+func TestInt64(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing positive integer should succeed",
+			parser:        Int64[string](),
+			input:         "123",
+			wantErr:       false,
+			wantOutput:    123,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing negative integer should succeed",
+			parser:        Int64[string](),
+			input:         "-123",
+			wantErr:       false,
+			wantOutput:    -123,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing positive integer prefix should succeed",
+			parser:        Int64[string](),
+			input:         "123abc",
+			wantErr:       false,
+			wantOutput:    123,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing negative integer should succeed",
+			parser:        Int64[string](),
+			input:         "-123abc",
+			wantErr:       false,
+			wantOutput:    -123,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing the largest integer should succeed",
+			parser:        Int64[string](),
+			input:         "9223372036854775807",
+			wantErr:       false,
+			wantOutput:    math.MaxInt64,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing the smallest integer should succeed",
+			parser:        Int64[string](),
+			input:         "-9223372036854775808",
+			wantErr:       false,
+			wantOutput:    math.MinInt64,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing underflowing integer should fail",
+			parser:        Int64[string](),
+			input:         "-9223372036854775809", // min int64 - 1
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-9223372036854775809",
+		},
+		{
+			name:          "parsing overflowing integer should fail",
+			parser:        Int64[string](),
+			input:         "9223372036854775808", // max int64 + 1
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "9223372036854775808",
+		},
+		{
+			name:          "parsing integer with invalid leading sign should fail",
+			parser:        Int64[string](),
+			input:         "!127",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "!127",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkInt64(b *testing.B) {
+	parser := Int64[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("123")
+	}
+}
+
+func TestInt64With(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing grouped digits should succeed",
+			parser:        Int64With[string](IntDigitSeparators),
+			input:         "1_000_000 ",
+			wantErr:       false,
+			wantOutput:    1000000,
+			wantRemaining: " ",
+		},
+		{
+			name:          "parsing negative grouped digits should succeed",
+			parser:        Int64With[string](IntDigitSeparators),
+			input:         "-12_34",
+			wantErr:       false,
+			wantOutput:    -1234,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single carriage-return char from multiple char input should succeed",
-			parser:        CR[string](),
-			input:         "\rabc",
-			wantErr:       false,
-			wantOutput:    rune('\r'),
-			wantRemaining: "abc",
+			name:          "parsing ungrouped digits should succeed",
+			parser:        Int64With[string](IntDigitSeparators),
+			input:         "1234,",
+			wantErr:       false,
+			wantOutput:    1234,
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing a trailing separator should fail",
+			parser:        Int64With[string](IntDigitSeparators),
+			input:         "1_000_",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1_000_",
+		},
+		{
+			name:          "parsing consecutive separators should fail",
+			parser:        Int64With[string](IntDigitSeparators),
+			input:         "1__000",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1__000",
 		},
 		{
-			name:          "parsing empty input should fail",
-			parser:        CR[string](),
-			input:         "",
+			name:          "parsing a leading separator should fail",
+			parser:        Int64With[string](IntDigitSeparators),
+			input:         "_1000",
 			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "",
+			wantOutput:    0,
+			wantRemaining: "_1000",
 		},
 		{
-			name:          "parsing single carriage-return char from single non-carriage-return input should fail",
-			parser:        CR[string](),
-			input:         "1",
+			name:          "parsing a separator after the sign should fail",
+			parser:        Int64With[string](IntDigitSeparators),
+			input:         "-_1",
 			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "1",
+			wantOutput:    0,
+			wantRemaining: "-_1",
 		},
 		{
-			name:          "parsing single carriage-return from multiple non-carriage-return input should fail",
-			parser:        CR[string](),
-			input:         "123",
+			name:          "parsing grouped digits without the format should stop at the separator",
+			parser:        Int64With[string](0),
+			input:         "1_000",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: "_000",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Int64With[string](IntDigitSeparators),
+			input:         "",
 			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "123",
+			wantOutput:    0,
+			wantRemaining: "",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -1331,72 +4623,106 @@ func TestCR(t *testing.T) {
 	}
 }
 
-func BenchmarkCR(b *testing.B) {
-	parser := CR[string]()
+func BenchmarkInt64With(b *testing.B) {
+	parser := Int64With[string](IntDigitSeparators)
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("\r")
+		parser("1_000_000 ")
 	}
 }
 
-func TestCRLF(t *testing.T) {
+func TestInt8(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, string]
+		parser        Parser[string, int8]
 		input         string
 		wantErr       bool
-		wantOutput    string
+		wantOutput    int8
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single CRLF char from single CRLF input should succeed",
-			parser:        CRLF[string](),
-			input:         "\r\n",
+			name:          "parsing positive integer should succeed",
+			parser:        Int8[string](),
+			input:         "123",
 			wantErr:       false,
-			wantOutput:    "\r\n",
+			wantOutput:    123,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single CRLF char from multiple char input should succeed",
-			parser:        CRLF[string](),
-			input:         "\r\nabc",
+			name:          "parsing the smallest integer should succeed",
+			parser:        Int8[string](),
+			input:         "-128",
 			wantErr:       false,
-			wantOutput:    "\r\n",
-			wantRemaining: "abc",
+			wantOutput:    -128,
+			wantRemaining: "",
 		},
 		{
-			name:          "parsing empty input should fail",
-			parser:        CRLF[string](),
-			input:         "",
+			name:          "parsing underflowing integer should fail",
+			parser:        Int8[string](),
+			input:         "-129",
 			wantErr:       true,
-			wantOutput:    "",
+			wantOutput:    0,
+			wantRemaining: "-129",
+		},
+		{
+			name:          "parsing negative integer should succeed",
+			parser:        Int8[string](),
+			input:         "-123",
+			wantErr:       false,
+			wantOutput:    -123,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing incomplete CRLF input should fail",
-			parser:        CRLF[string](),
-			input:         "\r",
-			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "\r",
+			name:          "parsing positive integer prefix should succeed",
+			parser:        Int8[string](),
+			input:         "123abc",
+			wantErr:       false,
+			wantOutput:    123,
+			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing single CRLF char from single non-CRLF input should fail",
-			parser:        CRLF[string](),
-			input:         "1",
+			name:          "parsing negative integer should succeed",
+			parser:        Int8[string](),
+			input:         "-123abc",
+			wantErr:       false,
+			wantOutput:    -123,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing overflowing integer should fail",
+			parser:        Int8[string](),
+			input:         "128", // max int8 + 1
 			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "1",
+			wantOutput:    0,
+			wantRemaining: "128",
 		},
 		{
-			name:          "parsing single CRLF from multiple non-CRLF input should fail",
-			parser:        CRLF[string](),
-			input:         "123",
+			name:          "parsing integer with invalid leading sign should fail",
+			parser:        Int8[string](),
+			input:         "!127",
 			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "123",
+			wantOutput:    0,
+			wantRemaining: "!127",
+		},
+		{
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        Int8[string](IntDigitSeparators),
+			input:         "-1_27;",
+			wantErr:       false,
+			wantOutput:    -127,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an integer with digit separators without IntDigitSeparators should stop before them",
+			parser:        Int8[string](),
+			input:         "-1_27",
+			wantErr:       false,
+			wantOutput:    -1,
+			wantRemaining: "_27",
 		},
 	}
 
@@ -1422,51 +4748,142 @@ func TestCRLF(t *testing.T) {
 	}
 }
 
-func BenchmarkCRLF(b *testing.B) {
-	parser := CRLF[string]()
+func BenchmarkInt8(b *testing.B) {
+	parser := Int8[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("\r\n")
+		parser("123")
 	}
 }
 
-func TestOneOf(t *testing.T) {
+func TestIntegerParsersDoNotAllocate(t *testing.T) {
+	input := []byte("-1_234_567 rest")
+	parsers := map[string]Parser[[]byte, int64]{
+		"Int64":     Int64[[]byte](),
+		"Int64With": Int64With[[]byte](IntDigitSeparators),
+	}
+
+	for name, parser := range parsers {
+		allocs := testing.AllocsPerRun(100, func() {
+			parser(input)
+		})
+
+		if allocs != 0 {
+			t.Errorf("%s: got %v allocations per run, want 0", name, allocs)
+		}
+	}
+}
+
+func TestInt8OutOfRange(t *testing.T) {
+	t.Parallel()
+
+	result := Int8[string]()("-129,")
+
+	assert.Error(t, result.Err)
+	assert.True(t, result.Err.IsFatal())
+	assert.True(t, errors.Is(result.Err, strconv.ErrRange))
+	assert.Equal(t, "expected Int8: number -129 out of range", result.Err.Error())
+	assert.Equal(t, "-129,", result.Remaining)
+
+	var rangeErr *RangeError
+	assert.True(t, errors.As(result.Err, &rangeErr))
+	assert.Equal(t, "-129", rangeErr.Literal)
+}
+
+func TestInt16(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, rune]
+		parser        Parser[string, int16]
 		input         string
 		wantErr       bool
-		wantOutput    rune
+		wantOutput    int16
 		wantRemaining string
 	}{
 		{
-			name:          "parsing matched char should succeed",
-			parser:        OneOf[string]('a', '1', '+'),
-			input:         "+",
+			name:          "parsing a positive integer should succeed",
+			parser:        Int16[string](),
+			input:         "123abc",
 			wantErr:       false,
-			wantOutput:    '+',
+			wantOutput:    123,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a negative integer should succeed",
+			parser:        Int16[string](),
+			input:         "-123",
+			wantErr:       false,
+			wantOutput:    -123,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing input not containing any of the sought chars should fail",
-			parser:        OneOf[string]('a', '1', '+'),
-			input:         "b",
+			name:          "parsing the largest value should succeed",
+			parser:        Int16[string](),
+			input:         "32767",
+			wantErr:       false,
+			wantOutput:    32767,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing the smallest value should succeed",
+			parser:        Int16[string](),
+			input:         "-32768",
+			wantErr:       false,
+			wantOutput:    -32768,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an overflowing integer should fail",
+			parser:        Int16[string](),
+			input:         "32768",
 			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "b",
+			wantOutput:    0,
+			wantRemaining: "32768",
+		},
+		{
+			name:          "parsing an underflowing integer should fail",
+			parser:        Int16[string](),
+			input:         "-32769",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-32769",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        Int16[string](),
+			input:         "-abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-abc",
 		},
 		{
 			name:          "parsing empty input should fail",
-			parser:        OneOf[string]('a', '1', '+'),
+			parser:        Int16[string](),
 			input:         "",
 			wantErr:       true,
-			wantOutput:    rune(0),
+			wantOutput:    0,
 			wantRemaining: "",
 		},
+		{
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        Int16[string](IntDigitSeparators),
+			input:         "-32_767;",
+			wantErr:       false,
+			wantOutput:    -32767,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an integer with digit separators without IntDigitSeparators should stop before them",
+			parser:        Int16[string](),
+			input:         "-32_767",
+			wantErr:       false,
+			wantOutput:    -32,
+			wantRemaining: "_767",
+		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -1489,59 +4906,108 @@ func TestOneOf(t *testing.T) {
 	}
 }
 
-func BenchmarkOneOf(b *testing.B) {
-	parser := OneOf[string]('a', '1', '+')
+func BenchmarkInt16(b *testing.B) {
+	parser := Int16[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("+")
+		parser("-123")
 	}
 }
 
-func TestSatisfy(t *testing.T) {
+func TestInt32(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, rune]
+		parser        Parser[string, int32]
 		input         string
 		wantErr       bool
-		wantOutput    rune
+		wantOutput    int32
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single alpha char satisfying constraint should succeed",
-			parser:        Satisfy[string](IsAlpha),
-			input:         "a",
+			name:          "parsing a positive integer should succeed",
+			parser:        Int32[string](),
+			input:         "123abc",
 			wantErr:       false,
-			wantOutput:    'a',
+			wantOutput:    123,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a negative integer should succeed",
+			parser:        Int32[string](),
+			input:         "-123",
+			wantErr:       false,
+			wantOutput:    -123,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing alpha char satisfying constraint from mixed input should succeed",
-			parser:        Satisfy[string](IsAlpha),
-			input:         "a1",
+			name:          "parsing the largest value should succeed",
+			parser:        Int32[string](),
+			input:         "2147483647",
 			wantErr:       false,
-			wantOutput:    'a',
-			wantRemaining: "1",
+			wantOutput:    2147483647,
+			wantRemaining: "",
 		},
 		{
-			name:          "parsing char not satisfying constraint should succeed",
-			parser:        Satisfy[string](IsAlpha),
-			input:         "1",
+			name:          "parsing the smallest value should succeed",
+			parser:        Int32[string](),
+			input:         "-2147483648",
+			wantErr:       false,
+			wantOutput:    -2147483648,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an overflowing integer should fail",
+			parser:        Int32[string](),
+			input:         "2147483648",
 			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "1",
+			wantOutput:    0,
+			wantRemaining: "2147483648",
 		},
 		{
-			name:          "parsing empty input should succeed",
-			parser:        Satisfy[string](IsAlpha),
+			name:          "parsing an underflowing integer should fail",
+			parser:        Int32[string](),
+			input:         "-2147483649",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-2147483649",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        Int32[string](),
+			input:         "-abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Int32[string](),
 			input:         "",
 			wantErr:       true,
-			wantOutput:    rune(0),
+			wantOutput:    0,
 			wantRemaining: "",
 		},
+		{
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        Int32[string](IntDigitSeparators),
+			input:         "-2_147_483_647;",
+			wantErr:       false,
+			wantOutput:    -2147483647,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an integer with digit separators without IntDigitSeparators should stop before them",
+			parser:        Int32[string](),
+			input:         "-2_147_483_647",
+			wantErr:       false,
+			wantOutput:    -2,
+			wantRemaining: "_147_483_647",
+		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -1564,67 +5030,76 @@ func TestSatisfy(t *testing.T) {
 	}
 }
 
-func BenchmarkSatisfy(b *testing.B) {
-	parser := Satisfy[string](IsAlpha)
+func BenchmarkInt32(b *testing.B) {
+	parser := Int32[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("a")
+		parser("-123")
 	}
 }
 
-func TestSpace(t *testing.T) {
+func TestInt(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, rune]
+		parser        Parser[string, int]
 		input         string
 		wantErr       bool
-		wantOutput    rune
+		wantOutput    int
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single space char from single space input should succeed",
-			parser:        Space[string](),
-			input:         " ",
+			name:          "parsing a positive integer should succeed",
+			parser:        Int[string](),
+			input:         "123abc",
+			wantErr:       false,
+			wantOutput:    123,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a negative integer should succeed",
+			parser:        Int[string](),
+			input:         "-123",
 			wantErr:       false,
-			wantOutput:    rune(' '),
+			wantOutput:    -123,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single space char from multiple char input should succeed",
-			parser:        Space[string](),
-			input:         " abc",
-			wantErr:       false,
-			wantOutput:    rune(' '),
-			wantRemaining: "abc",
+			name:          "parsing an integer overflowing 64 bits should fail",
+			parser:        Int[string](),
+			input:         "9223372036854775808",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "9223372036854775808",
 		},
 		{
 			name:          "parsing empty input should fail",
-			parser:        Space[string](),
+			parser:        Int[string](),
 			input:         "",
 			wantErr:       true,
-			wantOutput:    rune(0),
+			wantOutput:    0,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single space char from single non-space input should fail",
-			parser:        Space[string](),
-			input:         "1",
-			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "1",
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        Int[string](IntDigitSeparators),
+			input:         "-1_000;",
+			wantErr:       false,
+			wantOutput:    -1000,
+			wantRemaining: ";",
 		},
 		{
-			name:          "parsing single space from multiple non-space input should fail",
-			parser:        Space[string](),
-			input:         "123",
-			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "123",
+			name:          "parsing an integer with digit separators without IntDigitSeparators should stop before them",
+			parser:        Int[string](),
+			input:         "-1_000",
+			wantErr:       false,
+			wantOutput:    -1,
+			wantRemaining: "_000",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -1647,64 +5122,74 @@ func TestSpace(t *testing.T) {
 	}
 }
 
-func BenchmarkSpace(b *testing.B) {
-	parser := Space[string]()
+func BenchmarkInt(b *testing.B) {
+	parser := Int[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser(" ")
+		parser("-123")
 	}
 }
 
-func TestTab(t *testing.T) {
+func TestUInt8(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, rune]
+		parser        Parser[string, uint8]
 		input         string
 		wantErr       bool
-		wantOutput    rune
+		wantOutput    uint8
 		wantRemaining string
 	}{
 		{
-			name:          "parsing single space char from single space input should succeed",
-			parser:        Tab[string](),
-			input:         "\t",
+			name:          "parsing positive integer should succeed",
+			parser:        UInt8[string](),
+			input:         "253",
 			wantErr:       false,
-			wantOutput:    rune('\t'),
+			wantOutput:    253,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single space char from multiple char input should succeed",
-			parser:        Tab[string](),
-			input:         "\tabc",
+			name:          "parsing positive integer prefix should succeed",
+			parser:        UInt8[string](),
+			input:         "253abc",
 			wantErr:       false,
-			wantOutput:    rune('\t'),
+			wantOutput:    253,
 			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing empty input should fail",
-			parser:        Tab[string](),
+			name:          "parsing overflowing integer should fail",
+			parser:        UInt8[string](),
+			input:         "256", // max uint8 + 1
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "256",
+		},
+		{
+			name:          "parsing empty input should succeed",
+			parser:        UInt8[string](),
 			input:         "",
 			wantErr:       true,
-			wantOutput:    rune(0),
+			wantOutput:    0,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing single space char from single non-space input should fail",
-			parser:        Tab[string](),
-			input:         "1",
-			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "1",
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        UInt8[string](IntDigitSeparators),
+			input:         "2_55;",
+			wantErr:       false,
+			wantOutput:    255,
+			wantRemaining: ";",
 		},
 		{
-			name:          "parsing single space from multiple non-space input should fail",
-			parser:        Tab[string](),
-			input:         "123",
+			name:          "parsing an integer with misplaced digit separators using IntDigitSeparators should fail",
+			parser:        UInt8[string](IntDigitSeparators),
+			input:         "1__0",
 			wantErr:       true,
-			wantOutput:    rune(0),
-			wantRemaining: "123",
+			wantOutput:    0,
+			wantRemaining: "1__0",
 		},
 	}
 
@@ -1730,75 +5215,84 @@ func TestTab(t *testing.T) {
 	}
 }
 
-func BenchmarkTab(b *testing.B) {
-	parser := Tab[string]()
+func BenchmarkUInt8(b *testing.B) {
+	parser := UInt8[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("\t")
+		parser("253")
 	}
 }
 
-func TestInt64(t *testing.T) {
+func TestUInt16(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, int64]
+		parser        Parser[string, uint16]
 		input         string
 		wantErr       bool
-		wantOutput    int64
+		wantOutput    uint16
 		wantRemaining string
 	}{
 		{
-			name:          "parsing positive integer should succeed",
-			parser:        Int64[string](),
-			input:         "123",
+			name:          "parsing an integer should succeed",
+			parser:        UInt16[string](),
+			input:         "123abc",
 			wantErr:       false,
 			wantOutput:    123,
-			wantRemaining: "",
+			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing negative integer should succeed",
-			parser:        Int64[string](),
-			input:         "-123",
+			name:          "parsing the largest value should succeed",
+			parser:        UInt16[string](),
+			input:         "65535",
 			wantErr:       false,
-			wantOutput:    -123,
+			wantOutput:    65535,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing positive integer prefix should succeed",
-			parser:        Int64[string](),
-			input:         "123abc",
-			wantErr:       false,
-			wantOutput:    123,
-			wantRemaining: "abc",
+			name:          "parsing an overflowing integer should fail",
+			parser:        UInt16[string](),
+			input:         "65536",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "65536",
 		},
 		{
-			name:          "parsing negative integer should succeed",
-			parser:        Int64[string](),
-			input:         "-123abc",
-			wantErr:       false,
-			wantOutput:    -123,
-			wantRemaining: "abc",
+			name:          "parsing a negative integer should fail",
+			parser:        UInt16[string](),
+			input:         "-1",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-1",
 		},
 		{
-			name:          "parsing overflowing integer should fail",
-			parser:        Int64[string](),
-			input:         "9223372036854775808", // max int64 + 1
+			name:          "parsing empty input should fail",
+			parser:        UInt16[string](),
+			input:         "",
 			wantErr:       true,
 			wantOutput:    0,
-			wantRemaining: "9223372036854775808",
+			wantRemaining: "",
 		},
 		{
-			name:          "parsing integer with invalid leading sign should fail",
-			parser:        Int64[string](),
-			input:         "!127",
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        UInt16[string](IntDigitSeparators),
+			input:         "65_535;",
+			wantErr:       false,
+			wantOutput:    65535,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an integer with misplaced digit separators using IntDigitSeparators should fail",
+			parser:        UInt16[string](IntDigitSeparators),
+			input:         "1__0",
 			wantErr:       true,
 			wantOutput:    0,
-			wantRemaining: "!127",
+			wantRemaining: "1__0",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -1821,75 +5315,84 @@ func TestInt64(t *testing.T) {
 	}
 }
 
-func BenchmarkInt64(b *testing.B) {
-	parser := Int64[string]()
+func BenchmarkUInt16(b *testing.B) {
+	parser := UInt16[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		parser("123")
 	}
 }
 
-func TestInt8(t *testing.T) {
+func TestUInt32(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, int8]
+		parser        Parser[string, uint32]
 		input         string
 		wantErr       bool
-		wantOutput    int8
+		wantOutput    uint32
 		wantRemaining string
 	}{
 		{
-			name:          "parsing positive integer should succeed",
-			parser:        Int8[string](),
-			input:         "123",
+			name:          "parsing an integer should succeed",
+			parser:        UInt32[string](),
+			input:         "123abc",
 			wantErr:       false,
 			wantOutput:    123,
-			wantRemaining: "",
+			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing negative integer should succeed",
-			parser:        Int8[string](),
-			input:         "-123",
+			name:          "parsing the largest value should succeed",
+			parser:        UInt32[string](),
+			input:         "4294967295",
 			wantErr:       false,
-			wantOutput:    -123,
+			wantOutput:    4294967295,
 			wantRemaining: "",
 		},
 		{
-			name:          "parsing positive integer prefix should succeed",
-			parser:        Int8[string](),
-			input:         "123abc",
-			wantErr:       false,
-			wantOutput:    123,
-			wantRemaining: "abc",
+			name:          "parsing an overflowing integer should fail",
+			parser:        UInt32[string](),
+			input:         "4294967296",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "4294967296",
 		},
 		{
-			name:          "parsing negative integer should succeed",
-			parser:        Int8[string](),
-			input:         "-123abc",
-			wantErr:       false,
-			wantOutput:    -123,
-			wantRemaining: "abc",
+			name:          "parsing a negative integer should fail",
+			parser:        UInt32[string](),
+			input:         "-1",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-1",
 		},
 		{
-			name:          "parsing overflowing integer should fail",
-			parser:        Int8[string](),
-			input:         "128", // max int8 + 1
+			name:          "parsing empty input should fail",
+			parser:        UInt32[string](),
+			input:         "",
 			wantErr:       true,
 			wantOutput:    0,
-			wantRemaining: "128",
+			wantRemaining: "",
 		},
 		{
-			name:          "parsing integer with invalid leading sign should fail",
-			parser:        Int8[string](),
-			input:         "!127",
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        UInt32[string](IntDigitSeparators),
+			input:         "4_294_967_295;",
+			wantErr:       false,
+			wantOutput:    4294967295,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an integer with misplaced digit separators using IntDigitSeparators should fail",
+			parser:        UInt32[string](IntDigitSeparators),
+			input:         "1__0",
 			wantErr:       true,
 			wantOutput:    0,
-			wantRemaining: "!127",
+			wantRemaining: "1__0",
 		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -1912,59 +5415,84 @@ func TestInt8(t *testing.T) {
 	}
 }
 
-func BenchmarkInt8(b *testing.B) {
-	parser := Int8[string]()
+func BenchmarkUInt32(b *testing.B) {
+	parser := UInt32[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		parser("123")
 	}
 }
 
-func TestUInt8(t *testing.T) {
+func TestUInt64(t *testing.T) {
 	t.Parallel()
 
 	testCases := []struct {
 		name          string
-		parser        Parser[string, uint8]
+		parser        Parser[string, uint64]
 		input         string
 		wantErr       bool
-		wantOutput    uint8
+		wantOutput    uint64
 		wantRemaining string
 	}{
 		{
-			name:          "parsing positive integer should succeed",
-			parser:        UInt8[string](),
-			input:         "253",
+			name:          "parsing an integer should succeed",
+			parser:        UInt64[string](),
+			input:         "123abc",
 			wantErr:       false,
-			wantOutput:    253,
-			wantRemaining: "",
+			wantOutput:    123,
+			wantRemaining: "abc",
 		},
 		{
-			name:          "parsing positive integer prefix should succeed",
-			parser:        UInt8[string](),
-			input:         "253abc",
+			name:          "parsing the largest value should succeed",
+			parser:        UInt64[string](),
+			input:         "18446744073709551615",
 			wantErr:       false,
-			wantOutput:    253,
-			wantRemaining: "abc",
+			wantOutput:    18446744073709551615,
+			wantRemaining: "",
 		},
 		{
-			name:          "parsing overflowing integer should fail",
-			parser:        UInt8[string](),
-			input:         "256", // max uint8 + 1
+			name:          "parsing an overflowing integer should fail",
+			parser:        UInt64[string](),
+			input:         "18446744073709551616",
 			wantErr:       true,
 			wantOutput:    0,
-			wantRemaining: "256",
+			wantRemaining: "18446744073709551616",
 		},
 		{
-			name:          "parsing empty input should succeed",
-			parser:        UInt8[string](),
+			name:          "parsing a negative integer should fail",
+			parser:        UInt64[string](),
+			input:         "-1",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-1",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        UInt64[string](),
 			input:         "",
 			wantErr:       true,
 			wantOutput:    0,
 			wantRemaining: "",
 		},
+		{
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        UInt64[string](IntDigitSeparators),
+			input:         "18_446_744_073_709_551_615;",
+			wantErr:       false,
+			wantOutput:    18446744073709551615,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an integer with misplaced digit separators using IntDigitSeparators should fail",
+			parser:        UInt64[string](IntDigitSeparators),
+			input:         "1__0",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1__0",
+		},
 	}
-
 	for _, tc := range testCases {
 		tc := tc
 
@@ -1987,11 +5515,13 @@ func TestUInt8(t *testing.T) {
 	}
 }
 
-func BenchmarkUInt8(b *testing.B) {
-	parser := UInt8[string]()
+func BenchmarkUInt64(b *testing.B) {
+	parser := UInt64[string]()
 
+	b.ReportAllocs()
+	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("253")
+		parser("123")
 	}
 }
 
@@ -2057,3 +5587,60 @@ func TestIsControl(t *testing.T) {
 		})
 	}
 }
+
+func TestCharRangeBytes(t *testing.T) {
+	t.Parallel()
+
+	result := CharRange[[]byte](0x4E00, 0x9FFF)([]byte("中文"))
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, '中', result.Output)
+	assert.Equal(t, []byte("文"), result.Remaining)
+}
+
+func TestPrimitivesDoNotAllocate(t *testing.T) {
+	testCases := []struct {
+		name  string
+		parse func([]byte)
+		input []byte
+	}{
+		{name: "Char", parse: untypedParse(Char[[]byte]('a')), input: []byte("abc")},
+		{name: "AnyChar", parse: untypedParse(AnyChar[[]byte]()), input: []byte("abc")},
+		{name: "AnyRune", parse: untypedParse(AnyRune[[]byte]()), input: []byte("éabc")},
+		{name: "OneOf", parse: untypedParse(OneOf[[]byte]('a', 'b')), input: []byte("abc")},
+		{name: "Satisfy", parse: untypedParse(Satisfy[[]byte](IsAlpha)), input: []byte("abc")},
+		{name: "Alpha1", parse: untypedParse(Alpha1[[]byte]()), input: []byte("abc123")},
+		{name: "Digit1", parse: untypedParse(Digit1[[]byte]()), input: []byte("123abc")},
+		{name: "Whitespace1", parse: untypedParse(Whitespace1[[]byte]()), input: []byte(" \tabc")},
+		{name: "CRLF", parse: untypedParse(CRLF[[]byte]()), input: []byte("\r\nabc")},
+		{name: "Int64", parse: untypedParse(Int64[[]byte]()), input: []byte("-123abc")},
+		{name: "Float64", parse: untypedParse(Float64[[]byte]()), input: []byte("-1.5e3abc")},
+		{name: "Take", parse: untypedParse(Take[[]byte](2)), input: []byte("abc")},
+		{name: "Token", parse: untypedParse(Token[[]byte]("ab")), input: []byte("abc")},
+		{name: "TokenNoCase", parse: untypedParse(TokenNoCase[[]byte]("AB")), input: []byte("abc")},
+		{name: "TakeUntilToken", parse: untypedParse(TakeUntilToken[[]byte]("c")), input: []byte("abc")},
+		{name: "TakeUntil", parse: untypedParse(TakeUntil[[]byte](Char[[]byte]('c'))), input: []byte("abc")},
+		{name: "TakeUntil failing", parse: untypedParse(TakeUntil[[]byte](Char[[]byte]('c'))), input: []byte("abd")},
+		{name: "TakeWhileOneOf", parse: untypedParse(TakeWhileOneOf[[]byte]('a', 'b')), input: []byte("abc")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		allocs := testing.AllocsPerRun(100, func() {
+			tc.parse(tc.input)
+		})
+
+		if allocs != 0 {
+			t.Errorf("%s: got %v allocations per run, want 0", tc.name, allocs)
+		}
+	}
+}
+
+// untypedParse wraps the provided parser into a function discarding its result,
+// so that parsers of different output types can be exercised alike.
+func untypedParse[O any](parse Parser[[]byte, O]) func([]byte) {
+	return func(input []byte) {
+		parse(input)
+	}
+}
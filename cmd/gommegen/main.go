@@ -0,0 +1,102 @@
+// Command gommegen generates the Go code of a parser out of a grammar, described
+// using the EBNF notation of the github.com/oleiade/gomme/grammar package.
+//
+// Usage:
+//
+//	gommegen [flags] grammar.ebnf
+//
+// The flags are:
+//
+//	-package name
+//		the generated file's package; defaults to $GOPACKAGE
+//	-type name
+//		the generated parser type's name; defaults to "Parser"
+//	-input string|bytes
+//		the type of the inputs the generated parser consumes; defaults to string
+//	-o file
+//		the generated file; defaults to the grammar file's name, with its
+//		extension replaced by "_parser.go"
+//
+// It is meant to be used through go:generate directives, such as:
+//
+//	//go:generate go run github.com/oleiade/gomme/cmd/gommegen calc.ebnf
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/oleiade/gomme"
+	"github.com/oleiade/gomme/grammar"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "gommegen:", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the parser described by the provided command line arguments.
+func run(args []string, stderr io.Writer) error {
+	flags := flag.NewFlagSet("gommegen", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	pkg := flags.String("package", os.Getenv("GOPACKAGE"), "the generated file's package")
+	typeName := flags.String("type", "Parser", "the generated parser type's name")
+	input := flags.String("input", "string", "the type of the inputs the parser consumes: string or bytes")
+	output := flags.String("o", "", "the generated file")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return errors.New("expected a single grammar file")
+	}
+
+	path := flags.Arg(0)
+	if *output == "" {
+		*output = strings.TrimSuffix(path, filepath.Ext(path)) + "_parser.go"
+	}
+
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	options := grammar.GenerateOptions{Package: *pkg, Type: *typeName, Source: filepath.Base(path)}
+
+	var code bytes.Buffer
+	switch *input {
+	case "string":
+		err = generate[string](&code, string(text), options)
+	case "bytes":
+		err = generate[[]byte](&code, string(text), options)
+	default:
+		err = fmt.Errorf("unknown input type %q", *input)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(*output, code.Bytes(), 0o644)
+}
+
+// generate writes the code of the parser for the provided grammar text, consuming
+// inputs of type Input, to the provided writer.
+func generate[Input gomme.Bytes](w io.Writer, text string, options grammar.GenerateOptions) error {
+	g, err := grammar.Parse[Input](text)
+	if err != nil {
+		return err
+	}
+
+	return g.Generate(w, options)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "digits.ebnf")
+	if err := os.WriteFile(path, []byte(`Digits = "0"..."9" { "0"..."9" } .`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, run([]string{"-package", "digits", "-input", "bytes", path}, io.Discard))
+
+	code, err := os.ReadFile(filepath.Join(dir, "digits_parser.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.True(t, strings.HasPrefix(string(code), "// Code generated by gommegen from digits.ebnf. DO NOT EDIT."))
+	assert.Contains(t, string(code), "func (p *Parser) Digits(input []byte) gomme.Result[any, []byte] {")
+
+	output := filepath.Join(dir, "custom.go")
+	assert.NoError(t, run([]string{"-package", "digits", "-type", "Digits", "-o", output, path}, io.Discard))
+
+	code, err = os.ReadFile(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Contains(t, string(code), "func (p *Digits) Digits(input string) gomme.Result[any, string] {")
+}
+
+func TestRunErrors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "invalid.ebnf")
+	if err := os.WriteFile(path, []byte(`Digits = "0"..."9"`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Error(t, run([]string{"-package", "p", path}, io.Discard))
+	assert.Error(t, run([]string{"-package", "p", "-input", "runes", path}, io.Discard))
+	assert.Error(t, run([]string{"-package", "p", filepath.Join(dir, "missing.ebnf")}, io.Discard))
+	assert.Error(t, run([]string{"-package", "p"}, io.Discard))
+
+	_, err := os.Stat(filepath.Join(dir, "invalid_parser.go"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -18,6 +18,11 @@ type Separator interface {
 	rune | byte | string
 }
 
+// Integer is a generic type constraint for integer types
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
 // Result is a generic type alias for Result
 type Result[Output any, Remaining Bytes] struct {
 	Output    Output
@@ -25,6 +30,18 @@ type Result[Output any, Remaining Bytes] struct {
 	Remaining Remaining
 }
 
+// Report returns the Result's error, if any, recording the input the parser
+// failed at. Primitive parsers share a single Error across their failures, which
+// leaves its Input empty: Report replaces it by a complete one, and returns any
+// other error as is.
+func (r Result[Output, Remaining]) Report() *Error[Remaining] {
+	if r.Err == nil {
+		return nil
+	}
+
+	return r.Err.at(r.Remaining)
+}
+
 // Parser is a generic type alias for Parser
 type Parser[Input Bytes, Output any] func(input Input) Result[Output, Input]
 
@@ -42,12 +59,13 @@ func Failure[Input Bytes, Output any](err *Error[Input], input Input) Result[Out
 	return Result[Output, Input]{output, err, input}
 }
 
-// Map applies a function to the result of a parser.
+// Map applies a function to the result of a parser. Fatal errors produced by
+// the parser are reported as is.
 func Map[Input Bytes, ParserOutput any, MapperOutput any](parse Parser[Input, ParserOutput], fn func(ParserOutput) (MapperOutput, error)) Parser[Input, MapperOutput] {
 	return func(input Input) Result[MapperOutput, Input] {
 		res := parse(input)
 		if res.Err != nil {
-			return Failure[Input, MapperOutput](NewError(input, "Map"), input)
+			return Failure[Input, MapperOutput](sequenceError(res.Err, input, "Map"), input)
 		}
 
 		output, err := fn(res.Output)
@@ -59,6 +77,36 @@ func Map[Input Bytes, ParserOutput any, MapperOutput any](parse Parser[Input, Pa
 	}
 }
 
+// MapIn behaves like Map, but allocates the function's output from the provided
+// arena, rather than from the heap, and returns a pointer to it. It is meant for
+// the nodes of the trees parsers build, such as syntax trees, which the arena
+// carves out of large chunks, and releases all at once when it is reset.
+//
+// As parsers allocating from the same arena must not be invoked concurrently,
+// MapIn parsers must not be either.
+func MapIn[Input Bytes, ParserOutput any, MapperOutput any](
+	arena *Arena[MapperOutput],
+	parse Parser[Input, ParserOutput],
+	fn func(ParserOutput) (MapperOutput, error),
+) Parser[Input, *MapperOutput] {
+	return func(input Input) Result[*MapperOutput, Input] {
+		res := parse(input)
+		if res.Err != nil {
+			return Failure[Input, *MapperOutput](sequenceError(res.Err, input, "MapIn"), input)
+		}
+
+		output, err := fn(res.Output)
+		if err != nil {
+			return Failure[Input, *MapperOutput](NewError(input, err.Error()), input)
+		}
+
+		node := arena.New()
+		*node = output
+
+		return Success(node, res.Remaining)
+	}
+}
+
 // Optional applies a an optional child parser. Will return nil
 // if not successful.
 //
@@ -67,8 +115,8 @@ func Map[Input Bytes, ParserOutput any, MapperOutput any](parse Parser[Input, Pa
 func Optional[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, Output] {
 	return func(input Input) Result[Output, Input] {
 		result := parse(input)
-		if result.Err != nil && !result.Err.IsFatal() {
-			result.Err = nil
+		if result.Err != nil && result.Err.IsFatal() {
+			return Failure[Input, Output](result.Err, input)
 		}
 
 		return Success(result.Output, result.Remaining)
@@ -101,6 +149,67 @@ func Recognize[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Inpu
 	}
 }
 
+// RecognizeString behaves like Recognize, but produces the consumed input as a
+// string, regardless of the input's type.
+//
+// When the input is a []byte, the consumed bytes are copied into the produced
+// string. Programs built with the gomme_unsafe build tag, using Go 1.20 or later,
+// skip that copy, and produce strings sharing the input's memory instead: they
+// must guarantee the input buffer is neither modified nor reused for as long as
+// the produced strings are in use.
+func RecognizeString[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, string] {
+	return func(input Input) Result[string, Input] {
+		result := parse(input)
+		if result.Err != nil {
+			return Failure[Input, string](result.Err, input)
+		}
+
+		return Success(viewString(input[:len(input)-len(result.Remaining)]), result.Remaining)
+	}
+}
+
+// ToString converts the provided input into a string. It is meant to be used
+// by the functions passed to Map, and, like RecognizeString, avoids copying
+// []byte inputs in programs built with the gomme_unsafe build tag, in which
+// case the same guarantees apply.
+func ToString[Input Bytes](input Input) string {
+	return viewString(input)
+}
+
+// WithChecksum applies the body parser, followed by the checksum parser, and
+// then calls verify with the part of the input the body consumed, and the parsed
+// checksum. It allows validating the integrity of a region of binary data, such
+// as a frame followed by its CRC, and returns the body parser's output as the
+// produced value.
+//
+// If verify returns an error, the parser returns a fatal error result, holding
+// a ChecksumError wrapping it: as the data is known to be corrupt, it stops
+// enclosing combinators, such as Alternative, from trying other parsers.
+func WithChecksum[Input Bytes, Output, Sum any](
+	body Parser[Input, Output],
+	checksum Parser[Input, Sum],
+	verify func(consumed []byte, sum Sum) error,
+) Parser[Input, Output] {
+	return func(input Input) Result[Output, Input] {
+		bodyResult := body(input)
+		if bodyResult.Err != nil {
+			return Failure[Input, Output](bodyResult.Err, input)
+		}
+
+		checksumResult := checksum(bodyResult.Remaining)
+		if checksumResult.Err != nil {
+			return Failure[Input, Output](checksumResult.Report(), input)
+		}
+
+		consumed := input[:len(input)-len(bodyResult.Remaining)]
+		if err := verify([]byte(consumed), checksumResult.Output); err != nil {
+			return Failure[Input, Output](newChecksumError(input, err), input)
+		}
+
+		return Success(bodyResult.Output, checksumResult.Remaining)
+	}
+}
+
 // Assign returns the provided value if the parser succeeds, otherwise
 // it returns an error result.
 func Assign[Input Bytes, Output1, Output2 any](value Output1, parse Parser[Input, Output2]) Parser[Input, Output1] {
@@ -113,3 +222,16 @@ func Assign[Input Bytes, Output1, Output2 any](value Output1, parse Parser[Input
 		return Success(value, result.Remaining)
 	}
 }
+
+// EOF succeeds only if the input is empty, and returns it as the produced value.
+// It allows grammars to assert that the whole input was consumed from within the
+// combinator tree, rather than checking the Result's Remaining after the fact.
+func EOF[Input Bytes]() Parser[Input, Input] {
+	return func(input Input) Result[Input, Input] {
+		if len(input) != 0 {
+			return Failure[Input, Input](NewError(input, "EOF"), input)
+		}
+
+		return Success(input, input)
+	}
+}
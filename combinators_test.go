@@ -2,6 +2,7 @@ package gomme
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 	"testing"
 )
@@ -135,6 +136,73 @@ func BenchmarkMap(b *testing.B) {
 	}
 }
 
+func TestMapFatalError(t *testing.T) {
+	t.Parallel()
+
+	double := func(n int8) (int, error) { return int(n) * 2, nil }
+	length := func(digits string) (int, error) { return len(digits), nil }
+
+	result := Alternative(Map(Int8[string](), double), Map(Digit1[string](), length))("300")
+	if result.Err == nil || !result.Err.IsFatal() {
+		t.Fatalf("got error %v, want fatal error", result.Err)
+	}
+
+	if !errors.Is(result.Err, strconv.ErrRange) {
+		t.Errorf("got error %v, want error wrapping %v", result.Err, strconv.ErrRange)
+	}
+
+	if result.Remaining != "300" {
+		t.Errorf("got remaining %q, want remaining %q", result.Remaining, "300")
+	}
+
+	inArena := MapIn(NewArena[int](0), Int8[string](), double)("300")
+	if inArena.Err == nil || !inArena.Err.IsFatal() {
+		t.Errorf("got error %v, want fatal error", inArena.Err)
+	}
+}
+
+func TestMapIn(t *testing.T) {
+	t.Parallel()
+
+	arena := NewArena[int](4)
+	parser := MapIn(arena, Digit1[string](), strconv.Atoi)
+
+	first := parser("12abc")
+	if first.Err != nil || *first.Output != 12 || first.Remaining != "abc" {
+		t.Fatalf("got result %v, %v, %q, want 12, no error, %q", first.Output, first.Err, first.Remaining, "abc")
+	}
+
+	second := parser("34")
+	if second.Err != nil || *second.Output != 34 || *first.Output != 12 {
+		t.Fatalf("got outputs %v and %v, want 12 and 34", *first.Output, *second.Output)
+	}
+
+	if failed := parser("abc"); failed.Err == nil || failed.Output != nil || failed.Remaining != "abc" {
+		t.Errorf("got result %v, %v, %q, want an error", failed.Output, failed.Err, failed.Remaining)
+	}
+
+	// Outputs are released along with the arena they were allocated from.
+	arena.Reset()
+	if *first.Output != 0 || *second.Output != 0 {
+		t.Errorf("got outputs %v and %v after reset, want zero values", *first.Output, *second.Output)
+	}
+}
+
+func BenchmarkMapIn(b *testing.B) {
+	arena := NewArena[int](1024)
+	parser := MapIn(arena, Digit1[string](), strconv.Atoi)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if i%1024 == 0 {
+			arena.Reset()
+		}
+
+		parser("123abc")
+	}
+}
+
 func TestOptional(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +279,20 @@ func BenchmarkOptional(b *testing.B) {
 	}
 }
 
+func TestOptionalFatalError(t *testing.T) {
+	t.Parallel()
+
+	result := Optional(Int8[string]())("300")
+
+	if result.Err == nil || !result.Err.IsFatal() {
+		t.Errorf("got error %v, want fatal error", result.Err)
+	}
+
+	if result.Remaining != "300" {
+		t.Errorf("got remaining %v, want remaining %v", result.Remaining, "300")
+	}
+}
+
 func TestPeek(t *testing.T) {
 	t.Parallel()
 
@@ -363,6 +445,220 @@ func BenchmarkRecognize(b *testing.B) {
 	}
 }
 
+func TestRecognizeString(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[[]byte, string]
+		input         []byte
+		wantErr       bool
+		wantOutput    string
+		wantRemaining []byte
+	}{
+		{
+			name:          "matching parser should succeed",
+			parser:        RecognizeString(Pair(Digit1[[]byte](), Alpha1[[]byte]())),
+			input:         []byte("123abc;"),
+			wantErr:       false,
+			wantOutput:    "123abc",
+			wantRemaining: []byte(";"),
+		},
+		{
+			name:          "no prefix match should fail",
+			parser:        RecognizeString(Pair(Digit1[[]byte](), Alpha1[[]byte]())),
+			input:         []byte("abc"),
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte("abc"),
+		},
+		{
+			name:          "parser consuming nothing should succeed",
+			parser:        RecognizeString(Digit0[[]byte]()),
+			input:         []byte("abc"),
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: []byte("abc"),
+		},
+		{
+			name:          "empty input should fail",
+			parser:        RecognizeString(Pair(Digit1[[]byte](), Alpha1[[]byte]())),
+			input:         []byte{},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: []byte{},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if string(gotResult.Remaining) != string(tc.wantRemaining) {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkRecognizeString(b *testing.B) {
+	parser := RecognizeString(Pair(Digit1[[]byte](), Alpha1[[]byte]()))
+	input := []byte("123abc")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(input)
+	}
+}
+
+func TestToString(t *testing.T) {
+	t.Parallel()
+
+	parser := Map(Digit1[[]byte](), func(digits []byte) (string, error) {
+		return ToString(digits), nil
+	})
+
+	result := parser([]byte("123abc"))
+	if result.Err != nil {
+		t.Fatalf("got error %v, want no error", result.Err)
+	}
+
+	if result.Output != "123" {
+		t.Errorf("got output %v, want output %v", result.Output, "123")
+	}
+
+	if got := ToString("abc"); got != "abc" {
+		t.Errorf("got %v, want %v", got, "abc")
+	}
+}
+
+func TestWithChecksum(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a body matching its checksum should succeed",
+			parser:        WithChecksum(Digit1[string](), Preceded(Char[string]('#'), UInt8[string]()), verifyDigitSum),
+			input:         "123#6;",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a body not matching its checksum should fail",
+			parser:        WithChecksum(Digit1[string](), Preceded(Char[string]('#'), UInt8[string]()), verifyDigitSum),
+			input:         "123#7;",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "123#7;",
+		},
+		{
+			name:          "parsing a body not followed by a checksum should fail",
+			parser:        WithChecksum(Digit1[string](), Preceded(Char[string]('#'), UInt8[string]()), verifyDigitSum),
+			input:         "123;",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "123;",
+		},
+		{
+			name:          "parsing an invalid body should fail",
+			parser:        WithChecksum(Digit1[string](), Preceded(Char[string]('#'), UInt8[string]()), verifyDigitSum),
+			input:         "abc#6;",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc#6;",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        WithChecksum(Digit1[string](), Preceded(Char[string]('#'), UInt8[string]()), verifyDigitSum),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkWithChecksum(b *testing.B) {
+	parser := WithChecksum(Digit1[string](), Preceded(Char[string]('#'), UInt8[string]()), verifyDigitSum)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("123#6;")
+	}
+}
+
+func TestWithChecksumError(t *testing.T) {
+	t.Parallel()
+
+	result := WithChecksum(Digit1[string](), Preceded(Char[string]('#'), UInt8[string]()), verifyDigitSum)("123#7;")
+
+	if result.Err == nil || !result.Err.IsFatal() {
+		t.Fatalf("got error %v, want fatal error", result.Err)
+	}
+
+	var checksumErr *ChecksumError
+	if !errors.As(result.Err, &checksumErr) {
+		t.Fatalf("got error %v, want ChecksumError", result.Err)
+	}
+
+	if checksumErr.Err.Error() != "got digit sum 6, want 7" {
+		t.Errorf("got error %v, want error %v", checksumErr.Err, "got digit sum 6, want 7")
+	}
+}
+
+// verifyDigitSum checks that the sum of the consumed digits equals sum.
+func verifyDigitSum(consumed []byte, sum uint8) error {
+	var got uint8
+	for _, c := range consumed {
+		got += c - '0'
+	}
+
+	if got != sum {
+		return fmt.Errorf("got digit sum %d, want %d", got, sum)
+	}
+
+	return nil
+}
+
 func TestAssign(t *testing.T) {
 	t.Parallel()
 
@@ -428,3 +724,109 @@ func BenchmarkAssign(b *testing.B) {
 		p("abcd")
 	}
 }
+
+func TestEOF(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "empty input should succeed",
+			parser:        EOF[string](),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "non-empty input should fail",
+			parser:        EOF[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "fully consumed input should succeed",
+			parser:        Terminated(Digit1[string](), EOF[string]()),
+			input:         "123",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "",
+		},
+		{
+			name:          "partially consumed input should fail",
+			parser:        Terminated(Digit1[string](), EOF[string]()),
+			input:         "123abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "123abc",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkEOF(b *testing.B) {
+	p := EOF[string]()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p("")
+	}
+}
+
+func TestResultReport(t *testing.T) {
+	t.Parallel()
+
+	if err := Char[string]('a')("a").Report(); err != nil {
+		t.Errorf("got error %v, want no error", err)
+	}
+
+	parser := Preceded(Char[string]('a'), Char[string]('b'))
+
+	first := parser("ac").Report()
+	if first == nil || first.Input != "c" {
+		t.Fatalf("got error %#v, want failure at %q", first, "c")
+	}
+
+	second := parser("ad").Report()
+	if second.Input != "d" {
+		t.Errorf("got error %#v, want failure at %q", second, "d")
+	}
+
+	// Reported errors don't share anything with the error shared by the
+	// primitive parser, nor with one another.
+	first.Expected[0] = "changed"
+	if got := parser("ad").Report().Expected[0]; got != "b" {
+		t.Errorf("got expected %q, want %q", got, "b")
+	}
+
+	if second.Expected[0] != "b" {
+		t.Errorf("got expected %q, want %q", second.Expected[0], "b")
+	}
+}
@@ -13,3 +13,19 @@ func NewPairContainer[Left, Right any](left Left, right Right) *PairContainer[Le
 		Right: right,
 	}
 }
+
+// TripleContainer allows returning a triple of results from a parser.
+type TripleContainer[Left, Middle, Right any] struct {
+	Left   Left
+	Middle Middle
+	Right  Right
+}
+
+// NewTripleContainer instantiates a new Triple
+func NewTripleContainer[Left, Middle, Right any](left Left, middle Middle, right Right) *TripleContainer[Left, Middle, Right] {
+	return &TripleContainer[Left, Middle, Right]{
+		Left:   left,
+		Middle: middle,
+		Right:  right,
+	}
+}
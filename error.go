@@ -2,15 +2,26 @@ package gomme
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
 // Error represents a parsing error. It holds the input that was being parsed,
 // the parsers that were tried, and the error that was produced.
+//
+// As failures are routinely expected, when trying alternatives for instance,
+// primitive parsers don't allocate an Error each time they fail: each of them
+// shares a single one across its failures, which doesn't record the input it
+// failed at. That input is the Remaining of the failed Result, and Result.Report
+// produces the complete Error, where it's reported.
 type Error[Input Bytes] struct {
 	Input    Input
 	Err      error
 	Expected []string
+
+	// shared is true for the Errors primitive parsers share across their
+	// failures, whose Input is left empty.
+	shared bool
 }
 
 // NewError produces a new Error from the provided input and names of
@@ -19,12 +30,107 @@ func NewError[Input Bytes](input Input, expected ...string) *Error[Input] {
 	return &Error[Input]{Input: input, Expected: expected}
 }
 
+// newSharedError produces the Error a primitive parser shares across its
+// failures, naming the parsers it expected to succeed. It's meant to be built
+// once, along with the parser, so that failing doesn't allocate.
+func newSharedError[Input Bytes](expected ...string) *Error[Input] {
+	return &Error[Input]{Expected: expected, shared: true}
+}
+
+// at returns the Error, as reported at the provided input, which is the one the
+// parser producing it failed at. Shared Errors are replaced by a new Error
+// recording that input, while other ones are returned as is.
+func (e *Error[Input]) at(input Input) *Error[Input] {
+	if !e.shared {
+		return e
+	}
+
+	return &Error[Input]{Input: input, Expected: append([]string(nil), e.Expected...)}
+}
+
+// newRangeError produces a new fatal Error, reporting that the provided literal,
+// matched by the named numeric parser, doesn't fit into the parser's output type.
+func newRangeError[Input Bytes](input Input, literal string, name string) *Error[Input] {
+	return &Error[Input]{Input: input, Err: &RangeError{Literal: literal}, Expected: []string{name}}
+}
+
+// newChecksumError produces a new fatal Error, reporting that the region of the
+// input validated by WithChecksum doesn't match its checksum.
+func newChecksumError[Input Bytes](input Input, err error) *Error[Input] {
+	return &Error[Input]{Input: input, Err: &ChecksumError{Err: err}, Expected: []string{"WithChecksum"}}
+}
+
+// newDuplicateKeyError produces a new fatal Error, reporting that the provided
+// key, matched by KeyValues, was already found in the map being parsed.
+func newDuplicateKeyError[Input Bytes](input Input, key any) *Error[Input] {
+	return &Error[Input]{Input: input, Err: &DuplicateKeyError{Key: key}, Expected: []string{"KeyValues"}}
+}
+
 // Error returns a human readable error string.
 func (e *Error[Input]) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("expected %v: %v", strings.Join(e.Expected, ", "), e.Err)
+	}
+
 	return fmt.Sprintf("expected %v", strings.Join(e.Expected, ", "))
 }
 
+// Unwrap returns the error held by a fatal Error, if any.
+func (e *Error[Input]) Unwrap() error {
+	return e.Err
+}
+
 // IsFatal returns true if the error is fatal.
 func (e *Error[Input]) IsFatal() bool {
 	return e.Err != nil
 }
+
+// RangeError is the error held by the fatal Errors produced by numeric parsers
+// which matched a number that doesn't fit into their output type. As it wraps
+// strconv.ErrRange, it can be identified using errors.Is, or errors.As to access
+// the offending literal.
+type RangeError struct {
+	// Literal holds the number, as it was found in the input.
+	Literal string
+}
+
+// Error returns a human readable error string.
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("number %s out of range", e.Literal)
+}
+
+// Unwrap returns strconv.ErrRange.
+func (e *RangeError) Unwrap() error {
+	return strconv.ErrRange
+}
+
+// ChecksumError is the error held by the fatal Errors produced by WithChecksum
+// when the consumed input doesn't match its checksum. It wraps the error returned
+// by the verification function, which can be accessed using errors.As.
+type ChecksumError struct {
+	// Err holds the error returned by the verification function.
+	Err error
+}
+
+// Error returns a human readable error string.
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("checksum mismatch: %v", e.Err)
+}
+
+// Unwrap returns the error returned by the verification function.
+func (e *ChecksumError) Unwrap() error {
+	return e.Err
+}
+
+// DuplicateKeyError is the error held by the fatal Errors produced by KeyValues
+// when a key is found twice, and its duplicate key policy is
+// DuplicateKeyReject. It can be accessed using errors.As.
+type DuplicateKeyError struct {
+	// Key holds the duplicate key, as produced by the key parser.
+	Key any
+}
+
+// Error returns a human readable error string.
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("duplicate key %v", e.Key)
+}
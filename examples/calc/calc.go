@@ -0,0 +1,76 @@
+// Package calc implements a calculator for arithmetic expressions over integers.
+// It demonstrates how to generate a parser out of a grammar using gommegen, and
+// how to attach actions to its rules to evaluate what it parses.
+package calc
+
+//go:generate go run github.com/oleiade/gomme/cmd/gommegen -type parser calc.ebnf
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// errDivisionByZero makes the rule holding a division by zero fail.
+var errDivisionByZero = errors.New("division by zero")
+
+// calculator evaluates the expressions it parses.
+var calculator = newParser(parserActions{
+	Expr:    fold,
+	Product: fold,
+	Factor: func(_ string, value any) (any, error) {
+		// Parenthesized expressions hold the opening parenthesis, and the space
+		// following it, before the expression.
+		if elements, ok := value.([]any); ok {
+			return elements[2], nil
+		}
+
+		return value, nil
+	},
+	Integer: func(text string, _ any) (any, error) {
+		return strconv.Atoi(text)
+	},
+})
+
+// Evaluate evaluates the provided arithmetic expression.
+func Evaluate(expression string) (int, error) {
+	result := calculator.Expr(expression)
+	if result.Err != nil {
+		return 0, result.Err
+	}
+
+	if result.Remaining != "" {
+		return 0, fmt.Errorf("unexpected input: %q", result.Remaining)
+	}
+
+	return result.Output.(int), nil
+}
+
+// fold evaluates sequences of an operand followed by operator and operand pairs,
+// as produced by the Expr and Product rules, from the left.
+func fold(_ string, value any) (any, error) {
+	elements := value.([]any)
+
+	acc := elements[0].(int)
+	for _, element := range elements[1].([]any) {
+		operation := element.([]any)
+		operator, operand := operation[1].(string), operation[3].(int)
+
+		switch operator {
+		case "+":
+			acc += operand
+		case "-":
+			acc -= operand
+		case "*":
+			acc *= operand
+		case "/":
+			if operand == 0 {
+				return nil, errDivisionByZero
+			}
+
+			acc /= operand
+		}
+	}
+
+	return acc, nil
+}
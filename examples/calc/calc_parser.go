@@ -0,0 +1,552 @@
+// Code generated by gommegen from calc.ebnf. DO NOT EDIT.
+
+package calc
+
+import (
+	"github.com/oleiade/gomme"
+)
+
+// parserActions holds the actions producing the values of the grammar's rules. An action
+// is passed the part of the input its rule matched, and the value the rule's
+// expression produced. Rules whose action is nil produce the latter.
+type parserActions struct {
+	Expr    func(text string, value any) (any, error)
+	Product func(text string, value any) (any, error)
+	Factor  func(text string, value any) (any, error)
+	Integer func(text string, value any) (any, error)
+	Space   func(text string, value any) (any, error)
+}
+
+// parser parses inputs following the grammar's rules.
+type parser struct {
+	actions parserActions
+}
+
+// newParser produces a parser applying the provided actions.
+func newParser(actions parserActions) *parser {
+	return &parser{actions: actions}
+}
+
+// Expr parses the Expr rule.
+func (p *parser) Expr(input string) gomme.Result[any, string] {
+	value, remaining, ok := p.ruleExpr(input)
+	if !ok {
+		return gomme.Failure[string, any](gomme.NewError(input, "Expr"), input)
+	}
+
+	return gomme.Success(value, remaining)
+}
+
+func (p *parser) ruleExpr(input string) (any, string, bool) {
+	value, remaining, ok := p.match9(input)
+	if !ok || p.actions.Expr == nil {
+		return value, remaining, ok
+	}
+
+	value, err := p.actions.Expr(input[:len(input)-len(remaining)], value)
+	if err != nil {
+		return nil, input, false
+	}
+
+	return value, remaining, true
+}
+
+// Product parses the Product rule.
+func (p *parser) Product(input string) gomme.Result[any, string] {
+	value, remaining, ok := p.ruleProduct(input)
+	if !ok {
+		return gomme.Failure[string, any](gomme.NewError(input, "Product"), input)
+	}
+
+	return gomme.Success(value, remaining)
+}
+
+func (p *parser) ruleProduct(input string) (any, string, bool) {
+	value, remaining, ok := p.match19(input)
+	if !ok || p.actions.Product == nil {
+		return value, remaining, ok
+	}
+
+	value, err := p.actions.Product(input[:len(input)-len(remaining)], value)
+	if err != nil {
+		return nil, input, false
+	}
+
+	return value, remaining, true
+}
+
+// Factor parses the Factor rule.
+func (p *parser) Factor(input string) gomme.Result[any, string] {
+	value, remaining, ok := p.ruleFactor(input)
+	if !ok {
+		return gomme.Failure[string, any](gomme.NewError(input, "Factor"), input)
+	}
+
+	return gomme.Success(value, remaining)
+}
+
+func (p *parser) ruleFactor(input string) (any, string, bool) {
+	value, remaining, ok := p.match27(input)
+	if !ok || p.actions.Factor == nil {
+		return value, remaining, ok
+	}
+
+	value, err := p.actions.Factor(input[:len(input)-len(remaining)], value)
+	if err != nil {
+		return nil, input, false
+	}
+
+	return value, remaining, true
+}
+
+// Integer parses the Integer rule.
+func (p *parser) Integer(input string) gomme.Result[any, string] {
+	value, remaining, ok := p.ruleInteger(input)
+	if !ok {
+		return gomme.Failure[string, any](gomme.NewError(input, "Integer"), input)
+	}
+
+	return gomme.Success(value, remaining)
+}
+
+func (p *parser) ruleInteger(input string) (any, string, bool) {
+	value, remaining, ok := p.match33(input)
+	if !ok || p.actions.Integer == nil {
+		return value, remaining, ok
+	}
+
+	value, err := p.actions.Integer(input[:len(input)-len(remaining)], value)
+	if err != nil {
+		return nil, input, false
+	}
+
+	return value, remaining, true
+}
+
+// Space parses the Space rule.
+func (p *parser) Space(input string) gomme.Result[any, string] {
+	value, remaining, ok := p.ruleSpace(input)
+	if !ok {
+		return gomme.Failure[string, any](gomme.NewError(input, "Space"), input)
+	}
+
+	return gomme.Success(value, remaining)
+}
+
+func (p *parser) ruleSpace(input string) (any, string, bool) {
+	value, remaining, ok := p.match37(input)
+	if !ok || p.actions.Space == nil {
+		return value, remaining, ok
+	}
+
+	value, err := p.actions.Space(input[:len(input)-len(remaining)], value)
+	if err != nil {
+		return nil, input, false
+	}
+
+	return value, remaining, true
+}
+
+func (p *parser) match0(input string) (any, string, bool) {
+	return p.ruleProduct(input)
+}
+
+func (p *parser) match1(input string) (any, string, bool) {
+	return p.ruleSpace(input)
+}
+
+func (p *parser) match2(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != "+" {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match3(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != "-" {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match4(input string) (any, string, bool) {
+	if value, remaining, ok := p.match2(input); ok {
+		return value, remaining, true
+	}
+
+	if value, remaining, ok := p.match3(input); ok {
+		return value, remaining, true
+	}
+
+	return nil, input, false
+}
+
+func (p *parser) match5(input string) (any, string, bool) {
+	return p.ruleSpace(input)
+}
+
+func (p *parser) match6(input string) (any, string, bool) {
+	return p.ruleProduct(input)
+}
+
+func (p *parser) match7(input string) (any, string, bool) {
+	values := make([]any, 4)
+	remaining := input
+	var ok bool
+
+	if values[0], remaining, ok = p.match1(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[1], remaining, ok = p.match4(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[2], remaining, ok = p.match5(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[3], remaining, ok = p.match6(remaining); !ok {
+		return nil, input, false
+	}
+
+	return values, remaining, true
+}
+
+func (p *parser) match8(input string) (any, string, bool) {
+	values := []any{}
+	remaining := input
+
+	for {
+		value, rest, ok := p.match7(remaining)
+		if !ok {
+			return values, remaining, true
+		}
+
+		// Checking for infinite loops, if nothing was consumed,
+		// the expression would make us go around in circles.
+		if len(rest) == len(remaining) {
+			return nil, input, false
+		}
+
+		values = append(values, value)
+		remaining = rest
+	}
+}
+
+func (p *parser) match9(input string) (any, string, bool) {
+	values := make([]any, 2)
+	remaining := input
+	var ok bool
+
+	if values[0], remaining, ok = p.match0(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[1], remaining, ok = p.match8(remaining); !ok {
+		return nil, input, false
+	}
+
+	return values, remaining, true
+}
+
+func (p *parser) match10(input string) (any, string, bool) {
+	return p.ruleFactor(input)
+}
+
+func (p *parser) match11(input string) (any, string, bool) {
+	return p.ruleSpace(input)
+}
+
+func (p *parser) match12(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != "*" {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match13(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != "/" {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match14(input string) (any, string, bool) {
+	if value, remaining, ok := p.match12(input); ok {
+		return value, remaining, true
+	}
+
+	if value, remaining, ok := p.match13(input); ok {
+		return value, remaining, true
+	}
+
+	return nil, input, false
+}
+
+func (p *parser) match15(input string) (any, string, bool) {
+	return p.ruleSpace(input)
+}
+
+func (p *parser) match16(input string) (any, string, bool) {
+	return p.ruleFactor(input)
+}
+
+func (p *parser) match17(input string) (any, string, bool) {
+	values := make([]any, 4)
+	remaining := input
+	var ok bool
+
+	if values[0], remaining, ok = p.match11(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[1], remaining, ok = p.match14(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[2], remaining, ok = p.match15(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[3], remaining, ok = p.match16(remaining); !ok {
+		return nil, input, false
+	}
+
+	return values, remaining, true
+}
+
+func (p *parser) match18(input string) (any, string, bool) {
+	values := []any{}
+	remaining := input
+
+	for {
+		value, rest, ok := p.match17(remaining)
+		if !ok {
+			return values, remaining, true
+		}
+
+		// Checking for infinite loops, if nothing was consumed,
+		// the expression would make us go around in circles.
+		if len(rest) == len(remaining) {
+			return nil, input, false
+		}
+
+		values = append(values, value)
+		remaining = rest
+	}
+}
+
+func (p *parser) match19(input string) (any, string, bool) {
+	values := make([]any, 2)
+	remaining := input
+	var ok bool
+
+	if values[0], remaining, ok = p.match10(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[1], remaining, ok = p.match18(remaining); !ok {
+		return nil, input, false
+	}
+
+	return values, remaining, true
+}
+
+func (p *parser) match20(input string) (any, string, bool) {
+	return p.ruleInteger(input)
+}
+
+func (p *parser) match21(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != "(" {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match22(input string) (any, string, bool) {
+	return p.ruleSpace(input)
+}
+
+func (p *parser) match23(input string) (any, string, bool) {
+	return p.ruleExpr(input)
+}
+
+func (p *parser) match24(input string) (any, string, bool) {
+	return p.ruleSpace(input)
+}
+
+func (p *parser) match25(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != ")" {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match26(input string) (any, string, bool) {
+	values := make([]any, 5)
+	remaining := input
+	var ok bool
+
+	if values[0], remaining, ok = p.match21(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[1], remaining, ok = p.match22(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[2], remaining, ok = p.match23(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[3], remaining, ok = p.match24(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[4], remaining, ok = p.match25(remaining); !ok {
+		return nil, input, false
+	}
+
+	return values, remaining, true
+}
+
+func (p *parser) match27(input string) (any, string, bool) {
+	if value, remaining, ok := p.match20(input); ok {
+		return value, remaining, true
+	}
+
+	if value, remaining, ok := p.match26(input); ok {
+		return value, remaining, true
+	}
+
+	return nil, input, false
+}
+
+func (p *parser) match28(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != "-" {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match29(input string) (any, string, bool) {
+	if value, remaining, ok := p.match28(input); ok {
+		return value, remaining, true
+	}
+
+	return nil, input, true
+}
+
+func (p *parser) match30(input string) (any, string, bool) {
+	if len(input) == 0 || input[0] < '0' || input[0] > '9' {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match31(input string) (any, string, bool) {
+	if len(input) == 0 || input[0] < '0' || input[0] > '9' {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match32(input string) (any, string, bool) {
+	values := []any{}
+	remaining := input
+
+	for {
+		value, rest, ok := p.match31(remaining)
+		if !ok {
+			return values, remaining, true
+		}
+
+		// Checking for infinite loops, if nothing was consumed,
+		// the expression would make us go around in circles.
+		if len(rest) == len(remaining) {
+			return nil, input, false
+		}
+
+		values = append(values, value)
+		remaining = rest
+	}
+}
+
+func (p *parser) match33(input string) (any, string, bool) {
+	values := make([]any, 3)
+	remaining := input
+	var ok bool
+
+	if values[0], remaining, ok = p.match29(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[1], remaining, ok = p.match30(remaining); !ok {
+		return nil, input, false
+	}
+
+	if values[2], remaining, ok = p.match32(remaining); !ok {
+		return nil, input, false
+	}
+
+	return values, remaining, true
+}
+
+func (p *parser) match34(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != " " {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match35(input string) (any, string, bool) {
+	if len(input) < 1 || input[:1] != "\t" {
+		return nil, input, false
+	}
+
+	return input[:1], input[1:], true
+}
+
+func (p *parser) match36(input string) (any, string, bool) {
+	if value, remaining, ok := p.match34(input); ok {
+		return value, remaining, true
+	}
+
+	if value, remaining, ok := p.match35(input); ok {
+		return value, remaining, true
+	}
+
+	return nil, input, false
+}
+
+func (p *parser) match37(input string) (any, string, bool) {
+	values := []any{}
+	remaining := input
+
+	for {
+		value, rest, ok := p.match36(remaining)
+		if !ok {
+			return values, remaining, true
+		}
+
+		// Checking for infinite loops, if nothing was consumed,
+		// the expression would make us go around in circles.
+		if len(rest) == len(remaining) {
+			return nil, input, false
+		}
+
+		values = append(values, value)
+		remaining = rest
+	}
+}
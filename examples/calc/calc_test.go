@@ -0,0 +1,115 @@
+package calc
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/oleiade/gomme/grammar"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:embed calc.ebnf
+var calcGrammar string
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		expression string
+		wantErr    bool
+		wantResult int
+	}{
+		{
+			name:       "evaluating an integer should succeed",
+			expression: "42",
+			wantErr:    false,
+			wantResult: 42,
+		},
+		{
+			name:       "evaluating operators should apply their precedence",
+			expression: "1 + 2 * 3 - -4",
+			wantErr:    false,
+			wantResult: 11,
+		},
+		{
+			name:       "evaluating operators of the same precedence should apply them from the left",
+			expression: "20/2/5 - 3-2",
+			wantErr:    false,
+			wantResult: -3,
+		},
+		{
+			name:       "evaluating parenthesized expressions should group them",
+			expression: "( 1 + 2 ) * (3 + 4)",
+			wantErr:    false,
+			wantResult: 21,
+		},
+		{
+			name:       "evaluating a division by zero should fail",
+			expression: "1 / (2 - 2)",
+			wantErr:    true,
+			wantResult: 0,
+		},
+		{
+			name:       "evaluating an incomplete expression should fail",
+			expression: "1 + 2 *",
+			wantErr:    true,
+			wantResult: 0,
+		},
+		{
+			name:       "evaluating an empty expression should fail",
+			expression: "",
+			wantErr:    true,
+			wantResult: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult, gotErr := Evaluate(tc.expression)
+			if (gotErr != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotErr, tc.wantErr)
+			}
+
+			if gotResult != tc.wantResult {
+				t.Errorf("got result %v, want result %v", gotResult, tc.wantResult)
+			}
+		})
+	}
+}
+
+func TestGeneratedParserMatchesGrammar(t *testing.T) {
+	t.Parallel()
+
+	g, err := grammar.Parse[string](calcGrammar)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	interpreted, err := g.Parser("Expr")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated := newParser(parserActions{})
+
+	for _, input := range []string{"1", "1 + 2 * 3", "(1 +(2))*-3 /4", "1 +", "(1", "", "x"} {
+		want := interpreted(input)
+		got := generated.Expr(input)
+
+		assert.Equal(t, want.Output, got.Output, input)
+		assert.Equal(t, want.Remaining, got.Remaining, input)
+		assert.Equal(t, want.Err != nil, got.Err != nil, input)
+	}
+}
+
+func BenchmarkEvaluate(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Evaluate("1 + 2 * (3 - 4) / 5")
+	}
+}
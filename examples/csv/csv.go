@@ -14,7 +14,7 @@ func ParseCSV(input string) ([][]string, error) {
 		gomme.SeparatedList1(
 			gomme.Alternative(
 				gomme.Alphanumeric1[string](),
-				gomme.Delimited(gomme.Char[string]('"'), gomme.Alphanumeric1[string](), gomme.Char[string]('"')),
+				gomme.QuotedString[string](gomme.WithEscape('"')),
 			),
 			gomme.Char[string](','),
 		),
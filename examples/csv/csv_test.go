@@ -36,6 +36,12 @@ func TestParseRGBColor(t *testing.T) {
 			wantErr:    false,
 			wantOutput: [][]string{{"abc", "def", "ghi"}},
 		},
+		{
+			name:       "parsing a csv line of escaped strings holding separators and quotes should succeed",
+			input:      "\"a,b\",\"say \"\"hi\"\"\",ghi\r\n",
+			wantErr:    false,
+			wantOutput: [][]string{{"a,b", "say \"hi\"", "ghi"}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
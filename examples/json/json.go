@@ -1,28 +1,15 @@
-package main
+// Package json demonstrates the usage of the gomme package to parse [JSON]
+// documents.
+//
+// [JSON]: https://www.json.org
+package json
 
 import (
-	_ "embed"
-	"fmt"
-	"log"
 	"strconv"
-	"strings"
 
 	"github.com/oleiade/gomme"
 )
 
-//go:embed test.json
-var testJSON string
-
-func main() {
-	result := parseJSON(testJSON)
-	if result.Err != nil {
-		log.Fatal(result.Err)
-		return
-	}
-
-	fmt.Println(result.Output)
-}
-
 type (
 	// JSONValue represents any value that can be encountered in
 	// JSON, including complex types like objects and arrays.
@@ -47,8 +34,8 @@ type (
 	JSONNull struct{}
 )
 
-// parseJSON is a convenience function to start parsing JSON from the given input string.
-func parseJSON(input string) gomme.Result[JSONValue, string] {
+// ParseJSON parses a JSON value from the given input string.
+func ParseJSON(input string) gomme.Result[JSONValue, string] {
 	return parseValue(input)
 }
 
@@ -73,13 +60,7 @@ func parseObject(input string) gomme.Result[JSONValue, string] {
 		gomme.Delimited[string, rune, map[string]JSONValue, rune](
 			gomme.Char[string]('{'),
 			gomme.Optional[string, map[string]JSONValue](
-				gomme.Preceded(
-					ws(),
-					gomme.Terminated[string, map[string]JSONValue](
-						parseMembers,
-						ws(),
-					),
-				),
+				gomme.PaddedWith(ws(), parseMembers),
 			),
 			gomme.Char[string]('}'),
 		),
@@ -115,7 +96,7 @@ var _ gomme.Parser[string, JSONValue] = parseArray
 
 func parseElement(input string) gomme.Result[JSONValue, string] {
 	return gomme.Map(
-		gomme.Delimited[string](ws(), parseValue, ws()),
+		gomme.PaddedWith(ws(), parseValue),
 		func(v JSONValue) (JSONValue, error) { return v, nil },
 	)(input)
 }
@@ -125,44 +106,36 @@ var _ gomme.Parser[string, JSONValue] = parseElement
 
 // parseNumber parses a JSON number.
 func parseNumber(input string) gomme.Result[JSONValue, string] {
-	return gomme.Map[string](
-		gomme.Sequence(
-			gomme.Map(integer(), func(i int) (string, error) { return strconv.Itoa(i), nil }),
-			gomme.Optional(fraction()),
-			gomme.Optional(exponent()),
+	number := numberSyntax()(input)
+	if number.Err != nil {
+		return gomme.Failure[string, JSONValue](number.Err, input)
+	}
+
+	result := gomme.Float64With[string](gomme.FloatExponent)(number.Output)
+	if result.Err != nil {
+		return gomme.Failure[string, JSONValue](result.Err, input)
+	}
+
+	return gomme.Success[JSONValue](JSONNumber(result.Output), number.Remaining)
+}
+
+// numberSyntax recognizes a JSON number. Its syntax is stricter than the one
+// Float64With accepts: the integer part is mandatory and can't hold leading
+// zeros, and a dot must be followed by digits.
+func numberSyntax() gomme.Parser[string, string] {
+	return gomme.Recognize(gomme.Sequence(
+		gomme.Optional(gomme.Token[string]("-")),
+		gomme.Alternative(
+			gomme.Token[string]("0"),
+			gomme.Recognize(gomme.Pair(gomme.CharRange[string]('1', '9'), gomme.Digit0[string]())),
 		),
-		func(parts []string) (JSONValue, error) {
-			// Construct the float string from parts
-			var floatStr string
-
-			// Integer part
-			floatStr += parts[0]
-
-			// Fraction part
-			if parts[1] != "" {
-				fractionPart, err := strconv.Atoi(parts[1])
-				if err != nil {
-					return 0, err
-				}
-
-				if fractionPart != 0 {
-					floatStr += fmt.Sprintf(".%d", fractionPart)
-				}
-			}
-
-			// Exponent part
-			if parts[2] != "" {
-				floatStr += fmt.Sprintf("e%s", parts[2])
-			}
-
-			f, err := strconv.ParseFloat(floatStr, 64)
-			if err != nil {
-				return JSONNumber(0.0), err
-			}
-
-			return JSONNumber(f), nil
-		},
-	)(input)
+		gomme.Optional(gomme.Recognize(gomme.Pair(gomme.Char[string]('.'), gomme.Digit1[string]()))),
+		gomme.Optional(gomme.Recognize(gomme.Triplet(
+			gomme.OneOf[string]('e', 'E'),
+			gomme.Optional(gomme.OneOf[string]('+', '-')),
+			gomme.Digit1[string](),
+		))),
+	))
 }
 
 // Ensure parseNumber is a Parser[string, JSONValue]
@@ -230,71 +203,29 @@ func parseElements(input string) gomme.Result[[]JSONValue, string] {
 // Ensure parseElements is a Parser[string, []JSONValue]
 var _ gomme.Parser[string, []JSONValue] = parseElements
 
-// parseElement parses a single element of a JSON array.
+// parseMembers parses the members of a JSON object.
 func parseMembers(input string) gomme.Result[map[string]JSONValue, string] {
-	return gomme.Map(
-		gomme.SeparatedList0[string](
-			parseMember,
-			gomme.Token[string](","),
-		),
-		func(kvs []kv) (map[string]JSONValue, error) {
-			obj := make(JSONObject)
-			for _, kv := range kvs {
-				obj[kv.key] = kv.value
-			}
-			return obj, nil
-		},
+	return gomme.KeyValues[string](
+		gomme.PaddedWith(ws(), stringParser()),
+		gomme.Token[string](":"),
+		element(),
+		gomme.Token[string](","),
 	)(input)
 }
 
 // Ensure parseMembers is a Parser[string, map[string]JSONValue]
 var _ gomme.Parser[string, map[string]JSONValue] = parseMembers
 
-// parseMember parses a single member (key-value pair) of a JSON object.
-func parseMember(input string) gomme.Result[kv, string] {
-	return member()(input)
-}
-
-// Ensure parseMember is a Parser[string, kv]
-var _ gomme.Parser[string, kv] = parseMember
-
-// member creates a parser for a single key-value pair in a JSON object.
-//
-// It expects a string followed by a colon and then a JSON value.
-// The result is a kv struct with the parsed key and value.
-func member() gomme.Parser[string, kv] {
-	mapFunc := func(p gomme.PairContainer[string, JSONValue]) (kv, error) {
-		return kv{p.Left, p.Right}, nil
-	}
-
-	return gomme.Map(
-		gomme.SeparatedPair[string](
-			gomme.Delimited(ws(), stringParser(), ws()),
-			gomme.Token[string](":"),
-			element(),
-		),
-		mapFunc,
-	)
-}
-
 // element creates a parser for a single element in a JSON array.
 //
 // It wraps the element with optional whitespace on either side.
 func element() gomme.Parser[string, JSONValue] {
 	return gomme.Map(
-		gomme.Delimited(ws(), parseValue, ws()),
+		gomme.PaddedWith(ws(), parseValue),
 		func(v JSONValue) (JSONValue, error) { return v, nil },
 	)
 }
 
-// kv is a struct representing a key-value pair in a JSON object.
-//
-// 'key' holds the string key, and 'value' holds the corresponding JSON value.
-type kv struct {
-	key   string
-	value JSONValue
-}
-
 // stringParser creates a parser for a JSON string.
 //
 // It expects a sequence of characters enclosed in double quotes.
@@ -306,115 +237,6 @@ func stringParser() gomme.Parser[string, string] {
 	)
 }
 
-// integer creates a parser for a JSON number's integer part.
-//
-// It handles negative and positive integers including zero.
-func integer() gomme.Parser[string, int] {
-	return gomme.Alternative(
-		// "-" onenine digits
-		gomme.Preceded(
-			gomme.Token[string]("-"),
-			gomme.Map(
-				gomme.Pair(onenine(), digits()),
-				func(p gomme.PairContainer[string, string]) (int, error) {
-					return strconv.Atoi(p.Left + p.Right)
-				},
-			),
-		),
-
-		// onenine digits
-		gomme.Map(
-			gomme.Pair(onenine(), digits()),
-			func(p gomme.PairContainer[string, string]) (int, error) {
-				return strconv.Atoi(p.Left + p.Right)
-			},
-		),
-
-		// "-" digit
-		gomme.Preceded(
-			gomme.Token[string]("-"),
-			gomme.Map(
-				digit(),
-				strconv.Atoi,
-			),
-		),
-
-		// digit
-		gomme.Map(digit(), strconv.Atoi),
-	)
-}
-
-// digits creates a parser for a sequence of digits.
-//
-// It concatenates the sequence into a single string.
-func digits() gomme.Parser[string, string] {
-	return gomme.Map(gomme.Many1(digit()), func(digits []string) (string, error) {
-		return strings.Join(digits, ""), nil
-	})
-}
-
-// digit creates a parser for a single digit.
-//
-// It distinguishes between '0' and non-zero digits.
-func digit() gomme.Parser[string, string] {
-	return gomme.Alternative(
-		gomme.Token[string]("0"),
-		onenine(),
-	)
-}
-
-// onenine creates a parser for digits from 1 to 9.
-func onenine() gomme.Parser[string, string] {
-	return gomme.Alternative(
-		gomme.Token[string]("1"),
-		gomme.Token[string]("2"),
-		gomme.Token[string]("3"),
-		gomme.Token[string]("4"),
-		gomme.Token[string]("5"),
-		gomme.Token[string]("6"),
-		gomme.Token[string]("7"),
-		gomme.Token[string]("8"),
-		gomme.Token[string]("9"),
-	)
-}
-
-// fraction creates a parser for the fractional part of a JSON number.
-//
-// It expects a dot followed by at least one digit.
-func fraction() gomme.Parser[string, string] {
-	return gomme.Preceded(
-		gomme.Token[string]("."),
-		gomme.Digit1[string](),
-	)
-}
-
-// exponent creates a parser for the exponent part of a JSON number.
-//
-// It handles the exponent sign and the exponent digits.
-func exponent() gomme.Parser[string, string] {
-	return gomme.Preceded(
-		gomme.Token[string]("e"),
-		gomme.Map(
-			gomme.Pair(sign(), digits()),
-			func(p gomme.PairContainer[string, string]) (string, error) {
-				return p.Left + p.Right, nil
-			},
-		),
-	)
-}
-
-// sign creates a parser for the sign part of a number's exponent.
-//
-// It can parse both positive ('+') and negative ('-') signs.
-func sign() gomme.Parser[string, string] {
-	return gomme.Optional(
-		gomme.Alternative[string, string](
-			gomme.Token[string]("-"),
-			gomme.Token[string]("+"),
-		),
-	)
-}
-
 // characters creates a parser for a sequence of JSON string characters.
 //
 // It handles regular characters and escaped sequences.
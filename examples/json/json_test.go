@@ -0,0 +1,48 @@
+package json
+
+import (
+	_ "embed"
+	"testing"
+)
+
+//go:embed test.json
+var testJSON string
+
+func TestParseJSON(t *testing.T) {
+	t.Parallel()
+
+	result := ParseJSON(testJSON)
+	if result.Err != nil {
+		t.Fatalf("got error %v, want success", result.Err)
+	}
+
+	if result.Remaining != "\n" {
+		t.Errorf("got remaining %q, want remaining %q", result.Remaining, "\n")
+	}
+
+	object, ok := result.Output.(JSONObject)
+	if !ok {
+		t.Fatalf("got output %#v, want a JSONObject", result.Output)
+	}
+
+	if object["abc"] != JSONNumber(123) {
+		t.Errorf("got abc %#v, want abc %#v", object["abc"], JSONNumber(123))
+	}
+
+	entries, ok := object["entries"].(JSONArray)
+	if !ok || len(entries) != 2 {
+		t.Fatalf("got entries %#v, want an array of 2 entries", object["entries"])
+	}
+
+	if entry := entries[1].(JSONObject); entry["name"] != JSONString("Jane") || entry["age"] != JSONNumber(25) {
+		t.Errorf("got entry %#v, want Jane, aged 25", entry)
+	}
+}
+
+func BenchmarkParseJSON(b *testing.B) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParseJSON(testJSON)
+	}
+}
@@ -45,6 +45,48 @@ func ParseRESPMessage(input string) (RESPMessage, error) {
 	return result.Output, nil
 }
 
+// EncodeRESPMessage encodes a Redis' [RESP protocol] message, as parsed by
+// ParseRESPMessage, into its wire representation.
+//
+// [RESP protocol]: https://redis.io/docs/reference/protocol-spec/
+func EncodeRESPMessage(message RESPMessage) string {
+	var builder strings.Builder
+	encodeRESPMessage(&builder, message)
+
+	return builder.String()
+}
+
+// encodeRESPMessage writes the wire representation of the provided message to
+// the provided builder.
+func encodeRESPMessage(builder *strings.Builder, message RESPMessage) {
+	builder.WriteString(string(message.Kind))
+
+	switch message.Kind {
+	case SimpleStringKind:
+		builder.WriteString(message.SimpleString.Content)
+	case ErrorKind:
+		builder.WriteString(message.Error.Message)
+	case IntegerKind:
+		builder.WriteString(strconv.Itoa(message.Integer.Value))
+	case BulkStringKind:
+		builder.WriteString(strconv.Itoa(len(message.BulkString.Data)))
+		builder.WriteString("\r\n")
+		builder.Write(message.BulkString.Data)
+	case ArrayKind:
+		builder.WriteString(strconv.Itoa(len(message.Array.Elements)))
+		builder.WriteString("\r\n")
+
+		// Arrays end with their last element's CRLF.
+		for _, element := range message.Array.Elements {
+			encodeRESPMessage(builder, element)
+		}
+
+		return
+	}
+
+	builder.WriteString("\r\n")
+}
+
 // ErrMessageTooShort is returned when a message is too short to be valid.
 // A [RESP protocol] message is at least 3 characters long: the message kind
 // prefix, the message content (which can be empty), and the gomme.CRLF suffix.
@@ -126,7 +168,7 @@ func SimpleString() gomme.Parser[string, RESPMessage] {
 
 	return gomme.Delimited(
 		gomme.Token[string](string(SimpleStringKind)),
-		gomme.Map(gomme.TakeUntil(gomme.CRLF[string]()), mapFn),
+		gomme.Map(gomme.TakeUntilToken[string]("\r\n"), mapFn),
 		gomme.CRLF[string](),
 	)
 }
@@ -163,7 +205,7 @@ func Error() gomme.Parser[string, RESPMessage] {
 
 	return gomme.Delimited(
 		gomme.Token[string](string(ErrorKind)),
-		gomme.Map(gomme.TakeUntil(gomme.CRLF[string]()), mapFn),
+		gomme.Map(gomme.TakeUntilToken[string]("\r\n"), mapFn),
 		gomme.CRLF[string](),
 	)
 }
@@ -200,7 +242,7 @@ func Integer() gomme.Parser[string, RESPMessage] {
 
 	return gomme.Delimited(
 		gomme.Token[string](string(IntegerKind)),
-		gomme.Map(gomme.TakeUntil(gomme.CRLF[string]()), mapFn),
+		gomme.Map(gomme.TakeUntilToken[string]("\r\n"), mapFn),
 		gomme.CRLF[string](),
 	)
 }
@@ -221,43 +263,25 @@ type BulkStringMessage struct {
 // The bulk string's data is available in the BulkString field of the result's
 // RESPMessage.
 func BulkString() gomme.Parser[string, RESPMessage] {
-	mapFn := func(message gomme.PairContainer[int64, string]) (RESPMessage, error) {
-		if message.Left < 0 {
-			if message.Left < -1 {
-				return RESPMessage{}, fmt.Errorf(
-					"unable to parse bulk string; "+
-						"reason: negative length %d",
-					message.Left,
-				)
-			}
-
-			if message.Left == -1 && len(message.Right) != 0 {
-				return RESPMessage{}, fmt.Errorf(
-					"malformed array: declared message size -1, and actual size differ %d",
-					len(message.Right),
-				)
-			}
-		} else if len(message.Right) != int(message.Left) {
-			return RESPMessage{}, fmt.Errorf(
-				"malformed array: declared message size %d, and actual size differ %d",
-				message.Left,
-				len(message.Right),
-			)
-		}
-
+	mapFn := func(data string) (RESPMessage, error) {
 		return RESPMessage{
 			Kind: BulkStringKind,
 			BulkString: &BulkStringMessage{
-				Data: []byte(message.Right),
+				Data: []byte(data),
 			},
 		}, nil
 	}
 
 	return gomme.Map(
-		gomme.Pair(
-			sizePrefix(gomme.Token[string](string(BulkStringKind))),
-			gomme.Optional(
-				gomme.Terminated(gomme.TakeUntil(gomme.CRLF[string]()), gomme.CRLF[string]()),
+		gomme.Alternative(
+			// The null bulk string is represented by a -1 size, and has no data.
+			gomme.Assign("", gomme.Token[string](string(BulkStringKind)+"-1\r\n")),
+
+			// Bulk strings are binary-safe, their data is taken according to the
+			// size prefix, regardless of whether it contains CRLF sequences.
+			gomme.Terminated(
+				gomme.LengthData(sizePrefix(gomme.Token[string](string(BulkStringKind)))),
+				gomme.CRLF[string](),
 			),
 		),
 		mapFn,
@@ -9,6 +9,10 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/oleiade/gomme"
+	"github.com/oleiade/gomme/gommetest"
+	"github.com/oleiade/gomme/grammar"
 )
 
 func TestParseRESPMessage(t *testing.T) {
@@ -188,6 +192,19 @@ func TestParseRESPMessage(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "binary-safe bulk string containing CRLF should succeed",
+			args: args{
+				"$7\r\nhel\r\nlo\r\n",
+			},
+			want: RESPMessage{
+				Kind: BulkStringKind,
+				BulkString: &BulkStringMessage{
+					Data: []byte("hel\r\nlo"),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "nil bulk string should succeed",
 			args: args{
@@ -450,17 +467,28 @@ const (
 	TeraBytes = GigaBytes * 1024
 )
 
-// TODO: add fuzz tests input for other kind of messages,
-// and handled their expected format too.
+// simpleStringGrammar describes the RESP simple string messages the fuzz test's
+// seeds are generated from.
+const simpleStringGrammar = `
+	SimpleString = "+" { Char } "\r\n" .
+	Char         = " "..."~" .
+`
+
 func FuzzTestParseMessage(f *testing.F) {
-	testCases := []string{
-		"+OK\r\n",
-		"+Hello world\r\n",
-		"+This is a string\r\n",
+	// TODO: add fuzz tests input for other kind of messages,
+	// and handled their expected format too.
+	g, err := grammar.Parse[string](simpleStringGrammar)
+	if err != nil {
+		f.Fatal(err)
 	}
 
-	for _, testCase := range testCases {
-		f.Add(testCase)
+	seeds, err := g.Samples("SimpleString", grammar.SampleOptions{Seed: 1, MaxRepetitions: 16})
+	if err != nil {
+		f.Fatal(err)
+	}
+
+	for _, seed := range seeds {
+		f.Add(seed)
 	}
 
 	f.Fuzz(func(t *testing.T, message string) {
@@ -479,6 +507,59 @@ func FuzzTestParseMessage(f *testing.F) {
 	})
 }
 
+func TestEncodeRESPMessageRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	gommetest.RoundTripTest[string, RESPMessage]{
+		Parser: gomme.Alternative(SimpleString(), Error(), Integer(), BulkString(), Array()),
+		Print:  EncodeRESPMessage,
+		Generate: func(random *rand.Rand) RESPMessage {
+			if random.Intn(4) == 0 {
+				elements := make([]RESPMessage, random.Intn(4))
+				for idx := range elements {
+					elements[idx] = randomScalarMessage(random)
+				}
+
+				return RESPMessage{Kind: ArrayKind, Array: &ArrayMessage{Elements: elements}}
+			}
+
+			return randomScalarMessage(random)
+		},
+		Values: []RESPMessage{
+			{Kind: SimpleStringKind, SimpleString: &SimpleStringMessage{Content: ""}},
+			{Kind: IntegerKind, Integer: &IntegerMessage{Value: -42}},
+			{Kind: BulkStringKind, BulkString: &BulkStringMessage{Data: []byte{}}},
+			{Kind: BulkStringKind, BulkString: &BulkStringMessage{Data: []byte("line\r\nbreak")}},
+			{Kind: ArrayKind, Array: &ArrayMessage{Elements: []RESPMessage{}}},
+		},
+		Count: 200,
+		Seed:  1,
+	}.Run(t)
+}
+
+// randomScalarMessage produces a random RESP message, of any kind but arrays.
+func randomScalarMessage(random *rand.Rand) RESPMessage {
+	text := func() string {
+		b := make([]byte, random.Intn(16))
+		for i := range b {
+			b[i] = alnumCharset[random.Intn(len(alnumCharset))]
+		}
+
+		return string(b)
+	}
+
+	switch random.Intn(4) {
+	case 0:
+		return RESPMessage{Kind: SimpleStringKind, SimpleString: &SimpleStringMessage{Content: text()}}
+	case 1:
+		return RESPMessage{Kind: ErrorKind, Error: &ErrorStringMessage{Kind: "ERR", Message: text()}}
+	case 2:
+		return RESPMessage{Kind: IntegerKind, Integer: &IntegerMessage{Value: random.Int() - random.Int()}}
+	default:
+		return RESPMessage{Kind: BulkStringKind, BulkString: &BulkStringMessage{Data: []byte(text() + "\r\n" + text())}}
+	}
+}
+
 func simpleStringProducer(messageSize int) string {
 	return strings.Join(
 		[]string{
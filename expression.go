@@ -0,0 +1,240 @@
+package gomme
+
+// Associativity describes how a sequence of infix operators sharing the same
+// precedence is grouped.
+type Associativity int
+
+const (
+	// LeftAssociative operators are grouped from the left: "1-2-3" is
+	// parsed as "(1-2)-3".
+	LeftAssociative Associativity = iota
+
+	// RightAssociative operators are grouped from the right: "2^3^2" is
+	// parsed as "2^(3^2)".
+	RightAssociative
+
+	// NonAssociative operators can't be chained: once "1<2" is parsed,
+	// a following "<3" is left in the remaining input.
+	NonAssociative
+)
+
+// ExpressionBuilder describes the operators of an expression grammar, along
+// with their precedence and associativity, over a parser for the expression's
+// terms; such as numbers, identifiers, or parenthesized sub-expressions.
+//
+// Operators with a higher precedence bind tighter than operators with a lower
+// one. When several operators of the same kind match the input, the first one
+// registered wins: operators sharing a prefix, such as "<" and "<=", should be
+// registered from the longest to the shortest.
+//
+// ExpressionBuilder values are produced by Expression, and turned into a parser
+// by Build.
+type ExpressionBuilder[I Bytes, O any] struct {
+	term     Parser[I, O]
+	prefixes []unaryOperator[I, O]
+	infixes  []binaryOperator[I, O]
+	suffixes []unaryOperator[I, O]
+}
+
+type unaryOperator[I Bytes, O any] struct {
+	precedence int
+	parse      Parser[I, any]
+	apply      func(O) (O, error)
+}
+
+type binaryOperator[I Bytes, O any] struct {
+	precedence    int
+	associativity Associativity
+	parse         Parser[I, any]
+	apply         func(left, right O) (O, error)
+}
+
+// Expression starts the description of an expression grammar whose terms are
+// parsed by the provided parser. To allow parenthesized sub-expressions, the
+// term parser can refer to the built expression parser through a variable.
+func Expression[I Bytes, O any](term Parser[I, O]) *ExpressionBuilder[I, O] {
+	return &ExpressionBuilder[I, O]{term: term}
+}
+
+// Prefix registers an operator preceding its operand, such as the '-' of "-1",
+// with the provided precedence. Its operand extends over any operator binding
+// tighter, and is passed to apply to produce the operation's value.
+func (b *ExpressionBuilder[I, O]) Prefix(precedence int, operator Parser[I, any], apply func(O) (O, error)) *ExpressionBuilder[I, O] {
+	b.prefixes = append(b.prefixes, unaryOperator[I, O]{precedence: precedence, parse: operator, apply: apply})
+	return b
+}
+
+// Infix registers an operator standing between its operands, such as the '+'
+// of "1+2", with the provided precedence and associativity. Both operands are
+// passed to apply to produce the operation's value.
+func (b *ExpressionBuilder[I, O]) Infix(
+	precedence int,
+	associativity Associativity,
+	operator Parser[I, any],
+	apply func(left, right O) (O, error),
+) *ExpressionBuilder[I, O] {
+	b.infixes = append(b.infixes, binaryOperator[I, O]{
+		precedence:    precedence,
+		associativity: associativity,
+		parse:         operator,
+		apply:         apply,
+	})
+	return b
+}
+
+// Postfix registers an operator following its operand, such as the '!' of "3!",
+// with the provided precedence. Its operand is passed to apply to produce the
+// operation's value.
+func (b *ExpressionBuilder[I, O]) Postfix(precedence int, operator Parser[I, any], apply func(O) (O, error)) *ExpressionBuilder[I, O] {
+	b.suffixes = append(b.suffixes, unaryOperator[I, O]{precedence: precedence, parse: operator, apply: apply})
+	return b
+}
+
+// Build produces a parser for the described expressions, using precedence
+// climbing. Operators registered after Build is called don't affect the
+// produced parser.
+//
+// If the input doesn't start with an expression, if an operator isn't followed
+// by its operand, or if one of the operators' functions returns an error, the
+// produced parser fails and returns an error Result.
+func (b *ExpressionBuilder[I, O]) Build() Parser[I, O] {
+	e := &ExpressionBuilder[I, O]{
+		term:     b.term,
+		prefixes: append([]unaryOperator[I, O](nil), b.prefixes...),
+		infixes:  append([]binaryOperator[I, O](nil), b.infixes...),
+		suffixes: append([]unaryOperator[I, O](nil), b.suffixes...),
+	}
+
+	return func(input I) Result[O, I] {
+		return e.parse(input, minPrecedence)
+	}
+}
+
+// minPrecedence is lower than any precedence an operator can be registered with,
+// so that parsing a whole expression accepts every operator.
+const minPrecedence = -int(^uint(0)>>1) - 1
+
+// parse parses an expression whose operators all have a precedence greater than
+// or equal to the provided one.
+func (b *ExpressionBuilder[I, O]) parse(input I, precedence int) Result[O, I] {
+	result := b.operand(input)
+	if result.Err != nil {
+		return result
+	}
+
+	value, remaining := result.Output, result.Remaining
+
+	// The precedence of the last non associative operator applied, which can't be
+	// followed by another operator of the same precedence.
+	nonAssociative, chained := 0, false
+
+	for {
+		suffix, rest, err := matchUnary(b.suffixes, remaining)
+		if err != nil {
+			return Failure[I, O](err, input)
+		}
+
+		if suffix != nil {
+			if suffix.precedence < precedence {
+				return Success(value, remaining)
+			}
+
+			output, applyErr := suffix.apply(value)
+			if applyErr != nil {
+				return Failure[I, O](NewError(input, applyErr.Error()), input)
+			}
+
+			value, remaining = output, rest
+			continue
+		}
+
+		operator, rest, err := matchBinary(b.infixes, remaining)
+		if err != nil {
+			return Failure[I, O](err, input)
+		}
+
+		if operator == nil || operator.precedence < precedence ||
+			(chained && operator.associativity == NonAssociative && operator.precedence == nonAssociative) {
+			return Success(value, remaining)
+		}
+
+		// Operands of left and non associative operators only extend over operators
+		// binding tighter, whereas operands of right associative ones also extend
+		// over operators of the same precedence.
+		next := operator.precedence
+		if operator.associativity != RightAssociative {
+			next++
+		}
+
+		right := b.parse(rest, next)
+		if right.Err != nil {
+			return Failure[I, O](right.Report(), input)
+		}
+
+		output, applyErr := operator.apply(value, right.Output)
+		if applyErr != nil {
+			return Failure[I, O](NewError(input, applyErr.Error()), input)
+		}
+
+		value, remaining = output, right.Remaining
+		nonAssociative, chained = operator.precedence, operator.associativity == NonAssociative
+	}
+}
+
+// operand parses a term, optionally preceded by prefix operators.
+func (b *ExpressionBuilder[I, O]) operand(input I) Result[O, I] {
+	operator, rest, err := matchUnary(b.prefixes, input)
+	if err != nil {
+		return Failure[I, O](err, input)
+	}
+
+	if operator == nil {
+		return b.term(input)
+	}
+
+	result := b.parse(rest, operator.precedence)
+	if result.Err != nil {
+		return Failure[I, O](result.Report(), input)
+	}
+
+	output, applyErr := operator.apply(result.Output)
+	if applyErr != nil {
+		return Failure[I, O](NewError(input, applyErr.Error()), input)
+	}
+
+	return Success(output, result.Remaining)
+}
+
+// matchUnary returns the first of the provided operators matching the input,
+// along with the input following it. If none matches, the returned operator is
+// nil. If one of the operators fails with a fatal error, it is returned.
+func matchUnary[I Bytes, O any](operators []unaryOperator[I, O], input I) (*unaryOperator[I, O], I, *Error[I]) {
+	for idx := range operators {
+		result := operators[idx].parse(input)
+		if result.Err == nil {
+			return &operators[idx], result.Remaining, nil
+		}
+
+		if result.Err.IsFatal() {
+			return nil, input, result.Err
+		}
+	}
+
+	return nil, input, nil
+}
+
+// matchBinary behaves like matchUnary, for infix operators.
+func matchBinary[I Bytes, O any](operators []binaryOperator[I, O], input I) (*binaryOperator[I, O], I, *Error[I]) {
+	for idx := range operators {
+		result := operators[idx].parse(input)
+		if result.Err == nil {
+			return &operators[idx], result.Remaining, nil
+		}
+
+		if result.Err.IsFatal() {
+			return nil, input, result.Err
+		}
+	}
+
+	return nil, input, nil
+}
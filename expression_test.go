@@ -0,0 +1,272 @@
+package gomme
+
+import (
+	"errors"
+	"testing"
+)
+
+// groupingParser produces a parser for arithmetic expressions, which outputs
+// the expressions with each operation enclosed in parentheses, so that tests
+// can observe how operators were grouped.
+func groupingParser() Parser[string, string] {
+	var expression Parser[string, string]
+
+	term := Alternative(
+		Digit1[string](),
+		Delimited(Char[string]('('), func(input string) Result[string, string] {
+			return expression(input)
+		}, Char[string](')')),
+	)
+
+	binary := func(operator string) func(left, right string) (string, error) {
+		return func(left, right string) (string, error) {
+			return "(" + left + operator + right + ")", nil
+		}
+	}
+
+	unary := func(format func(string) string) func(string) (string, error) {
+		return func(operand string) (string, error) {
+			return format(operand), nil
+		}
+	}
+
+	expression = Expression(term).
+		Infix(5, NonAssociative, Untyped(Token[string]("<=")), binary("<=")).
+		Infix(5, NonAssociative, Untyped(Token[string]("<")), binary("<")).
+		Infix(10, LeftAssociative, Untyped(Char[string]('+')), binary("+")).
+		Infix(10, LeftAssociative, Untyped(Char[string]('-')), binary("-")).
+		Infix(20, LeftAssociative, Untyped(Char[string]('*')), binary("*")).
+		Infix(40, RightAssociative, Untyped(Char[string]('^')), binary("^")).
+		Prefix(30, Untyped(Char[string]('-')), unary(func(operand string) string { return "(-" + operand + ")" })).
+		Postfix(50, Untyped(Char[string]('!')), unary(func(operand string) string { return "(" + operand + "!)" })).
+		Build()
+
+	return expression
+}
+
+func TestExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a single term should succeed",
+			parser:        groupingParser(),
+			input:         "12",
+			wantErr:       false,
+			wantOutput:    "12",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing operators of different precedences should group the tighter ones first",
+			parser:        groupingParser(),
+			input:         "1+2*3-4",
+			wantErr:       false,
+			wantOutput:    "((1+(2*3))-4)",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing left associative operators should group them from the left",
+			parser:        groupingParser(),
+			input:         "1-2-3",
+			wantErr:       false,
+			wantOutput:    "((1-2)-3)",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing right associative operators should group them from the right",
+			parser:        groupingParser(),
+			input:         "2^3^2",
+			wantErr:       false,
+			wantOutput:    "(2^(3^2))",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a prefix operator should bind it according to its precedence",
+			parser:        groupingParser(),
+			input:         "-2^2*-3",
+			wantErr:       false,
+			wantOutput:    "((-(2^2))*(-3))",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a postfix operator should bind it according to its precedence",
+			parser:        groupingParser(),
+			input:         "-3!+2^2!",
+			wantErr:       false,
+			wantOutput:    "((-(3!))+(2^(2!)))",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing parenthesized sub-expressions should group them first",
+			parser:        groupingParser(),
+			input:         "(1+2)*3",
+			wantErr:       false,
+			wantOutput:    "((1+2)*3)",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing operators sharing a prefix should use the first registered one",
+			parser:        groupingParser(),
+			input:         "1+1<=3",
+			wantErr:       false,
+			wantOutput:    "((1+1)<=3)",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing chained non associative operators should stop before the second one",
+			parser:        groupingParser(),
+			input:         "1<2<3",
+			wantErr:       false,
+			wantOutput:    "(1<2)",
+			wantRemaining: "<3",
+		},
+		{
+			name:          "parsing an expression followed by other input should leave it in the remaining input",
+			parser:        groupingParser(),
+			input:         "1+2;",
+			wantErr:       false,
+			wantOutput:    "(1+2)",
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an infix operator missing its right operand should fail",
+			parser:        groupingParser(),
+			input:         "1+",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "1+",
+		},
+		{
+			name:          "parsing a prefix operator missing its operand should fail",
+			parser:        groupingParser(),
+			input:         "-",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "-",
+		},
+		{
+			name:          "parsing an unterminated sub-expression should fail",
+			parser:        groupingParser(),
+			input:         "(1+2",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "(1+2",
+		},
+		{
+			name:          "parsing input not starting with a term should fail",
+			parser:        groupingParser(),
+			input:         "*1",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "*1",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        groupingParser(),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestExpressionEvaluation(t *testing.T) {
+	t.Parallel()
+
+	errDivisionByZero := errors.New("division by zero")
+
+	parser := Expression(Int64[string]()).
+		Infix(1, LeftAssociative, Untyped(Char[string]('+')), func(left, right int64) (int64, error) {
+			return left + right, nil
+		}).
+		Infix(2, LeftAssociative, Untyped(Char[string]('/')), func(left, right int64) (int64, error) {
+			if right == 0 {
+				return 0, errDivisionByZero
+			}
+
+			return left / right, nil
+		}).
+		Build()
+
+	result := parser("1+12/4+2")
+	if result.Err != nil || result.Output != 6 {
+		t.Errorf("got output %v and error %v, want output 6", result.Output, result.Err)
+	}
+
+	result = parser("1+12/0")
+	if result.Err == nil {
+		t.Fatalf("got no error, want error")
+	}
+
+	if result.Remaining != "1+12/0" {
+		t.Errorf("got remaining %v, want remaining %v", result.Remaining, "1+12/0")
+	}
+}
+
+func TestExpressionFatalOperatorError(t *testing.T) {
+	t.Parallel()
+
+	fatal := func(input string) Result[any, string] {
+		return Failure[string, any](&Error[string]{Input: input, Err: errors.New("invalid operator"), Expected: []string{"operator"}}, input)
+	}
+
+	parser := Expression(Digit1[string]()).
+		Infix(1, LeftAssociative, fatal, func(left, right string) (string, error) {
+			return left + right, nil
+		}).
+		Build()
+
+	result := parser("1+2")
+	if result.Err == nil || !result.Err.IsFatal() {
+		t.Errorf("got error %v, want fatal error", result.Err)
+	}
+}
+
+func BenchmarkExpression(b *testing.B) {
+	parser := Expression(Int64[string]()).
+		Infix(1, LeftAssociative, Untyped(Char[string]('+')), func(left, right int64) (int64, error) {
+			return left + right, nil
+		}).
+		Infix(2, LeftAssociative, Untyped(Char[string]('*')), func(left, right int64) (int64, error) {
+			return left * right, nil
+		}).
+		Prefix(3, Untyped(Char[string]('-')), func(operand int64) (int64, error) {
+			return -operand, nil
+		}).
+		Build()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1+2*3+-4*5+6")
+	}
+}
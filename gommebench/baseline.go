@@ -0,0 +1,98 @@
+package gommebench
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// ErrMalformed is returned by the baseline scanners when their input doesn't
+// follow the format they expect.
+var ErrMalformed = errors.New("gommebench: malformed input")
+
+// DecodeJSON is the baseline gomme JSON parsers are compared against: it
+// decodes the provided document using encoding/json.
+func DecodeJSON(data []byte) (any, error) {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// ScanCSV is a hand-written scanner, the baseline gomme CSV parsers are compared
+// against. It splits the provided CSV file, made of unquoted fields and line feed
+// terminated rows, into its fields, which it returns.
+func ScanCSV(data []byte) ([][][]byte, error) {
+	var rows [][][]byte
+
+	for len(data) > 0 {
+		end := bytes.IndexByte(data, '\n')
+		if end < 0 {
+			return nil, ErrMalformed
+		}
+
+		rows = append(rows, bytes.Split(data[:end], []byte{','}))
+		data = data[end+1:]
+	}
+
+	return rows, nil
+}
+
+// ScanRESP is a hand-written scanner, the baseline gomme Redis serialization
+// protocol parsers are compared against. It walks the provided stream of
+// messages, and returns the number of top-level messages it holds.
+func ScanRESP(data []byte) (int, error) {
+	count := 0
+	for len(data) > 0 {
+		rest, err := scanRESPMessage(data)
+		if err != nil {
+			return 0, err
+		}
+
+		data = rest
+		count++
+	}
+
+	return count, nil
+}
+
+// scanRESPMessage skips over the message found at the beginning of the data,
+// and returns what follows it.
+func scanRESPMessage(data []byte) ([]byte, error) {
+	end := bytes.Index(data, []byte("\r\n"))
+	if end < 1 {
+		return nil, ErrMalformed
+	}
+
+	line, rest := data[1:end], data[end+2:]
+
+	switch data[0] {
+	case '+', '-', ':':
+		return rest, nil
+	case '$':
+		length, err := strconv.Atoi(string(line))
+		if err != nil || length < 0 || len(rest) < length+2 || !bytes.HasPrefix(rest[length:], []byte("\r\n")) {
+			return nil, ErrMalformed
+		}
+
+		return rest[length+2:], nil
+	case '*':
+		count, err := strconv.Atoi(string(line))
+		if err != nil || count < 0 {
+			return nil, ErrMalformed
+		}
+
+		for idx := 0; idx < count; idx++ {
+			if rest, err = scanRESPMessage(rest); err != nil {
+				return nil, err
+			}
+		}
+
+		return rest, nil
+	}
+
+	return nil, ErrMalformed
+}
@@ -0,0 +1,71 @@
+package gommebench
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanCSV(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		input    string
+		wantErr  bool
+		wantRows int
+	}{
+		{name: "scanning rows should succeed", input: "a,b\nc,d\n", wantErr: false, wantRows: 2},
+		{name: "scanning empty input should succeed", input: "", wantErr: false, wantRows: 0},
+		{name: "scanning an unterminated row should fail", input: "a,b\nc,d", wantErr: true, wantRows: 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rows, err := ScanCSV([]byte(tc.input))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", err, tc.wantErr)
+			}
+
+			assert.Len(t, rows, tc.wantRows)
+		})
+	}
+}
+
+func TestScanRESP(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		input     string
+		wantErr   bool
+		wantCount int
+	}{
+		{name: "scanning simple messages should succeed", input: "+OK\r\n-ERR\r\n:12\r\n", wantErr: false, wantCount: 3},
+		{name: "scanning a bulk string should succeed", input: "$5\r\nhe\r\no\r\n", wantErr: false, wantCount: 1},
+		{name: "scanning nested arrays should succeed", input: "*2\r\n*1\r\n:1\r\n$1\r\na\r\n+OK\r\n", wantErr: false, wantCount: 2},
+		{name: "scanning a truncated bulk string should fail", input: "$5\r\nhel\r\n", wantErr: true, wantCount: 0},
+		{name: "scanning a truncated array should fail", input: "*2\r\n:1\r\n", wantErr: true, wantCount: 0},
+		{name: "scanning an unknown message type should fail", input: "?OK\r\n", wantErr: true, wantCount: 0},
+		{name: "scanning an unterminated message should fail", input: "+OK", wantErr: true, wantCount: 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			count, err := ScanRESP([]byte(tc.input))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantCount, count)
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package gommebench
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/oleiade/gomme/examples/json"
+)
+
+// The benchmarks below compare parsers built using gomme with the baselines,
+// over the standard corpora. Running them with -benchmem before and after a
+// change to the combinators highlights its impact.
+
+func BenchmarkCSV(b *testing.B) {
+	corpus := CSVCorpus(1000, 8)
+	parser := csvParser()
+
+	b.Run("gomme", func(b *testing.B) {
+		b.SetBytes(int64(len(corpus.Data)))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			parser(corpus.Data)
+		}
+	})
+
+	b.Run("baseline", func(b *testing.B) {
+		b.SetBytes(int64(len(corpus.Data)))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = ScanCSV(corpus.Data)
+		}
+	})
+}
+
+func BenchmarkRESP(b *testing.B) {
+	corpus := RESPCorpus(1000)
+	parser := gomme.Many0(respMessage)
+
+	b.Run("gomme", func(b *testing.B) {
+		b.SetBytes(int64(len(corpus.Data)))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			parser(corpus.Data)
+		}
+	})
+
+	b.Run("baseline", func(b *testing.B) {
+		b.SetBytes(int64(len(corpus.Data)))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = ScanRESP(corpus.Data)
+		}
+	})
+}
+
+func BenchmarkJSON(b *testing.B) {
+	corpus := JSONCorpus(100)
+	document := string(corpus.Data)
+
+	b.Run("gomme", func(b *testing.B) {
+		b.SetBytes(int64(len(corpus.Data)))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			json.ParseJSON(document)
+		}
+	})
+
+	b.Run("baseline", func(b *testing.B) {
+		b.SetBytes(int64(len(corpus.Data)))
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			_, _ = DecodeJSON(corpus.Data)
+		}
+	})
+}
+
+func TestGommeParsersMatchBaselines(t *testing.T) {
+	t.Parallel()
+
+	csv := CSVCorpus(20, 5)
+	wantRows, err := ScanCSV(csv.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotRows := csvParser()(csv.Data)
+	if gotRows.Err != nil || len(gotRows.Remaining) != 0 || len(gotRows.Output) != len(wantRows) {
+		t.Errorf("got %d CSV rows, want %d", len(gotRows.Output), len(wantRows))
+	}
+
+	document := JSONCorpus(20)
+	wantValue, err := DecodeJSON(document.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotValue := json.ParseJSON(string(document.Data))
+	gotArray, ok := gotValue.Output.(json.JSONArray)
+	if gotValue.Err != nil || gotValue.Remaining != "\n" || !ok || len(gotArray) != len(wantValue.([]any)) {
+		t.Errorf("got %d JSON objects, want %d", len(gotArray), len(wantValue.([]any)))
+	}
+
+	resp := RESPCorpus(20)
+	wantCount, err := ScanRESP(resp.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotMessages := gomme.Many0(respMessage)(resp.Data)
+	if gotMessages.Err != nil || len(gotMessages.Remaining) != 0 || len(gotMessages.Output) != wantCount {
+		t.Errorf("got %d RESP messages, want %d", len(gotMessages.Output), wantCount)
+	}
+}
+
+// csvParser parses CSV files made of unquoted fields, as CSVCorpus produces them.
+func csvParser() gomme.Parser[[]byte, [][][]byte] {
+	field := gomme.TakeWhileNot[[]byte](func(c rune) bool { return c == ',' || c == '\n' })
+	row := gomme.Terminated(gomme.SeparatedList1(field, gomme.Char[[]byte](',')), gomme.Char[[]byte]('\n'))
+
+	return gomme.Many0(row)
+}
+
+var (
+	// respLength parses the length, or element count, of a RESP bulk string
+	// or array.
+	respLength = gomme.Terminated(gomme.UInt64[[]byte](), gomme.CRLF[[]byte]())
+
+	// respScalar parses the RESP messages which aren't arrays.
+	respScalar = gomme.Recognize(gomme.Alternative(
+		gomme.Preceded(
+			gomme.OneOf[[]byte]('+', '-', ':'),
+			gomme.Terminated(gomme.TakeUntilToken[[]byte]("\r\n"), gomme.CRLF[[]byte]()),
+		),
+		gomme.Preceded(
+			gomme.Char[[]byte]('$'),
+			gomme.Terminated(gomme.LengthData(respLength), gomme.CRLF[[]byte]()),
+		),
+	))
+)
+
+// respMessage parses a single message of the Redis serialization protocol, as
+// RESPCorpus produces them, and returns the part of the input it spans.
+func respMessage(input []byte) gomme.Result[[]byte, []byte] {
+	if len(input) == 0 || input[0] != '*' {
+		return respScalar(input)
+	}
+
+	count := respLength(input[1:])
+	if count.Err != nil {
+		return gomme.Failure[[]byte, []byte](count.Err, input)
+	}
+
+	remaining := count.Remaining
+	for idx := uint64(0); idx < count.Output; idx++ {
+		element := respMessage(remaining)
+		if element.Err != nil {
+			return gomme.Failure[[]byte, []byte](element.Err, input)
+		}
+
+		remaining = element.Remaining
+	}
+
+	return gomme.Success(input[:len(input)-len(remaining)], remaining)
+}
@@ -0,0 +1,633 @@
+package gommebench
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+)
+
+// This file holds the prototype of a struct-based parser representation, used
+// to measure how it compares with gomme's closure-based one. See
+// parser-core.md for the findings.
+//
+// Parsers are values of concrete types implementing a Parse method, and
+// combinators are generic over the concrete types of the parsers they hold,
+// rather than over their output only. The complete type of a grammar is thus
+// known at compile time, which lets the compiler devirtualize, and possibly
+// inline, the calls to the primitives.
+
+// structParser is the interface the prototype's parsers implement.
+type structParser[O any] interface {
+	Parse(input []byte) gomme.Result[O, []byte]
+}
+
+// The errors the prototype's primitives fail with: as gomme's primitives do,
+// each of them shares a single error across its failures.
+var (
+	errStructChar          = gomme.NewError[[]byte](nil, "Char")
+	errStructUntilCRLF     = gomme.NewError[[]byte](nil, "UntilCRLF")
+	errStructOneOf         = gomme.NewError[[]byte](nil, "OneOf")
+	errStructTakeWhileNot1 = gomme.NewError[[]byte](nil, "TakeWhileNot1")
+	errStructAnyChar       = gomme.NewError[[]byte](nil, "AnyChar")
+	errStructToken         = gomme.NewError[[]byte](nil, "Token")
+)
+
+// structChar is the prototype's counterpart of gomme.Char.
+type structChar struct{ c byte }
+
+func (p structChar) Parse(input []byte) gomme.Result[rune, []byte] {
+	if len(input) == 0 || input[0] != p.c {
+		return gomme.Failure[[]byte, rune](errStructChar, input)
+	}
+
+	return gomme.Success(rune(input[0]), input[1:])
+}
+
+// structTakeWhileNot2 is the prototype's counterpart of gomme.TakeWhileNot, with
+// a predicate matching either of two bytes inlined.
+type structTakeWhileNot2 struct{ a, b byte }
+
+func (p structTakeWhileNot2) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	pos := 0
+	for pos < len(input) && input[pos] != p.a && input[pos] != p.b {
+		pos++
+	}
+
+	return gomme.Success(input[:pos], input[pos:])
+}
+
+// structUntilCRLF is the prototype's counterpart of TakeUntilToken("\r\n")
+// followed by CRLF.
+type structUntilCRLF struct{}
+
+func (structUntilCRLF) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	for pos := 0; pos+1 < len(input); pos++ {
+		if input[pos] == '\r' && input[pos+1] == '\n' {
+			return gomme.Success(input[:pos], input[pos+2:])
+		}
+	}
+
+	return gomme.Failure[[]byte, []byte](errStructUntilCRLF, input)
+}
+
+// structLength is the prototype's counterpart of respLength, which it delegates
+// to.
+type structLength struct{}
+
+func (structLength) Parse(input []byte) gomme.Result[uint64, []byte] {
+	return respLength(input)
+}
+
+// structPreceded is the prototype's counterpart of gomme.Preceded.
+type structPreceded[P structParser[PO], S structParser[O], PO, O any] struct {
+	prefix P
+	parse  S
+}
+
+func (p structPreceded[P, S, PO, O]) Parse(input []byte) gomme.Result[O, []byte] {
+	prefix := p.prefix.Parse(input)
+	if prefix.Err != nil {
+		return gomme.Failure[[]byte, O](prefix.Err, input)
+	}
+
+	result := p.parse.Parse(prefix.Remaining)
+	if result.Err != nil {
+		return gomme.Failure[[]byte, O](result.Err, input)
+	}
+
+	return result
+}
+
+// structAlternative2 is the prototype's counterpart of gomme.Alternative, with
+// two alternatives.
+type structAlternative2[A structParser[O], B structParser[O], O any] struct {
+	a A
+	b B
+}
+
+func (p structAlternative2[A, B, O]) Parse(input []byte) gomme.Result[O, []byte] {
+	if result := p.a.Parse(input); result.Err == nil || result.Err.IsFatal() {
+		return result
+	}
+
+	return p.b.Parse(input)
+}
+
+// structLengthData is the prototype's counterpart of gomme.LengthData, followed
+// by CRLF.
+type structLengthData[L structParser[uint64]] struct{ length L }
+
+func (p structLengthData[L]) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	length := p.length.Parse(input)
+	if length.Err != nil || length.Output+2 > uint64(len(length.Remaining)) {
+		return gomme.Failure[[]byte, []byte](gomme.NewError(input, "LengthData"), input)
+	}
+
+	end := int(length.Output)
+	if length.Remaining[end] != '\r' || length.Remaining[end+1] != '\n' {
+		return gomme.Failure[[]byte, []byte](gomme.NewError(input, "LengthData"), input)
+	}
+
+	return gomme.Success(length.Remaining[:end], length.Remaining[end+2:])
+}
+
+// structSeparatedList1 is the prototype's counterpart of gomme.SeparatedList1,
+// followed by a terminator, as gomme.Terminated would.
+type structSeparatedList1[P structParser[O], S structParser[SO], T structParser[TO], O, SO, TO any] struct {
+	parse      P
+	separator  S
+	terminator T
+}
+
+func (p structSeparatedList1[P, S, T, O, SO, TO]) Parse(input []byte) gomme.Result[[]O, []byte] {
+	first := p.parse.Parse(input)
+	if first.Err != nil {
+		return gomme.Failure[[]byte, []O](first.Err, input)
+	}
+
+	results := []O{first.Output}
+	remaining := first.Remaining
+	for {
+		separator := p.separator.Parse(remaining)
+		if separator.Err != nil {
+			break
+		}
+
+		result := p.parse.Parse(separator.Remaining)
+		if result.Err != nil {
+			break
+		}
+
+		results = append(results, result.Output)
+		remaining = result.Remaining
+	}
+
+	terminator := p.terminator.Parse(remaining)
+	if terminator.Err != nil {
+		return gomme.Failure[[]byte, []O](terminator.Err, input)
+	}
+
+	return gomme.Success(results, terminator.Remaining)
+}
+
+// structMany0 is the prototype's counterpart of gomme.Many0.
+type structMany0[P structParser[O], O any] struct{ parse P }
+
+func (p structMany0[P, O]) Parse(input []byte) gomme.Result[[]O, []byte] {
+	var results []O
+
+	remaining := input
+	for {
+		result := p.parse.Parse(remaining)
+		if result.Err != nil {
+			return gomme.Success(results, remaining)
+		}
+
+		if len(result.Remaining) == len(remaining) {
+			return gomme.Failure[[]byte, []O](gomme.NewError(input, "Many0"), input)
+		}
+
+		results = append(results, result.Output)
+		remaining = result.Remaining
+	}
+}
+
+// structCSV is the struct-based counterpart of csvParser.
+var structCSV = structMany0[structSeparatedList1[structTakeWhileNot2, structChar, structChar, []byte, rune, rune], [][]byte]{
+	parse: structSeparatedList1[structTakeWhileNot2, structChar, structChar, []byte, rune, rune]{
+		parse:      structTakeWhileNot2{a: ',', b: '\n'},
+		separator:  structChar{','},
+		terminator: structChar{'\n'},
+	},
+}
+
+// structRESPScalar is the struct-based counterpart of respScalar.
+var structRESPScalar = structAlternative2[
+	structPreceded[structOneOf3, structUntilCRLF, rune, []byte],
+	structPreceded[structChar, structLengthData[structLength], rune, []byte],
+	[]byte,
+]{
+	a: structPreceded[structOneOf3, structUntilCRLF, rune, []byte]{prefix: structOneOf3{'+', '-', ':'}},
+	b: structPreceded[structChar, structLengthData[structLength], rune, []byte]{prefix: structChar{'$'}},
+}
+
+// structOneOf3 is the prototype's counterpart of gomme.OneOf, with three
+// characters.
+type structOneOf3 struct{ a, b, c byte }
+
+func (p structOneOf3) Parse(input []byte) gomme.Result[rune, []byte] {
+	if len(input) == 0 || (input[0] != p.a && input[0] != p.b && input[0] != p.c) {
+		return gomme.Failure[[]byte, rune](errStructOneOf, input)
+	}
+
+	return gomme.Success(rune(input[0]), input[1:])
+}
+
+// structRESPMessage is the struct-based counterpart of respMessage.
+func structRESPMessage(input []byte) gomme.Result[[]byte, []byte] {
+	if len(input) == 0 || input[0] != '*' {
+		return structRESPScalar.Parse(input)
+	}
+
+	count := structLength{}.Parse(input[1:])
+	if count.Err != nil {
+		return gomme.Failure[[]byte, []byte](count.Err, input)
+	}
+
+	remaining := count.Remaining
+	for idx := uint64(0); idx < count.Output; idx++ {
+		element := structRESPMessage(remaining)
+		if element.Err != nil {
+			return gomme.Failure[[]byte, []byte](element.Err, input)
+		}
+
+		remaining = element.Remaining
+	}
+
+	return gomme.Success(input[:len(input)-len(remaining)], remaining)
+}
+
+// structWhitespace0 is the prototype's counterpart of gomme.Whitespace0.
+type structWhitespace0 struct{}
+
+func (structWhitespace0) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	pos := 0
+	for pos < len(input) && (input[pos] == ' ' || input[pos] == '\t' || input[pos] == '\n' || input[pos] == '\r') {
+		pos++
+	}
+
+	return gomme.Success(input[:pos], input[pos:])
+}
+
+// structTakeWhileNot1Of2 is the prototype's counterpart of gomme.TakeWhileNot1,
+// with a predicate matching either of two bytes inlined.
+type structTakeWhileNot1Of2 struct{ a, b byte }
+
+func (p structTakeWhileNot1Of2) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	pos := 0
+	for pos < len(input) && input[pos] != p.a && input[pos] != p.b {
+		pos++
+	}
+
+	if pos == 0 {
+		return gomme.Failure[[]byte, []byte](errStructTakeWhileNot1, input)
+	}
+
+	return gomme.Success(input[:pos], input[pos:])
+}
+
+// structAnyChar is the prototype's counterpart of gomme.AnyChar.
+type structAnyChar struct{}
+
+func (structAnyChar) Parse(input []byte) gomme.Result[rune, []byte] {
+	if len(input) == 0 {
+		return gomme.Failure[[]byte, rune](errStructAnyChar, input)
+	}
+
+	return gomme.Success(rune(input[0]), input[1:])
+}
+
+// structToken is the prototype's counterpart of gomme.Token.
+type structToken struct{ token string }
+
+func (p structToken) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	if len(input) < len(p.token) || string(input[:len(p.token)]) != p.token {
+		return gomme.Failure[[]byte, []byte](errStructToken, input)
+	}
+
+	return gomme.Success(input[:len(p.token)], input[len(p.token):])
+}
+
+// structRecognize is the prototype's counterpart of gomme.Recognize.
+type structRecognize[P structParser[O], O any] struct{ parse P }
+
+func (p structRecognize[P, O]) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	result := p.parse.Parse(input)
+	if result.Err != nil {
+		return gomme.Failure[[]byte, []byte](result.Err, input)
+	}
+
+	return gomme.Success(input[:len(input)-len(result.Remaining)], result.Remaining)
+}
+
+// structDelimited is the prototype's counterpart of gomme.Delimited.
+type structDelimited[P structParser[PO], S structParser[O], T structParser[TO], PO, O, TO any] struct {
+	prefix P
+	parse  S
+	suffix T
+}
+
+func (p structDelimited[P, S, T, PO, O, TO]) Parse(input []byte) gomme.Result[O, []byte] {
+	prefix := p.prefix.Parse(input)
+	if prefix.Err != nil {
+		return gomme.Failure[[]byte, O](prefix.Err, input)
+	}
+
+	result := p.parse.Parse(prefix.Remaining)
+	if result.Err != nil {
+		return gomme.Failure[[]byte, O](result.Err, input)
+	}
+
+	suffix := p.suffix.Parse(result.Remaining)
+	if suffix.Err != nil {
+		return gomme.Failure[[]byte, O](suffix.Err, input)
+	}
+
+	return gomme.Success(result.Output, suffix.Remaining)
+}
+
+// structSeparatedList0 is the prototype's counterpart of gomme.SeparatedList0.
+type structSeparatedList0[P structParser[O], S structParser[SO], O, SO any] struct {
+	parse     P
+	separator S
+}
+
+func (p structSeparatedList0[P, S, O, SO]) Parse(input []byte) gomme.Result[[]O, []byte] {
+	first := p.parse.Parse(input)
+	if first.Err != nil {
+		return gomme.Success([]O{}, input)
+	}
+
+	results := []O{first.Output}
+	remaining := first.Remaining
+	for {
+		separator := p.separator.Parse(remaining)
+		if separator.Err != nil {
+			break
+		}
+
+		result := p.parse.Parse(separator.Remaining)
+		if result.Err != nil {
+			break
+		}
+
+		results = append(results, result.Output)
+		remaining = result.Remaining
+	}
+
+	return gomme.Success(results, remaining)
+}
+
+// jsonNumber recognizes the numbers of the JSON documents JSONCorpus produces.
+var jsonNumber = gomme.Recognize(gomme.Pair(
+	gomme.Pair(gomme.Optional(gomme.Char[[]byte]('-')), gomme.Digit1[[]byte]()),
+	gomme.Pair(
+		gomme.Optional(gomme.Preceded(gomme.Char[[]byte]('.'), gomme.Digit1[[]byte]())),
+		gomme.Optional(gomme.Preceded(
+			gomme.OneOf[[]byte]('e', 'E'),
+			gomme.Pair(gomme.Optional(gomme.OneOf[[]byte]('+', '-')), gomme.Digit1[[]byte]()),
+		)),
+	),
+))
+
+// jsonRecognizer parses a JSON value, surrounded by whitespace, as JSONCorpus
+// produces them, and returns the part of the input it spans. Unlike the
+// examples/json grammar, which builds its combinators anew on each call and
+// decodes values, it builds them once, and only recognizes values, as the CSV
+// and RESP grammars do, so that the representation of parsers dominates.
+func jsonRecognizer() gomme.Parser[[]byte, []byte] {
+	var value gomme.Parser[[]byte, []byte]
+	recurse := func(input []byte) gomme.Result[[]byte, []byte] {
+		return value(input)
+	}
+
+	ws := gomme.Whitespace0[[]byte]()
+	str := gomme.Recognize(gomme.Delimited(
+		gomme.Char[[]byte]('"'),
+		gomme.Many0(gomme.Alternative(
+			gomme.TakeWhileNot1[[]byte](func(c rune) bool { return c == '"' || c == '\\' }),
+			gomme.Recognize(gomme.Preceded(gomme.Char[[]byte]('\\'), gomme.AnyChar[[]byte]())),
+		)),
+		gomme.Char[[]byte]('"'),
+	))
+	literal := gomme.Alternative(gomme.Token[[]byte]("true"), gomme.Token[[]byte]("false"), gomme.Token[[]byte]("null"))
+	array := gomme.Recognize(gomme.Delimited(
+		gomme.Char[[]byte]('['),
+		gomme.Preceded(ws, gomme.SeparatedList0(recurse, gomme.Char[[]byte](','))),
+		gomme.Char[[]byte](']'),
+	))
+	member := gomme.Preceded(gomme.Delimited(ws, str, gomme.Preceded(ws, gomme.Char[[]byte](':'))), recurse)
+	object := gomme.Recognize(gomme.Delimited(
+		gomme.Char[[]byte]('{'),
+		gomme.Preceded(ws, gomme.SeparatedList0(member, gomme.Char[[]byte](','))),
+		gomme.Char[[]byte]('}'),
+	))
+
+	value = gomme.Delimited(ws, gomme.Alternative(object, array, str, jsonNumber, literal), ws)
+
+	return value
+}
+
+// structJSONNumber is the prototype's counterpart of jsonNumber, which it
+// delegates to.
+type structJSONNumber struct{}
+
+func (structJSONNumber) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	return jsonNumber(input)
+}
+
+// The types of the struct-based counterpart of jsonRecognizer, spelled one
+// production at a time.
+type (
+	structJSONString = structRecognize[
+		structDelimited[
+			structChar,
+			structMany0[structAlternative2[
+				structTakeWhileNot1Of2,
+				structRecognize[structPreceded[structChar, structAnyChar, rune, rune], rune],
+				[]byte,
+			], []byte],
+			structChar,
+			rune, [][]byte, rune,
+		],
+		[][]byte,
+	]
+	structJSONLiteral = structAlternative2[structToken, structAlternative2[structToken, structToken, []byte], []byte]
+	structJSONArray   = structRecognize[
+		structDelimited[
+			structChar,
+			structPreceded[structWhitespace0, structSeparatedList0[structParserFunc, structChar, []byte, rune], []byte, [][]byte],
+			structChar,
+			rune, [][]byte, rune,
+		],
+		[][]byte,
+	]
+	structJSONMember = structPreceded[
+		structDelimited[structWhitespace0, structJSONString, structPreceded[structWhitespace0, structChar, []byte, rune], []byte, []byte, rune],
+		structParserFunc,
+		[]byte, []byte,
+	]
+	structJSONObject = structRecognize[
+		structDelimited[
+			structChar,
+			structPreceded[structWhitespace0, structSeparatedList0[structJSONMember, structChar, []byte, rune], []byte, [][]byte],
+			structChar,
+			rune, [][]byte, rune,
+		],
+		[][]byte,
+	]
+	structJSONValue = structDelimited[
+		structWhitespace0,
+		structAlternative2[structJSONObject, structAlternative2[structJSONArray, structAlternative2[structJSONString, structAlternative2[structJSONNumber, structJSONLiteral, []byte], []byte], []byte], []byte],
+		structWhitespace0,
+		[]byte, []byte, []byte,
+	]
+)
+
+// structJSONRecognizer is the struct-based counterpart of jsonRecognizer. As
+// the grammar is recursive, nested values are parsed through a structParserFunc.
+func structJSONRecognizer() structParserFunc {
+	var value structJSONValue
+	recurse := structParserFunc(func(input []byte) gomme.Result[[]byte, []byte] {
+		return value.Parse(input)
+	})
+
+	str := structJSONString{parse: structDelimited[
+		structChar,
+		structMany0[structAlternative2[
+			structTakeWhileNot1Of2,
+			structRecognize[structPreceded[structChar, structAnyChar, rune, rune], rune],
+			[]byte,
+		], []byte],
+		structChar,
+		rune, [][]byte, rune,
+	]{
+		prefix: structChar{'"'},
+		parse: structMany0[structAlternative2[
+			structTakeWhileNot1Of2,
+			structRecognize[structPreceded[structChar, structAnyChar, rune, rune], rune],
+			[]byte,
+		], []byte]{parse: structAlternative2[
+			structTakeWhileNot1Of2,
+			structRecognize[structPreceded[structChar, structAnyChar, rune, rune], rune],
+			[]byte,
+		]{
+			a: structTakeWhileNot1Of2{a: '"', b: '\\'},
+			b: structRecognize[structPreceded[structChar, structAnyChar, rune, rune], rune]{
+				parse: structPreceded[structChar, structAnyChar, rune, rune]{prefix: structChar{'\\'}},
+			},
+		}},
+		suffix: structChar{'"'},
+	}}
+
+	member := structJSONMember{
+		prefix: structDelimited[structWhitespace0, structJSONString, structPreceded[structWhitespace0, structChar, []byte, rune], []byte, []byte, rune]{
+			parse:  str,
+			suffix: structPreceded[structWhitespace0, structChar, []byte, rune]{parse: structChar{':'}},
+		},
+		parse: recurse,
+	}
+
+	value.parse = structAlternative2[structJSONObject, structAlternative2[structJSONArray, structAlternative2[structJSONString, structAlternative2[structJSONNumber, structJSONLiteral, []byte], []byte], []byte], []byte]{
+		a: structJSONObject{parse: structDelimited[
+			structChar,
+			structPreceded[structWhitespace0, structSeparatedList0[structJSONMember, structChar, []byte, rune], []byte, [][]byte],
+			structChar,
+			rune, [][]byte, rune,
+		]{
+			prefix: structChar{'{'},
+			parse: structPreceded[structWhitespace0, structSeparatedList0[structJSONMember, structChar, []byte, rune], []byte, [][]byte]{
+				parse: structSeparatedList0[structJSONMember, structChar, []byte, rune]{parse: member, separator: structChar{','}},
+			},
+			suffix: structChar{'}'},
+		}},
+		b: structAlternative2[structJSONArray, structAlternative2[structJSONString, structAlternative2[structJSONNumber, structJSONLiteral, []byte], []byte], []byte]{
+			a: structJSONArray{parse: structDelimited[
+				structChar,
+				structPreceded[structWhitespace0, structSeparatedList0[structParserFunc, structChar, []byte, rune], []byte, [][]byte],
+				structChar,
+				rune, [][]byte, rune,
+			]{
+				prefix: structChar{'['},
+				parse: structPreceded[structWhitespace0, structSeparatedList0[structParserFunc, structChar, []byte, rune], []byte, [][]byte]{
+					parse: structSeparatedList0[structParserFunc, structChar, []byte, rune]{parse: recurse, separator: structChar{','}},
+				},
+				suffix: structChar{']'},
+			}},
+			b: structAlternative2[structJSONString, structAlternative2[structJSONNumber, structJSONLiteral, []byte], []byte]{
+				a: str,
+				b: structAlternative2[structJSONNumber, structJSONLiteral, []byte]{
+					b: structJSONLiteral{
+						a: structToken{"true"},
+						b: structAlternative2[structToken, structToken, []byte]{a: structToken{"false"}, b: structToken{"null"}},
+					},
+				},
+			},
+		},
+	}
+
+	return recurse
+}
+
+func TestStructParsersMatchClosures(t *testing.T) {
+	t.Parallel()
+
+	csv := CSVCorpus(20, 5)
+	wantRows := csvParser()(csv.Data)
+	gotRows := structCSV.Parse(csv.Data)
+	if gotRows.Err != nil || len(gotRows.Remaining) != 0 || len(gotRows.Output) != len(wantRows.Output) {
+		t.Errorf("got %d CSV rows, want %d", len(gotRows.Output), len(wantRows.Output))
+	}
+
+	resp := RESPCorpus(20)
+	remaining := resp.Data
+	for len(remaining) > 0 {
+		want := respMessage(remaining)
+		got := structRESPMessage(remaining)
+		if got.Err != nil || len(got.Remaining) != len(want.Remaining) {
+			t.Fatalf("got RESP remaining %q, want %q", got.Remaining, want.Remaining)
+		}
+
+		remaining = got.Remaining
+	}
+
+	json := JSONCorpus(20)
+	wantValue := jsonRecognizer()(json.Data)
+	gotValue := structJSONRecognizer().Parse(json.Data)
+	if wantValue.Err != nil || len(wantValue.Remaining) != 0 || gotValue.Err != nil || len(gotValue.Remaining) != 0 {
+		t.Errorf("got JSON remaining %q and %q, want none", gotValue.Remaining, wantValue.Remaining)
+	}
+}
+
+func BenchmarkParserCore(b *testing.B) {
+	csv := CSVCorpus(1000, 8)
+	resp := RESPCorpus(1000)
+	json := JSONCorpus(100)
+	closureCSV := csvParser()
+	closureJSON := jsonRecognizer()
+	structJSON := structJSONRecognizer()
+
+	benchmarks := []struct {
+		name   string
+		corpus Corpus
+		parse  func([]byte)
+	}{
+		{name: "csv/closures", corpus: csv, parse: func(data []byte) { closureCSV(data) }},
+		{name: "csv/structs", corpus: csv, parse: func(data []byte) { structCSV.Parse(data) }},
+		{name: "resp/closures", corpus: resp, parse: func(data []byte) { gomme.Many0(respMessage)(data) }},
+		{name: "resp/structs", corpus: resp, parse: func(data []byte) { structMany0[structParserFunc, []byte]{structRESPMessage}.Parse(data) }},
+		{name: "json/closures", corpus: json, parse: func(data []byte) { closureJSON(data) }},
+		{name: "json/structs", corpus: json, parse: func(data []byte) { structJSON.Parse(data) }},
+	}
+
+	for _, bm := range benchmarks {
+		bm := bm
+
+		b.Run(bm.name, func(b *testing.B) {
+			b.SetBytes(int64(len(bm.corpus.Data)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				bm.parse(bm.corpus.Data)
+			}
+		})
+	}
+}
+
+// structParserFunc adapts a recursive parse function to the prototype's
+// interface.
+type structParserFunc func(input []byte) gomme.Result[[]byte, []byte]
+
+func (p structParserFunc) Parse(input []byte) gomme.Result[[]byte, []byte] {
+	return p(input)
+}
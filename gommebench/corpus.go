@@ -0,0 +1,90 @@
+// Package gommebench provides standard corpora, reference implementations, and
+// helpers to benchmark parsers built with gomme, and to detect throughput and
+// allocation regressions across changes to the combinators they rely on.
+//
+// The corpora are generated deterministically, so that measurements taken on
+// different revisions of a parser are comparable.
+package gommebench
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Corpus is a named document, meant to be fed to the parsers being measured.
+type Corpus struct {
+	// Name identifies the corpus in measurements.
+	Name string
+
+	// Data holds the document's content.
+	Data []byte
+}
+
+// JSONCorpus produces a JSON document holding an array of the provided number
+// of objects. Each object holds strings, escaped strings, integers, floats,
+// booleans, null values, as well as nested arrays and objects.
+func JSONCorpus(objects int) Corpus {
+	var builder strings.Builder
+
+	builder.WriteString("[\n")
+	for idx := 0; idx < objects; idx++ {
+		if idx > 0 {
+			builder.WriteString(",\n")
+		}
+
+		fmt.Fprintf(&builder,
+			`  {"id": %d, "name": "user %d", "bio": "line \"%d\"\nnext", "score": %d.%02d, `+
+				`"ratio": -%de-%d, "active": %t, "manager": null, "tags": ["a%d", "b%d"], `+
+				`"address": {"street": "%d Main St", "zip": "%05d"}}`,
+			idx, idx, idx, idx%1000, idx%100, idx%9+1, idx%5, idx%2 == 0, idx%7, idx%3, idx, idx*37%100000,
+		)
+	}
+	builder.WriteString("\n]\n")
+
+	return Corpus{Name: fmt.Sprintf("json/%d", objects), Data: []byte(builder.String())}
+}
+
+// RESPCorpus produces a stream of the provided number of Redis serialization
+// protocol messages, cycling through simple strings, errors, integers, bulk
+// strings, and arrays of bulk strings.
+func RESPCorpus(messages int) Corpus {
+	var builder strings.Builder
+
+	for idx := 0; idx < messages; idx++ {
+		switch idx % 5 {
+		case 0:
+			builder.WriteString("+OK\r\n")
+		case 1:
+			fmt.Fprintf(&builder, "-ERR unknown key %d\r\n", idx)
+		case 2:
+			fmt.Fprintf(&builder, ":%d\r\n", idx*7919)
+		case 3:
+			value := fmt.Sprintf("value %d", idx)
+			fmt.Fprintf(&builder, "$%d\r\n%s\r\n", len(value), value)
+		case 4:
+			key := fmt.Sprintf("key:%d", idx)
+			fmt.Fprintf(&builder, "*3\r\n$3\r\nSET\r\n$%d\r\n%s\r\n$%d\r\n%s\r\n", len(key), key, len(key), key)
+		}
+	}
+
+	return Corpus{Name: fmt.Sprintf("resp/%d", messages), Data: []byte(builder.String())}
+}
+
+// CSVCorpus produces a CSV file holding the provided number of rows, each made
+// of the provided number of unquoted fields, and terminated by a line feed.
+func CSVCorpus(rows, columns int) Corpus {
+	var builder strings.Builder
+
+	for row := 0; row < rows; row++ {
+		for column := 0; column < columns; column++ {
+			if column > 0 {
+				builder.WriteByte(',')
+			}
+
+			fmt.Fprintf(&builder, "r%dc%d", row, column)
+		}
+		builder.WriteByte('\n')
+	}
+
+	return Corpus{Name: fmt.Sprintf("csv/%dx%d", rows, columns), Data: []byte(builder.String())}
+}
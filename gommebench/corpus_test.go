@@ -0,0 +1,49 @@
+package gommebench
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONCorpus(t *testing.T) {
+	t.Parallel()
+
+	corpus := JSONCorpus(10)
+
+	value, err := DecodeJSON(corpus.Data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "json/10", corpus.Name)
+	assert.Len(t, value, 10)
+	assert.Equal(t, JSONCorpus(10), corpus, "corpora should be deterministic")
+}
+
+func TestRESPCorpus(t *testing.T) {
+	t.Parallel()
+
+	corpus := RESPCorpus(12)
+
+	count, err := ScanRESP(corpus.Data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "resp/12", corpus.Name)
+	assert.Equal(t, 12, count)
+	assert.Equal(t, RESPCorpus(12), corpus, "corpora should be deterministic")
+}
+
+func TestCSVCorpus(t *testing.T) {
+	t.Parallel()
+
+	corpus := CSVCorpus(3, 4)
+
+	rows, err := ScanCSV(corpus.Data)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "csv/3x4", corpus.Name)
+	assert.Len(t, rows, 3)
+	for _, row := range rows {
+		assert.Len(t, row, 4)
+	}
+	assert.Equal(t, "r2c3", string(rows[2][3]))
+}
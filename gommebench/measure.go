@@ -0,0 +1,146 @@
+package gommebench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+)
+
+// Measurement holds the performance of a parse function over a corpus, as
+// measured by Measure.
+type Measurement struct {
+	// Name identifies the measurement, and is used to match measurements of
+	// different revisions together.
+	Name string `json:"name"`
+
+	// NsPerOp holds the time, in nanoseconds, it takes to parse the corpus.
+	NsPerOp int64 `json:"ns_per_op"`
+
+	// AllocsPerOp holds the number of allocations parsing the corpus requires.
+	AllocsPerOp int64 `json:"allocs_per_op"`
+
+	// BytesPerOp holds the number of bytes parsing the corpus allocates.
+	BytesPerOp int64 `json:"bytes_per_op"`
+
+	// MBPerSec holds the throughput, in megabytes per second, of the parse
+	// function.
+	MBPerSec float64 `json:"mb_per_sec"`
+}
+
+// Measure benchmarks the provided parse function over the corpus, using
+// testing.Benchmark, and returns the resulting measurement. The corpus is parsed
+// once beforehand, to ensure the parse function accepts it: if it returns an
+// error, so does Measure.
+func Measure(name string, corpus Corpus, parse func(data []byte) error) (Measurement, error) {
+	if err := parse(corpus.Data); err != nil {
+		return Measurement{}, fmt.Errorf("gommebench: parsing corpus %s: %w", corpus.Name, err)
+	}
+
+	result := testing.Benchmark(func(b *testing.B) {
+		b.SetBytes(int64(len(corpus.Data)))
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for i := 0; i < b.N; i++ {
+			_ = parse(corpus.Data)
+		}
+	})
+
+	measurement := Measurement{
+		Name:        name,
+		NsPerOp:     result.NsPerOp(),
+		AllocsPerOp: result.AllocsPerOp(),
+		BytesPerOp:  result.AllocedBytesPerOp(),
+	}
+
+	if result.T > 0 {
+		measurement.MBPerSec = float64(result.Bytes) * float64(result.N) / 1e6 / result.T.Seconds()
+	}
+
+	return measurement, nil
+}
+
+// Thresholds describe by how much a measurement may worsen, compared to its
+// baseline, before Compare reports it as a regression.
+type Thresholds struct {
+	// Time is the tolerated relative increase of the time per operation;
+	// 0.1 tolerates measurements up to 10% slower than their baseline.
+	Time float64
+
+	// Allocs is the tolerated increase of the number of allocations per
+	// operation.
+	Allocs int64
+}
+
+// Regression describes a measurement which worsened beyond the tolerated
+// thresholds, compared to its baseline.
+type Regression struct {
+	Baseline Measurement
+	Current  Measurement
+
+	// Reason describes how the measurement worsened.
+	Reason string
+}
+
+// String returns a human readable description of the regression.
+func (r Regression) String() string {
+	return fmt.Sprintf("%s: %s", r.Current.Name, r.Reason)
+}
+
+// Compare matches the current measurements with the baseline ones sharing their
+// name, and returns the regressions exceeding the provided thresholds, in the
+// order of the current measurements. Measurements without a baseline are
+// ignored.
+func Compare(baseline, current []Measurement, thresholds Thresholds) []Regression {
+	baselines := make(map[string]Measurement, len(baseline))
+	for _, measurement := range baseline {
+		baselines[measurement.Name] = measurement
+	}
+
+	var regressions []Regression
+	for _, measurement := range current {
+		base, ok := baselines[measurement.Name]
+		if !ok {
+			continue
+		}
+
+		if float64(measurement.NsPerOp) > float64(base.NsPerOp)*(1+thresholds.Time) {
+			regressions = append(regressions, Regression{
+				Baseline: base,
+				Current:  measurement,
+				Reason:   fmt.Sprintf("time per operation went from %dns to %dns", base.NsPerOp, measurement.NsPerOp),
+			})
+		}
+
+		if measurement.AllocsPerOp > base.AllocsPerOp+thresholds.Allocs {
+			regressions = append(regressions, Regression{
+				Baseline: base,
+				Current:  measurement,
+				Reason:   fmt.Sprintf("allocations per operation went from %d to %d", base.AllocsPerOp, measurement.AllocsPerOp),
+			})
+		}
+	}
+
+	return regressions
+}
+
+// WriteMeasurements encodes the provided measurements as JSON to w, so that
+// they can later be used as a baseline.
+func WriteMeasurements(w io.Writer, measurements []Measurement) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(measurements)
+}
+
+// ReadMeasurements decodes the JSON encoded measurements found in r, as
+// written by WriteMeasurements.
+func ReadMeasurements(r io.Reader) ([]Measurement, error) {
+	var measurements []Measurement
+	if err := json.NewDecoder(r).Decode(&measurements); err != nil {
+		return nil, fmt.Errorf("gommebench: reading measurements: %w", err)
+	}
+
+	return measurements, nil
+}
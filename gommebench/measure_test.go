@@ -0,0 +1,109 @@
+package gommebench
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeasure(t *testing.T) {
+	t.Parallel()
+
+	corpus := CSVCorpus(10, 3)
+
+	measurement, err := Measure("csv/baseline", corpus, func(data []byte) error {
+		_, err := ScanCSV(data)
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "csv/baseline", measurement.Name)
+	assert.Greater(t, measurement.NsPerOp, int64(0))
+	assert.Greater(t, measurement.AllocsPerOp, int64(0))
+	assert.Greater(t, measurement.MBPerSec, float64(0))
+}
+
+func TestMeasureRejectedCorpus(t *testing.T) {
+	t.Parallel()
+
+	errRejected := errors.New("rejected")
+
+	_, err := Measure("rejected", CSVCorpus(1, 1), func([]byte) error { return errRejected })
+
+	assert.ErrorIs(t, err, errRejected)
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	baseline := []Measurement{
+		{Name: "a", NsPerOp: 100, AllocsPerOp: 2},
+		{Name: "b", NsPerOp: 100, AllocsPerOp: 2},
+	}
+
+	testCases := []struct {
+		name        string
+		current     []Measurement
+		thresholds  Thresholds
+		wantReasons []string
+	}{
+		{
+			name:        "measurements within thresholds should not regress",
+			current:     []Measurement{{Name: "a", NsPerOp: 110, AllocsPerOp: 3}, {Name: "b", NsPerOp: 50, AllocsPerOp: 0}},
+			thresholds:  Thresholds{Time: 0.1, Allocs: 1},
+			wantReasons: nil,
+		},
+		{
+			name:        "slower measurements should regress",
+			current:     []Measurement{{Name: "a", NsPerOp: 111, AllocsPerOp: 2}},
+			thresholds:  Thresholds{Time: 0.1},
+			wantReasons: []string{"a: time per operation went from 100ns to 111ns"},
+		},
+		{
+			name:        "allocating measurements should regress",
+			current:     []Measurement{{Name: "b", NsPerOp: 100, AllocsPerOp: 3}},
+			thresholds:  Thresholds{},
+			wantReasons: []string{"b: allocations per operation went from 2 to 3"},
+		},
+		{
+			name:        "measurements without baseline should be ignored",
+			current:     []Measurement{{Name: "c", NsPerOp: 1000, AllocsPerOp: 10}},
+			thresholds:  Thresholds{},
+			wantReasons: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotReasons []string
+			for _, regression := range Compare(baseline, tc.current, tc.thresholds) {
+				gotReasons = append(gotReasons, regression.String())
+			}
+
+			assert.Equal(t, tc.wantReasons, gotReasons)
+		})
+	}
+}
+
+func TestWriteReadMeasurements(t *testing.T) {
+	t.Parallel()
+
+	measurements := []Measurement{
+		{Name: "a", NsPerOp: 100, AllocsPerOp: 2, BytesPerOp: 64, MBPerSec: 12.5},
+		{Name: "b", NsPerOp: 200},
+	}
+
+	var buffer bytes.Buffer
+	assert.NoError(t, WriteMeasurements(&buffer, measurements))
+
+	got, err := ReadMeasurements(&buffer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, measurements, got)
+}
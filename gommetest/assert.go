@@ -0,0 +1,81 @@
+package gommetest
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/oleiade/gomme"
+)
+
+// AssertSuccess reports an error to t unless the provided result is a success,
+// whose output equals the expected one, and whose remaining input satisfies the
+// provided matchers. It returns true if the assertion holds.
+func AssertSuccess[I gomme.Bytes, O any](t testing.TB, result gomme.Result[O, I], wantOutput O, remaining ...Matcher[I]) bool {
+	t.Helper()
+
+	if result.Err != nil {
+		t.Errorf("got error %v, want success", result.Err)
+		return false
+	}
+
+	ok := true
+	if !objectsAreEqual(result.Output, wantOutput) {
+		t.Errorf("got output %#v, want output %#v", result.Output, wantOutput)
+		ok = false
+	}
+
+	return AssertRemaining(t, result, remaining...) && ok
+}
+
+// AssertFailure reports an error to t unless the provided result is a failure,
+// whose error, as reported by Result.Report, satisfies the provided matchers. It
+// returns true if the assertion holds.
+func AssertFailure[I gomme.Bytes, O any](t testing.TB, result gomme.Result[O, I], matchers ...Matcher[*gomme.Error[I]]) bool {
+	t.Helper()
+
+	if result.Err == nil {
+		t.Errorf("got output %#v, want error", result.Output)
+		return false
+	}
+
+	reported := result.Report()
+	ok := true
+	for _, match := range matchers {
+		if err := match(reported); err != nil {
+			t.Errorf("got error %v, %v", reported, err)
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+// AssertRemaining reports an error to t unless the remaining input of the provided
+// result satisfies the provided matchers. It returns true if the assertion holds.
+func AssertRemaining[I gomme.Bytes, O any](t testing.TB, result gomme.Result[O, I], matchers ...Matcher[I]) bool {
+	t.Helper()
+
+	ok := true
+	for _, match := range matchers {
+		if err := match(result.Remaining); err != nil {
+			t.Errorf("got remaining %q, %v", result.Remaining, err)
+			ok = false
+		}
+	}
+
+	return ok
+}
+
+// objectsAreEqual returns true if the provided values are deeply equal. Like in
+// testify's assertions, byte slices are compared by their contents, so that nil
+// and empty slices are equal.
+func objectsAreEqual(got, want any) bool {
+	gotBytes, gotOK := got.([]byte)
+	wantBytes, wantOK := want.([]byte)
+	if gotOK && wantOK {
+		return bytes.Equal(gotBytes, wantBytes)
+	}
+
+	return reflect.DeepEqual(got, want)
+}
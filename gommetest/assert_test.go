@@ -0,0 +1,58 @@
+package gommetest
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/oleiade/gomme"
+)
+
+func TestAssertSuccess(t *testing.T) {
+	t.Parallel()
+
+	parser := gomme.Int64[string]()
+
+	AssertSuccess(t, parser("42;"), 42, Equals(";"))
+
+	r := &recorder{}
+	if AssertSuccess(r, parser("42;"), 41, IsEmpty[string]()) || len(r.errors) != 2 {
+		t.Errorf("got errors %q, want output and remaining errors", r.errors)
+	}
+
+	r = &recorder{}
+	if AssertSuccess(r, parser("abc"), 0) || len(r.errors) != 1 {
+		t.Errorf("got errors %q, want a single error", r.errors)
+	}
+}
+
+func TestAssertFailure(t *testing.T) {
+	t.Parallel()
+
+	parser := gomme.Int8[string]()
+
+	AssertFailure(t, parser("300"), IsFatal[string](), Wraps[string](strconv.ErrRange), Expects[string]("Int8"))
+	AssertFailure(t, parser("abc"), IsNotFatal[string](), FailsAt("abc"))
+
+	r := &recorder{}
+	if AssertFailure(r, parser("12"), IsFatal[string]()) || len(r.errors) != 1 {
+		t.Errorf("got errors %q, want a single error", r.errors)
+	}
+
+	r = &recorder{}
+	if AssertFailure(r, parser("abc"), IsFatal[string](), Expects[string]("Int16")) || len(r.errors) != 2 {
+		t.Errorf("got errors %q, want two errors", r.errors)
+	}
+}
+
+func TestAssertRemaining(t *testing.T) {
+	t.Parallel()
+
+	result := gomme.Alpha1[[]byte]()([]byte("abc123"))
+
+	AssertRemaining(t, result, HasPrefix[[]byte]("12"), HasLen[[]byte](3), Equals([]byte("123")))
+
+	r := &recorder{}
+	if AssertRemaining(r, result, IsEmpty[[]byte](), HasPrefix[[]byte]("abc")) || len(r.errors) != 2 {
+		t.Errorf("got errors %q, want two errors", r.errors)
+	}
+}
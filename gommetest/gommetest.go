@@ -0,0 +1,114 @@
+// Package gommetest provides helpers to test parsers built with gomme: a runner
+// for tables of parser test cases, assertions on the results parsers produce, and
+// matchers describing the expected errors and remaining inputs.
+//
+// A typical table test reads:
+//
+//	gommetest.ParserTest[string, int64]{
+//		Parser: gomme.Int64[string](),
+//		Cases: []gommetest.Case[string, int64]{
+//			{Name: "parsing a number should succeed", Input: "42;", WantOutput: 42, WantRemaining: ";"},
+//			{Name: "parsing a word should fail", Input: "abc", WantErr: true, WantRemaining: "abc"},
+//		},
+//	}.Run(t)
+package gommetest
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+)
+
+// Case describes a parser test case: the input fed to the parser, and the result
+// it is expected to produce.
+type Case[I gomme.Bytes, O any] struct {
+	// Name is the name of the subtest running the case.
+	Name string
+
+	// Parser is the parser under test. It defaults to the ParserTest's.
+	Parser gomme.Parser[I, O]
+
+	// Input is the input fed to the parser.
+	Input I
+
+	// WantErr is true if the parser is expected to fail.
+	WantErr bool
+
+	// WantOutput and WantRemaining are the output and remaining input the parser
+	// is expected to produce, whether it succeeds or fails.
+	WantOutput    O
+	WantRemaining I
+
+	// WantError holds matchers the error of a failing parser, as reported by
+	// Result.Report, is expected to satisfy, such as IsFatal or Expects.
+	WantError []Matcher[*gomme.Error[I]]
+}
+
+// ParserTest is a table of parser test cases.
+type ParserTest[I gomme.Bytes, O any] struct {
+	// Parser is the parser under test, unless a case provides its own.
+	Parser gomme.Parser[I, O]
+
+	// Cases are the test cases, run in order.
+	Cases []Case[I, O]
+
+	// Equal compares the outputs the parser produced to the expected ones. It
+	// defaults to a deep equality check.
+	Equal func(got, want O) bool
+}
+
+// Run runs each of the table's cases as a parallel subtest of t, checking the
+// parser produced the expected error, output, and remaining input.
+func (pt ParserTest[I, O]) Run(t *testing.T) {
+	t.Helper()
+
+	for _, tc := range pt.Cases {
+		tc := tc
+
+		parser := tc.Parser
+		if parser == nil {
+			parser = pt.Parser
+		}
+
+		if parser == nil {
+			t.Fatalf("case %q: no parser to test", tc.Name)
+		}
+
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			pt.check(t, tc, parser(tc.Input))
+		})
+	}
+}
+
+// check reports the differences between the provided result and the one the
+// case expects.
+func (pt ParserTest[I, O]) check(t testing.TB, tc Case[I, O], gotResult gomme.Result[O, I]) {
+	t.Helper()
+
+	if (gotResult.Err != nil) != tc.WantErr {
+		t.Errorf("got error %v, want error %v", gotResult.Err, tc.WantErr)
+	}
+
+	if reported := gotResult.Report(); reported != nil {
+		for _, match := range tc.WantError {
+			if err := match(reported); err != nil {
+				t.Errorf("got error %v, %v", reported, err)
+			}
+		}
+	}
+
+	equal := pt.Equal
+	if equal == nil {
+		equal = func(got, want O) bool { return objectsAreEqual(got, want) }
+	}
+
+	if !equal(gotResult.Output, tc.WantOutput) {
+		t.Errorf("got output %#v, want output %#v", gotResult.Output, tc.WantOutput)
+	}
+
+	if !objectsAreEqual(gotResult.Remaining, tc.WantRemaining) {
+		t.Errorf("got remaining %q, want remaining %q", gotResult.Remaining, tc.WantRemaining)
+	}
+}
@@ -0,0 +1,123 @@
+package gommetest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oleiade/gomme"
+)
+
+// recorder is a testing.TB recording the errors reported to it, which allows to
+// test the assertions' failures.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestParserTestRun(t *testing.T) {
+	t.Parallel()
+
+	ParserTest[string, int64]{
+		Parser: gomme.Int64[string](),
+		Cases: []Case[string, int64]{
+			{
+				Name:          "parsing a number should succeed",
+				Input:         "42;",
+				WantErr:       false,
+				WantOutput:    42,
+				WantRemaining: ";",
+			},
+			{
+				Name:          "parsing a word should fail",
+				Input:         "abc",
+				WantErr:       true,
+				WantOutput:    0,
+				WantRemaining: "abc",
+				WantError:     []Matcher[*gomme.Error[string]]{IsNotFatal[string](), Expects[string]("Int64")},
+			},
+			{
+				Name:          "case parser should override the table's",
+				Parser:        gomme.Map(gomme.Digit1[string](), func(string) (int64, error) { return 1, nil }),
+				Input:         "123",
+				WantErr:       false,
+				WantOutput:    1,
+				WantRemaining: "",
+			},
+		},
+	}.Run(t)
+}
+
+func TestParserTestCheck(t *testing.T) {
+	t.Parallel()
+
+	table := ParserTest[[]byte, []byte]{Parser: gomme.Digit1[[]byte]()}
+
+	testCases := []struct {
+		name       string
+		tc         Case[[]byte, []byte]
+		wantErrors int
+	}{
+		{
+			name:       "matching case should report nothing",
+			tc:         Case[[]byte, []byte]{Input: []byte("12"), WantOutput: []byte("12"), WantRemaining: nil},
+			wantErrors: 0,
+		},
+		{
+			name:       "unexpected success should be reported",
+			tc:         Case[[]byte, []byte]{Input: []byte("12"), WantErr: true, WantOutput: []byte("12")},
+			wantErrors: 1,
+		},
+		{
+			name:       "mismatching output and remaining should be reported",
+			tc:         Case[[]byte, []byte]{Input: []byte("12a"), WantOutput: []byte("1"), WantRemaining: []byte("2a")},
+			wantErrors: 2,
+		},
+		{
+			name: "mismatching error should be reported",
+			tc: Case[[]byte, []byte]{
+				Input:         []byte("a"),
+				WantErr:       true,
+				WantRemaining: []byte("a"),
+				WantError:     []Matcher[*gomme.Error[[]byte]]{IsFatal[[]byte](), Expects[[]byte]("Digit1")},
+			},
+			wantErrors: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &recorder{}
+			table.check(r, tc.tc, table.Parser(tc.tc.Input))
+			if len(r.errors) != tc.wantErrors {
+				t.Errorf("got errors %q, want %d errors", r.errors, tc.wantErrors)
+			}
+		})
+	}
+}
+
+func TestParserTestCustomEqual(t *testing.T) {
+	t.Parallel()
+
+	table := ParserTest[string, float64]{
+		Parser: gomme.Float64[string](),
+		Equal: func(got, want float64) bool {
+			return got-want < 1e-9 && want-got < 1e-9
+		},
+	}
+
+	r := &recorder{}
+	table.check(r, Case[string, float64]{Input: "0.30000000000000004", WantOutput: 0.3}, table.Parser("0.30000000000000004"))
+	if len(r.errors) != 0 {
+		t.Errorf("got errors %q, want none", r.errors)
+	}
+}
@@ -0,0 +1,124 @@
+package gommetest
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/oleiade/gomme"
+)
+
+// Matcher checks a value, such as a parser's error or remaining input, and returns
+// an error describing how the value differs from the expected one, if it does.
+type Matcher[T any] func(value T) error
+
+// Equals produces a matcher checking values are deeply equal to the provided one.
+// Byte slices are compared by their contents.
+func Equals[T any](want T) Matcher[T] {
+	return func(value T) error {
+		if !objectsAreEqual(value, want) {
+			return fmt.Errorf("want %#v", want)
+		}
+
+		return nil
+	}
+}
+
+// IsFatal produces a matcher checking errors are fatal.
+func IsFatal[I gomme.Bytes]() Matcher[*gomme.Error[I]] {
+	return func(err *gomme.Error[I]) error {
+		if !err.IsFatal() {
+			return errors.New("want fatal error")
+		}
+
+		return nil
+	}
+}
+
+// IsNotFatal produces a matcher checking errors aren't fatal, which lets the
+// parsers trying alternatives backtrack.
+func IsNotFatal[I gomme.Bytes]() Matcher[*gomme.Error[I]] {
+	return func(err *gomme.Error[I]) error {
+		if err.IsFatal() {
+			return errors.New("want non-fatal error")
+		}
+
+		return nil
+	}
+}
+
+// Expects produces a matcher checking errors report expecting exactly the provided
+// names, in order.
+func Expects[I gomme.Bytes](names ...string) Matcher[*gomme.Error[I]] {
+	return func(err *gomme.Error[I]) error {
+		if len(err.Expected) != len(names) {
+			return fmt.Errorf("want expected %q", names)
+		}
+
+		for idx, name := range names {
+			if err.Expected[idx] != name {
+				return fmt.Errorf("want expected %q", names)
+			}
+		}
+
+		return nil
+	}
+}
+
+// FailsAt produces a matcher checking errors point at the provided input, which
+// is the part of the input the failing parser couldn't match.
+func FailsAt[I gomme.Bytes](input I) Matcher[*gomme.Error[I]] {
+	return func(err *gomme.Error[I]) error {
+		if string(err.Input) != string(input) {
+			return fmt.Errorf("want failure at %q", input)
+		}
+
+		return nil
+	}
+}
+
+// Wraps produces a matcher checking errors wrap the provided target, as reported
+// by errors.Is. Only fatal errors wrap other errors.
+func Wraps[I gomme.Bytes](target error) Matcher[*gomme.Error[I]] {
+	return func(err *gomme.Error[I]) error {
+		if !errors.Is(err, target) {
+			return fmt.Errorf("want error wrapping %v", target)
+		}
+
+		return nil
+	}
+}
+
+// IsEmpty produces a matcher checking inputs are empty, such as the remaining
+// input of a parser which consumed all of its input.
+func IsEmpty[I gomme.Bytes]() Matcher[I] {
+	return func(input I) error {
+		if len(input) != 0 {
+			return errors.New("want empty input")
+		}
+
+		return nil
+	}
+}
+
+// HasPrefix produces a matcher checking inputs start with the provided prefix.
+func HasPrefix[I gomme.Bytes](prefix string) Matcher[I] {
+	return func(input I) error {
+		if !strings.HasPrefix(string(input), prefix) {
+			return fmt.Errorf("want input starting with %q", prefix)
+		}
+
+		return nil
+	}
+}
+
+// HasLen produces a matcher checking inputs are the provided number of bytes long.
+func HasLen[I gomme.Bytes](length int) Matcher[I] {
+	return func(input I) error {
+		if len(input) != length {
+			return fmt.Errorf("want input of length %d", length)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,90 @@
+package gommetest
+
+import (
+	"io"
+	"testing"
+
+	"github.com/oleiade/gomme"
+)
+
+func TestErrorMatchers(t *testing.T) {
+	t.Parallel()
+
+	nonFatal := gomme.NewError("abc", "Digit1")
+	fatal := &gomme.Error[string]{Input: "abc", Err: io.ErrUnexpectedEOF, Expected: []string{"Take", "Digit1"}}
+
+	testCases := []struct {
+		name    string
+		matcher Matcher[*gomme.Error[string]]
+		err     *gomme.Error[string]
+		wantErr bool
+	}{
+		{name: "IsFatal should match fatal errors", matcher: IsFatal[string](), err: fatal, wantErr: false},
+		{name: "IsFatal should not match non-fatal errors", matcher: IsFatal[string](), err: nonFatal, wantErr: true},
+		{name: "IsNotFatal should match non-fatal errors", matcher: IsNotFatal[string](), err: nonFatal, wantErr: false},
+		{name: "IsNotFatal should not match fatal errors", matcher: IsNotFatal[string](), err: fatal, wantErr: true},
+		{name: "Expects should match the expected names", matcher: Expects[string]("Take", "Digit1"), err: fatal, wantErr: false},
+		{name: "Expects should not match other names", matcher: Expects[string]("Digit1", "Take"), err: fatal, wantErr: true},
+		{name: "Expects should not match fewer names", matcher: Expects[string]("Take"), err: fatal, wantErr: true},
+		{name: "FailsAt should match the error's input", matcher: FailsAt("abc"), err: nonFatal, wantErr: false},
+		{name: "FailsAt should not match other inputs", matcher: FailsAt("bc"), err: nonFatal, wantErr: true},
+		{name: "Wraps should match wrapped errors", matcher: Wraps[string](io.ErrUnexpectedEOF), err: fatal, wantErr: false},
+		{name: "Wraps should not match other errors", matcher: Wraps[string](io.EOF), err: fatal, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tc.matcher(tc.err); (err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestInputMatchers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		matcher Matcher[[]byte]
+		input   []byte
+		wantErr bool
+	}{
+		{name: "IsEmpty should match nil inputs", matcher: IsEmpty[[]byte](), input: nil, wantErr: false},
+		{name: "IsEmpty should not match non-empty inputs", matcher: IsEmpty[[]byte](), input: []byte("a"), wantErr: true},
+		{name: "HasPrefix should match prefixed inputs", matcher: HasPrefix[[]byte]("ab"), input: []byte("abc"), wantErr: false},
+		{name: "HasPrefix should not match other inputs", matcher: HasPrefix[[]byte]("b"), input: []byte("abc"), wantErr: true},
+		{name: "HasLen should match inputs of the length", matcher: HasLen[[]byte](3), input: []byte("abc"), wantErr: false},
+		{name: "HasLen should not match other lengths", matcher: HasLen[[]byte](2), input: []byte("abc"), wantErr: true},
+		{name: "Equals should consider nil and empty inputs equal", matcher: Equals([]byte{}), input: nil, wantErr: false},
+		{name: "Equals should not match other inputs", matcher: Equals([]byte("ab")), input: []byte("abc"), wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tc.matcher(tc.input); (err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestEqualsDeepComparison(t *testing.T) {
+	t.Parallel()
+
+	if err := Equals([]any{"a", []byte("b")})([]any{"a", []byte("b")}); err != nil {
+		t.Error(err)
+	}
+
+	if err := Equals(map[string]int{"a": 1})(map[string]int{"a": 2}); err == nil {
+		t.Error("got no error, want error")
+	}
+}
@@ -0,0 +1,91 @@
+package gommetest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/oleiade/gomme"
+)
+
+// RoundTripTest checks a parser and the printer producing its inputs agree with
+// each other: that parsing the printed form of a value produces the value back,
+// consuming the whole printed form. It catches the asymmetries between a grammar
+// and its serializer, such as a field the printer escapes but the parser doesn't
+// unescape.
+type RoundTripTest[I gomme.Bytes, O any] struct {
+	// Parser is the parser under test.
+	Parser gomme.Parser[I, O]
+
+	// Print produces the input the parser is expected to parse into the provided
+	// value.
+	Print func(value O) I
+
+	// Generate produces the random values the test is run against, out of the
+	// provided source of randomness.
+	Generate func(random *rand.Rand) O
+
+	// Values are values the test is run against, before the generated ones, such
+	// as edge cases generated values are unlikely to cover.
+	Values []O
+
+	// Count is the number of generated values. It defaults to 100.
+	Count int
+
+	// Seed seeds the source of randomness passed to Generate, so that a given
+	// seed always produces the same values.
+	Seed int64
+
+	// Equal compares the parsed values to the printed ones. It defaults to a
+	// deep equality check.
+	Equal func(got, want O) bool
+}
+
+// Run checks each of the provided and generated values round-trips, reporting
+// the first one which doesn't to t, along with its printed form and the seed
+// it was generated from. It returns true if all of the values round-trip.
+func (rt RoundTripTest[I, O]) Run(t testing.TB) bool {
+	t.Helper()
+
+	count := rt.Count
+	if count == 0 {
+		count = 100
+	}
+
+	if rt.Generate == nil {
+		count = 0
+	}
+
+	equal := rt.Equal
+	if equal == nil {
+		equal = func(got, want O) bool { return objectsAreEqual(got, want) }
+	}
+
+	random := rand.New(rand.NewSource(rt.Seed)) //nolint:gosec // values needn't be unpredictable.
+
+	for idx := 0; idx < len(rt.Values)+count; idx++ {
+		var value O
+		if idx < len(rt.Values) {
+			value = rt.Values[idx]
+		} else {
+			value = rt.Generate(random)
+		}
+
+		printed := rt.Print(value)
+		result := rt.Parser(printed)
+
+		switch {
+		case result.Err != nil:
+			t.Errorf("value %d (seed %d): %#v printed as %q: got error %v", idx, rt.Seed, value, printed, result.Err)
+		case len(result.Remaining) > 0:
+			t.Errorf("value %d (seed %d): %#v printed as %q: got remaining %q", idx, rt.Seed, value, printed, result.Remaining)
+		case !equal(result.Output, value):
+			t.Errorf("value %d (seed %d): %#v printed as %q: got output %#v", idx, rt.Seed, value, printed, result.Output)
+		default:
+			continue
+		}
+
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,90 @@
+package gommetest
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/oleiade/gomme"
+)
+
+func TestRoundTripTest(t *testing.T) {
+	t.Parallel()
+
+	RoundTripTest[string, int64]{
+		Parser:   gomme.Int64[string](),
+		Print:    func(value int64) string { return strconv.FormatInt(value, 10) },
+		Generate: func(random *rand.Rand) int64 { return random.Int63() - random.Int63() },
+		Values:   []int64{0, -1, 1<<63 - 1, -1 << 63},
+	}.Run(t)
+
+	RoundTripTest[[]byte, string]{
+		Parser: gomme.QuotedString[[]byte](),
+		Print:  func(value string) []byte { return []byte(strconv.Quote(value)) },
+		Values: []string{"", "a\"b", "tab\tand\nnewline", "é"},
+		Count:  10,
+	}.Run(t)
+}
+
+func TestRoundTripTestReportsAsymmetries(t *testing.T) {
+	t.Parallel()
+
+	// The printer doesn't escape quotes, which the parser expects to be.
+	naive := RoundTripTest[string, string]{
+		Parser: gomme.QuotedString[string](),
+		Print:  func(value string) string { return `"` + value + `"` },
+		Generate: func(random *rand.Rand) string {
+			return strings.Repeat(`"`, random.Intn(3))
+		},
+		Count: 50,
+		Seed:  42,
+	}
+
+	r := &recorder{}
+	if naive.Run(r) || len(r.errors) != 1 {
+		t.Fatalf("got errors %q, want a single error", r.errors)
+	}
+
+	if !strings.Contains(r.errors[0], "seed 42") {
+		t.Errorf("got error %q, want it to mention the seed", r.errors[0])
+	}
+
+	// The printer pads numbers, which the parser leaves in the remaining input.
+	padded := RoundTripTest[string, int64]{
+		Parser: gomme.Int64[string](),
+		Print:  func(value int64) string { return strconv.FormatInt(value, 10) + " " },
+		Values: []int64{1},
+	}
+
+	r = &recorder{}
+	if padded.Run(r) || len(r.errors) != 1 {
+		t.Errorf("got errors %q, want a single error", r.errors)
+	}
+
+	// The parser and printer disagree on the value.
+	shifted := RoundTripTest[string, int64]{
+		Parser: gomme.Int64[string](),
+		Print:  func(value int64) string { return strconv.FormatInt(value+1, 10) },
+		Values: []int64{1},
+	}
+
+	r = &recorder{}
+	if shifted.Run(r) || len(r.errors) != 1 {
+		t.Errorf("got errors %q, want a single error", r.errors)
+	}
+}
+
+func BenchmarkRoundTripTest(b *testing.B) {
+	rt := RoundTripTest[string, int64]{
+		Parser:   gomme.Int64[string](),
+		Print:    func(value int64) string { return strconv.FormatInt(value, 10) },
+		Generate: func(random *rand.Rand) int64 { return random.Int63() },
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		rt.Run(b)
+	}
+}
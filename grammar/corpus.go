@@ -0,0 +1,59 @@
+package grammar
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/oleiade/gomme"
+)
+
+// WriteCorpus writes the provided inputs, such as the ones produced by Samples,
+// to the provided directory, as the seed corpus of a native Go fuzz test, whose
+// argument is of type Input. Such corpora live in the testdata/fuzz/FuzzXxx
+// directory of the fuzz test's package. The directory is created if needed.
+//
+// Like the files written by the go command, each file is named after the hash of
+// its contents, so that writing the same input twice produces a single file.
+func WriteCorpus[Input gomme.Bytes](dir string, inputs []Input) error {
+	encode := func(input Input) string {
+		if _, ok := any(input).([]byte); ok {
+			return fmt.Sprintf("[]byte(%q)", string(input))
+		}
+
+		return fmt.Sprintf("string(%q)", string(input))
+	}
+
+	return writeCorpus(dir, inputs, func(input Input) []byte {
+		return []byte("go test fuzz v1\n" + encode(input) + "\n")
+	})
+}
+
+// WriteRawCorpus writes the provided inputs to the provided directory, each one
+// as the raw contents of a file, as expected by go-fuzz and most other fuzzing
+// engines. The directory is created if needed.
+func WriteRawCorpus[Input gomme.Bytes](dir string, inputs []Input) error {
+	return writeCorpus(dir, inputs, func(input Input) []byte {
+		return []byte(input)
+	})
+}
+
+// writeCorpus writes the provided inputs, encoded by the provided function, to
+// files of the provided directory named after the hash of their contents.
+func writeCorpus[Input gomme.Bytes](dir string, inputs []Input, encode func(Input) []byte) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("grammar: %w", err)
+	}
+
+	for _, input := range inputs {
+		data := encode(input)
+		name := fmt.Sprintf("%x", sha256.Sum256(data))[:16]
+
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			return fmt.Errorf("grammar: %w", err)
+		}
+	}
+
+	return nil
+}
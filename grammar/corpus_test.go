@@ -0,0 +1,59 @@
+package grammar
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteCorpus(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "testdata", "fuzz", "FuzzParse")
+
+	assert.NoError(t, WriteCorpus(dir, []string{"1+2", "a\"b\n", "1+2"}))
+	assert.Equal(t, []string{
+		"go test fuzz v1\nstring(\"1+2\")\n",
+		"go test fuzz v1\nstring(\"a\\\"b\\n\")\n",
+	}, readCorpus(t, dir))
+
+	bytesDir := filepath.Join(t.TempDir(), "bytes")
+	assert.NoError(t, WriteCorpus(bytesDir, [][]byte{[]byte("\xff")}))
+	assert.Equal(t, []string{"go test fuzz v1\n[]byte(\"\\xff\")\n"}, readCorpus(t, bytesDir))
+}
+
+func TestWriteRawCorpus(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	assert.NoError(t, WriteRawCorpus(dir, [][]byte{[]byte("1+2"), []byte("(3)")}))
+	assert.Equal(t, []string{"(3)", "1+2"}, readCorpus(t, dir))
+}
+
+// readCorpus returns the sorted contents of the files of the provided directory.
+func readCorpus(t *testing.T, dir string) []string {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contents := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		contents = append(contents, string(data))
+	}
+
+	sort.Strings(contents)
+
+	return contents
+}
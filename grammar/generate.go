@@ -0,0 +1,259 @@
+package grammar
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// GenerateOptions describes the Go source file produced by Generate.
+type GenerateOptions struct {
+	// Package is the name of the generated file's package.
+	Package string
+
+	// Type is the name of the generated parser type. It defaults to "Parser".
+	Type string
+
+	// Source is the name of the grammar file the code is generated from, as
+	// mentioned in the generated file's header.
+	Source string
+}
+
+// Generate writes the Go source code of a parser for the grammar to the provided
+// writer. The generated code is specific to the grammar, and to the grammar's
+// Input type: each rule, and each of the expressions it is made of, is matched by
+// a dedicated method, without going through closures or interfaces, which lets
+// the compiler inline the smallest ones.
+//
+// The generated parser type has a method named after each rule, its first letter
+// being upper cased, which parses the rule and produces the same values a parser
+// produced by the Parser method would, and a constructor accepting the actions
+// to attach to the rules, as the fields of a generated Actions struct.
+//
+// Unlike parsers produced by the Parser method, the generated ones report all
+// their failures, including their actions', naming the rule which failed.
+func (g *Grammar[Input]) Generate(w io.Writer, options GenerateOptions) error {
+	if options.Package == "" {
+		return fmt.Errorf("grammar: missing package name")
+	}
+
+	if options.Type == "" {
+		options.Type = "Parser"
+	}
+
+	gen := &generator{
+		grammar:   g.rules,
+		order:     g.order,
+		parser:    options.Type,
+		actions:   options.Type + "Actions",
+		input:     "string",
+		decodeFn:  "utf8.DecodeRuneInString",
+		rules:     make(map[string]string, len(g.rules)),
+		methodIDs: make(map[*expression]int),
+	}
+
+	var zero Input
+	if _, ok := any(zero).([]byte); ok {
+		gen.input = "[]byte"
+		gen.decodeFn = "utf8.DecodeRune"
+	}
+
+	exported := make(map[string]string, len(g.order))
+	for _, name := range g.order {
+		method := exportedName(name)
+		if other, ok := exported[method]; ok {
+			return fmt.Errorf("grammar: rules %q and %q would produce the same %s method", other, name, method)
+		}
+
+		exported[method] = name
+		gen.rules[name] = method
+	}
+
+	// The methods matching the rules' expressions are written first, as they
+	// determine which packages the generated file imports.
+	var methods bytes.Buffer
+	for _, name := range g.order {
+		gen.writeExpressionMethods(&methods, g.rules[name])
+	}
+
+	var body bytes.Buffer
+	gen.writeHeader(&body, options)
+	for _, name := range g.order {
+		gen.writeRule(&body, name)
+	}
+	body.Write(methods.Bytes())
+
+	source, err := format.Source(body.Bytes())
+	if err != nil {
+		return fmt.Errorf("grammar: formatting generated code: %w", err)
+	}
+
+	_, err = w.Write(source)
+
+	return err
+}
+
+// generator holds the state of a Generate call.
+type generator struct {
+	grammar map[string]*expression
+	order   []string
+
+	// parser and actions are the names of the generated types.
+	parser, actions string
+
+	// input is the name of the Input type, and decodeFn the name of the function
+	// decoding the first rune of such an input.
+	input, decodeFn string
+
+	// rules associates each rule's name with its exported method's name.
+	rules map[string]string
+
+	// methodIDs associates each expression with the number of the method
+	// matching it.
+	methodIDs map[*expression]int
+
+	usesUTF8 bool
+}
+
+// exportedName returns the name of the method parsing the named rule.
+func exportedName(rule string) string {
+	first, size := utf8.DecodeRuneInString(rule)
+	if first == '_' {
+		return "Rule" + rule
+	}
+
+	return string(unicode.ToUpper(first)) + rule[size:]
+}
+
+// writeHeader writes the parts of the generated file preceding the rules' methods.
+func (gen *generator) writeHeader(w *bytes.Buffer, options GenerateOptions) {
+	source := ""
+	if options.Source != "" {
+		source = " from " + options.Source
+	}
+
+	fmt.Fprintf(w, "// Code generated by gommegen%s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(w, "package %s\n\n", options.Package)
+
+	fmt.Fprintf(w, "import (\n")
+	if gen.usesUTF8 {
+		fmt.Fprintf(w, "\t\"unicode/utf8\"\n\n")
+	}
+	fmt.Fprintf(w, "\t\"github.com/oleiade/gomme\"\n)\n\n")
+
+	fmt.Fprintf(w, "// %s holds the actions producing the values of the grammar's rules. An action\n", gen.actions)
+	fmt.Fprintf(w, "// is passed the part of the input its rule matched, and the value the rule's\n")
+	fmt.Fprintf(w, "// expression produced. Rules whose action is nil produce the latter.\n")
+	fmt.Fprintf(w, "type %s struct {\n", gen.actions)
+	for _, name := range gen.order {
+		fmt.Fprintf(w, "\t%s func(text %s, value any) (any, error)\n", gen.rules[name], gen.input)
+	}
+	fmt.Fprintf(w, "}\n\n")
+
+	fmt.Fprintf(w, "// %s parses inputs following the grammar's rules.\n", gen.parser)
+	fmt.Fprintf(w, "type %s struct {\n\tactions %s\n}\n\n", gen.parser, gen.actions)
+
+	// The constructor is exported only if the parser type is.
+	constructor := "New" + exportedName(gen.parser)
+	if first, _ := utf8.DecodeRuneInString(gen.parser); !unicode.IsUpper(first) {
+		constructor = "new" + exportedName(gen.parser)
+	}
+
+	fmt.Fprintf(w, "// %s produces a %s applying the provided actions.\n", constructor, gen.parser)
+	fmt.Fprintf(w, "func %s(actions %s) *%s {\n\treturn &%s{actions: actions}\n}\n\n", constructor, gen.actions, gen.parser, gen.parser)
+}
+
+// writeRule writes the methods parsing the named rule.
+func (gen *generator) writeRule(w *bytes.Buffer, name string) {
+	method := gen.rules[name]
+	body := gen.methodIDs[gen.grammar[name]]
+
+	fmt.Fprintf(w, "// %s parses the %s rule.\n", method, name)
+	fmt.Fprintf(w, "func (p *%s) %s(input %s) gomme.Result[any, %s] {\n", gen.parser, method, gen.input, gen.input)
+	fmt.Fprintf(w, "\tvalue, remaining, ok := p.rule%s(input)\n", method)
+	fmt.Fprintf(w, "\tif !ok {\n\t\treturn gomme.Failure[%s, any](gomme.NewError(input, %q), input)\n\t}\n\n", gen.input, name)
+	fmt.Fprintf(w, "\treturn gomme.Success(value, remaining)\n}\n\n")
+
+	fmt.Fprintf(w, "func (p *%s) rule%s(input %s) (any, %s, bool) {\n", gen.parser, method, gen.input, gen.input)
+	fmt.Fprintf(w, "\tvalue, remaining, ok := p.match%d(input)\n", body)
+	fmt.Fprintf(w, "\tif !ok || p.actions.%s == nil {\n\t\treturn value, remaining, ok\n\t}\n\n", method)
+	fmt.Fprintf(w, "\tvalue, err := p.actions.%s(input[:len(input)-len(remaining)], value)\n", method)
+	fmt.Fprintf(w, "\tif err != nil {\n\t\treturn nil, input, false\n\t}\n\n")
+	fmt.Fprintf(w, "\treturn value, remaining, true\n}\n\n")
+}
+
+// writeExpressionMethods writes the methods matching the provided expression and
+// the expressions it is made of, numbering them in the order they are written.
+func (gen *generator) writeExpressionMethods(w *bytes.Buffer, e *expression) {
+	for _, child := range e.children {
+		gen.writeExpressionMethods(w, child)
+	}
+
+	id := len(gen.methodIDs)
+	gen.methodIDs[e] = id
+
+	fmt.Fprintf(w, "func (p *%s) match%d(input %s) (any, %s, bool) {\n", gen.parser, id, gen.input, gen.input)
+
+	switch e.kind {
+	case kindAlternation:
+		for _, child := range e.children {
+			fmt.Fprintf(w, "\tif value, remaining, ok := p.match%d(input); ok {\n\t\treturn value, remaining, true\n\t}\n\n", gen.methodIDs[child])
+		}
+		fmt.Fprintf(w, "\treturn nil, input, false\n")
+	case kindSequence:
+		fmt.Fprintf(w, "\tvalues := make([]any, %d)\n\tremaining := input\n\tvar ok bool\n\n", len(e.children))
+		for idx, child := range e.children {
+			fmt.Fprintf(w, "\tif values[%d], remaining, ok = p.match%d(remaining); !ok {\n\t\treturn nil, input, false\n\t}\n\n", idx, gen.methodIDs[child])
+		}
+		fmt.Fprintf(w, "\treturn values, remaining, true\n")
+	case kindToken:
+		literal := strconv.Quote(e.text)
+		prefix := fmt.Sprintf("input[:%d]", len(e.text))
+		if gen.input != "string" {
+			prefix = "string(" + prefix + ")"
+		}
+
+		fmt.Fprintf(w, "\tif len(input) < %d || %s != %s {\n\t\treturn nil, input, false\n\t}\n\n", len(e.text), prefix, literal)
+		fmt.Fprintf(w, "\treturn input[:%d], input[%d:], true\n", len(e.text), len(e.text))
+	case kindRange:
+		if e.high < utf8.RuneSelf {
+			fmt.Fprintf(w, "\tif len(input) == 0 || input[0] < %s || input[0] > %s {\n\t\treturn nil, input, false\n\t}\n\n",
+				strconv.QuoteRune(e.low), strconv.QuoteRune(e.high))
+			fmt.Fprintf(w, "\treturn input[:1], input[1:], true\n")
+
+			break
+		}
+
+		gen.usesUTF8 = true
+		fmt.Fprintf(w, "\tr, size := %s(input)\n", gen.decodeFn)
+		fmt.Fprintf(w, "\tif (r == utf8.RuneError && size <= 1) || r < %s || r > %s {\n\t\treturn nil, input, false\n\t}\n\n",
+			strconv.QuoteRune(e.low), strconv.QuoteRune(e.high))
+		fmt.Fprintf(w, "\treturn input[:size], input[size:], true\n")
+	case kindReference:
+		fmt.Fprintf(w, "\treturn p.rule%s(input)\n", gen.rules[e.text])
+	case kindOption:
+		fmt.Fprintf(w, "\tif value, remaining, ok := p.match%d(input); ok {\n\t\treturn value, remaining, true\n\t}\n\n", gen.methodIDs[e.children[0]])
+		fmt.Fprintf(w, "\treturn nil, input, true\n")
+	case kindRepetition:
+		fmt.Fprintf(w, "\tvalues := []any{}\n\tremaining := input\n\n\tfor {\n")
+		fmt.Fprintf(w, "\t\tvalue, rest, ok := p.match%d(remaining)\n", gen.methodIDs[e.children[0]])
+		fmt.Fprintf(w, "\t\tif !ok {\n\t\t\treturn values, remaining, true\n\t\t}\n\n")
+		fmt.Fprintf(w, "\t\t// Checking for infinite loops, if nothing was consumed,\n\t\t// the expression would make us go around in circles.\n")
+		fmt.Fprintf(w, "\t\tif len(rest) == len(remaining) {\n\t\t\treturn nil, input, false\n\t\t}\n\n")
+		fmt.Fprintf(w, "\t\tvalues = append(values, value)\n\t\tremaining = rest\n\t}\n")
+	case kindAnd:
+		fmt.Fprintf(w, "\tif _, _, ok := p.match%d(input); !ok {\n\t\treturn nil, input, false\n\t}\n\n", gen.methodIDs[e.children[0]])
+		fmt.Fprintf(w, "\treturn nil, input, true\n")
+	case kindNot:
+		fmt.Fprintf(w, "\tif _, _, ok := p.match%d(input); ok {\n\t\treturn nil, input, false\n\t}\n\n", gen.methodIDs[e.children[0]])
+		fmt.Fprintf(w, "\treturn nil, input, true\n")
+	default:
+		fmt.Fprintf(w, "\treturn nil, input, true\n")
+	}
+
+	fmt.Fprintf(w, "}\n\n")
+}
@@ -0,0 +1,85 @@
+package grammar
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateMatchesExample(t *testing.T) {
+	t.Parallel()
+
+	// The calc example's parser is generated by gommegen: it should be kept in
+	// sync with the generator, by running go generate.
+	text, err := os.ReadFile("../examples/calc/calc.ebnf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := os.ReadFile("../examples/calc/calc_parser.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	g, err := Parse[string](string(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got bytes.Buffer
+	if err := g.Generate(&got, GenerateOptions{Package: "calc", Type: "parser", Source: "calc.ebnf"}); err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, string(want), got.String())
+}
+
+func TestGenerateBytes(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[[]byte](`
+		word  = letter { letter } .
+		letter = "a"..."z" | "à"..."ÿ" | _digit .
+		_digit = "0"..."9" .
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var code bytes.Buffer
+	if err := g.Generate(&code, GenerateOptions{Package: "words"}); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "words.go", code.Bytes(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "words", file.Name.Name)
+	assert.Contains(t, code.String(), `"unicode/utf8"`)
+	assert.Contains(t, code.String(), "func NewParser(actions ParserActions) *Parser {")
+	assert.Contains(t, code.String(), "func (p *Parser) Word(input []byte) gomme.Result[any, []byte] {")
+	assert.Contains(t, code.String(), "func (p *Parser) Rule_digit(input []byte) gomme.Result[any, []byte] {")
+	assert.Contains(t, code.String(), "utf8.DecodeRune(input)")
+	assert.False(t, strings.Contains(code.String(), "DecodeRuneInString"))
+}
+
+func TestGenerateErrors(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[string](`rule = "a" . Rule = "b" .`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var code bytes.Buffer
+	assert.EqualError(t, g.Generate(&code, GenerateOptions{Package: "p"}), `grammar: rules "rule" and "Rule" would produce the same Rule method`)
+	assert.EqualError(t, g.Generate(&code, GenerateOptions{}), "grammar: missing package name")
+	assert.Zero(t, code.Len())
+}
@@ -0,0 +1,416 @@
+// Package grammar builds parsers at runtime, out of grammars described in an
+// EBNF notation, which allows parsing data-driven formats, or prototyping a
+// grammar before writing it using combinators.
+//
+// A grammar is a list of rules, each of which is terminated by a '.' or a ';'.
+// Rules are described using the following notation, itself described using the
+// notation:
+//
+//	Grammar     = { Rule } .
+//	Rule        = name "=" [ Expression ] ( "." | ";" ) .
+//	Expression  = Alternative { "|" Alternative } .
+//	Alternative = Term { Term } .
+//	Term        = [ "&" | "!" ] Primary .
+//	Primary     = name | token [ ( "…" | "..." ) token ] | Group | Option | Repetition .
+//	Group       = "(" Expression ")" .
+//	Option      = "[" Expression "]" .
+//	Repetition  = "{" Expression "}" .
+//
+// Names are made of letters, digits, and underscores, and refer to rules. Tokens
+// are double or single quoted literals, in which backslash escape sequences are
+// recognized, and match themselves. Two single character tokens separated by an
+// ellipsis match any character in the range they delimit. Whitespace and "//"
+// comments are allowed between the elements of a rule.
+//
+// Grammars are interpreted as parsing expression grammars (PEG): alternatives
+// are tried in order, and the first one to match is used; options and
+// repetitions match as much of the input as they can, and never backtrack.
+// A term prefixed with '&' matches if its primary matches, and one prefixed
+// with '!' if it doesn't, without consuming any input in both cases. Rules
+// can refer to themselves, but not before consuming some input: left
+// recursive rules are rejected. Whitespace is never skipped implicitly.
+//
+// Each rule produces a value: the matched text for tokens and ranges, a []any
+// slice holding the values of their elements for sequences and repetitions,
+// the value of the matched alternative for alternations, the value of their
+// expression or nil for options, and nil for predicates. Actions can be attached
+// to rules to produce values of their own, such as syntax tree nodes.
+package grammar
+
+import (
+	"fmt"
+
+	"github.com/oleiade/gomme"
+)
+
+// Action produces the value of a rule, out of the part of the input the rule
+// matched, and the value the rule's expression produced. If it returns an error,
+// the rule fails.
+type Action[Input gomme.Bytes] func(text Input, value any) (any, error)
+
+// Grammar holds the rules parsed out of a grammar text, and the actions attached
+// to them. Grammar values are produced by Parse, and turned into parsers using
+// their Parser method.
+type Grammar[Input gomme.Bytes] struct {
+	rules   map[string]*expression
+	order   []string
+	actions map[string]Action[Input]
+}
+
+// Parse parses the provided grammar text, following the notation described in
+// the package documentation, into a Grammar whose parsers consume inputs of type
+// Input. It returns an error if the text doesn't follow the notation, if it
+// defines a rule more than once, if it refers to an undefined rule, if a rule is
+// left recursive, or if a repetition's expression can match empty input, as
+// such a repetition would never end.
+func Parse[Input gomme.Bytes](text string) (*Grammar[Input], error) {
+	result := syntax()(text)
+	if result.Err != nil {
+		return nil, syntaxError(text, text)
+	}
+
+	if len(result.Remaining) > 0 {
+		return nil, syntaxError(text, result.Remaining)
+	}
+
+	g := &Grammar[Input]{
+		rules:   make(map[string]*expression, len(result.Output)),
+		actions: make(map[string]Action[Input]),
+	}
+
+	for _, rule := range result.Output {
+		if _, ok := g.rules[rule.name]; ok {
+			return nil, fmt.Errorf("grammar: rule %q is defined more than once", rule.name)
+		}
+
+		g.rules[rule.name] = rule.expression
+		g.order = append(g.order, rule.name)
+	}
+
+	for _, name := range g.order {
+		if undefined := g.undefinedReference(g.rules[name]); undefined != "" {
+			return nil, fmt.Errorf("grammar: rule %q refers to undefined rule %q", name, undefined)
+		}
+	}
+
+	if recursive := g.leftRecursiveRule(); recursive != "" {
+		return nil, fmt.Errorf("grammar: rule %q is left recursive", recursive)
+	}
+
+	if repeating := g.nullableRepetitionRule(); repeating != "" {
+		return nil, fmt.Errorf("grammar: rule %q repeats an expression which can match empty input", repeating)
+	}
+
+	return g, nil
+}
+
+// Rules returns the names of the grammar's rules, in the order they are defined.
+func (g *Grammar[Input]) Rules() []string {
+	return append([]string(nil), g.order...)
+}
+
+// Action attaches an action to the named rule, replacing any previously attached
+// one. Actions attached after a parser was produced don't affect it. It returns
+// an error if the grammar has no such rule.
+func (g *Grammar[Input]) Action(rule string, action Action[Input]) error {
+	if _, ok := g.rules[rule]; !ok {
+		return fmt.Errorf("grammar: undefined rule %q", rule)
+	}
+
+	g.actions[rule] = action
+
+	return nil
+}
+
+// Parser produces a parser for the named rule, using the actions attached to the
+// grammar's rules so far. Like any other parser, it doesn't need to consume the
+// whole input to succeed. It returns an error if the grammar has no such rule.
+func (g *Grammar[Input]) Parser(start string) (gomme.Parser[Input, any], error) {
+	if _, ok := g.rules[start]; !ok {
+		return nil, fmt.Errorf("grammar: undefined rule %q", start)
+	}
+
+	// Rules are compiled into slots, so that references to rules compiled later,
+	// or to the rule being compiled, can be resolved when parsing.
+	slots := make(map[string]*gomme.Parser[Input, any], len(g.rules))
+	for name := range g.rules {
+		slots[name] = new(gomme.Parser[Input, any])
+	}
+
+	for name, body := range g.rules {
+		*slots[name] = rule(name, compile(body, slots), g.actions[name])
+	}
+
+	return *slots[start], nil
+}
+
+// rule wraps the parser of the named rule's expression, so that it fails naming
+// the rule, and produces the value of the provided action, if any.
+func rule[Input gomme.Bytes](name string, parse gomme.Parser[Input, any], action Action[Input]) gomme.Parser[Input, any] {
+	return func(input Input) gomme.Result[any, Input] {
+		result := parse(input)
+		if result.Err != nil {
+			if result.Err.IsFatal() {
+				return result
+			}
+
+			return gomme.Failure[Input, any](gomme.NewError(input, name), input)
+		}
+
+		if action == nil {
+			return result
+		}
+
+		value, err := action(input[:len(input)-len(result.Remaining)], result.Output)
+		if err != nil {
+			return gomme.Failure[Input, any](gomme.NewError(input, err.Error()), input)
+		}
+
+		return gomme.Success(value, result.Remaining)
+	}
+}
+
+// compile produces the parser matching the provided expression. References to
+// rules are resolved through the provided slots.
+func compile[Input gomme.Bytes](e *expression, slots map[string]*gomme.Parser[Input, any]) gomme.Parser[Input, any] {
+	switch e.kind {
+	case kindAlternation:
+		return gomme.Alternative(compileAll(e.children, slots)...)
+	case kindSequence:
+		return gomme.Map(gomme.Sequence(compileAll(e.children, slots)...), func(values []any) (any, error) {
+			return values, nil
+		})
+	case kindToken:
+		return gomme.Untyped(gomme.Token[Input](e.text))
+	case kindRange:
+		low, high := e.low, e.high
+		anyRune := gomme.AnyRune[Input]()
+		return func(input Input) gomme.Result[any, Input] {
+			result := anyRune(input)
+			if result.Err != nil || result.Output < low || result.Output > high {
+				return gomme.Failure[Input, any](gomme.NewError(input, "Range"), input)
+			}
+
+			return gomme.Success[any](input[:len(input)-len(result.Remaining)], result.Remaining)
+		}
+	case kindReference:
+		slot := slots[e.text]
+		return func(input Input) gomme.Result[any, Input] {
+			return (*slot)(input)
+		}
+	case kindOption:
+		return gomme.Optional(compile(e.children[0], slots))
+	case kindRepetition:
+		return gomme.Map(gomme.Many0(compile(e.children[0], slots)), func(values []any) (any, error) {
+			return values, nil
+		})
+	case kindAnd:
+		parse := compile(e.children[0], slots)
+		return func(input Input) gomme.Result[any, Input] {
+			if result := parse(input); result.Err != nil {
+				return gomme.Failure[Input, any](result.Err, input)
+			}
+
+			return gomme.Success[any](nil, input)
+		}
+	case kindNot:
+		parse := compile(e.children[0], slots)
+		return func(input Input) gomme.Result[any, Input] {
+			result := parse(input)
+			if result.Err == nil {
+				return gomme.Failure[Input, any](gomme.NewError(input, "Not"), input)
+			}
+
+			if result.Err.IsFatal() {
+				return gomme.Failure[Input, any](result.Err, input)
+			}
+
+			return gomme.Success[any](nil, input)
+		}
+	default:
+		return func(input Input) gomme.Result[any, Input] {
+			return gomme.Success[any](nil, input)
+		}
+	}
+}
+
+// compileAll produces the parsers matching the provided expressions.
+func compileAll[Input gomme.Bytes](expressions []*expression, slots map[string]*gomme.Parser[Input, any]) []gomme.Parser[Input, any] {
+	parsers := make([]gomme.Parser[Input, any], len(expressions))
+	for idx, e := range expressions {
+		parsers[idx] = compile(e, slots)
+	}
+
+	return parsers
+}
+
+// undefinedReference returns the name of the first undefined rule the provided
+// expression refers to, or an empty string if there is none.
+func (g *Grammar[Input]) undefinedReference(e *expression) string {
+	if e.kind == kindReference {
+		if _, ok := g.rules[e.text]; !ok {
+			return e.text
+		}
+	}
+
+	for _, child := range e.children {
+		if undefined := g.undefinedReference(child); undefined != "" {
+			return undefined
+		}
+	}
+
+	return ""
+}
+
+// leftRecursiveRule returns the name of the first rule which can refer to itself
+// without consuming any input, or an empty string if there is none.
+func (g *Grammar[Input]) leftRecursiveRule() string {
+	nullable := g.nullableRules()
+
+	// The rules each rule can refer to without consuming any input.
+	leftmost := make(map[string][]string, len(g.rules))
+	for name, body := range g.rules {
+		leftmost[name] = leftmostReferences(body, nullable, nil)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int, len(g.rules))
+
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		switch state[name] {
+		case visiting:
+			return true
+		case visited:
+			return false
+		}
+
+		state[name] = visiting
+		for _, reference := range leftmost[name] {
+			if visit(reference) {
+				return true
+			}
+		}
+		state[name] = visited
+
+		return false
+	}
+
+	for _, name := range g.order {
+		if state[name] == unvisited && visit(name) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// nullableRepetitionRule returns the name of the first rule holding a repetition
+// whose expression can match without consuming any input, or an empty string if
+// there is none.
+func (g *Grammar[Input]) nullableRepetitionRule() string {
+	nullable := g.nullableRules()
+	for _, name := range g.order {
+		if hasNullableRepetition(g.rules[name], nullable) {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// hasNullableRepetition returns true if the provided expression holds a
+// repetition whose expression can match without consuming any input, given the
+// set of nullable rules.
+func hasNullableRepetition(e *expression, nullable map[string]bool) bool {
+	if e.kind == kindRepetition && isNullable(e.children[0], nullable) {
+		return true
+	}
+
+	for _, child := range e.children {
+		if hasNullableRepetition(child, nullable) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nullableRules returns the set of rules which can match without consuming any
+// input.
+func (g *Grammar[Input]) nullableRules() map[string]bool {
+	nullable := make(map[string]bool, len(g.rules))
+
+	// Rules are nullable if their expression is, which can depend on other rules
+	// being nullable: the set is grown until it doesn't change anymore.
+	for changed := true; changed; {
+		changed = false
+		for name, body := range g.rules {
+			if !nullable[name] && isNullable(body, nullable) {
+				nullable[name] = true
+				changed = true
+			}
+		}
+	}
+
+	return nullable
+}
+
+// isNullable returns true if the provided expression can match without consuming
+// any input, given the set of nullable rules.
+func isNullable(e *expression, nullable map[string]bool) bool {
+	switch e.kind {
+	case kindAlternation:
+		for _, child := range e.children {
+			if isNullable(child, nullable) {
+				return true
+			}
+		}
+
+		return false
+	case kindSequence:
+		for _, child := range e.children {
+			if !isNullable(child, nullable) {
+				return false
+			}
+		}
+
+		return true
+	case kindToken, kindRange:
+		return false
+	case kindReference:
+		return nullable[e.text]
+	default:
+		return true
+	}
+}
+
+// leftmostReferences appends the rules the provided expression can refer to
+// without consuming any input to the provided slice, given the set of nullable
+// rules.
+func leftmostReferences(e *expression, nullable map[string]bool, references []string) []string {
+	switch e.kind {
+	case kindReference:
+		return append(references, e.text)
+	case kindSequence:
+		for _, child := range e.children {
+			references = leftmostReferences(child, nullable, references)
+			if !isNullable(child, nullable) {
+				break
+			}
+		}
+
+		return references
+	default:
+		for _, child := range e.children {
+			references = leftmostReferences(child, nullable, references)
+		}
+
+		return references
+	}
+}
@@ -0,0 +1,329 @@
+package grammar
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const arithmetic = `
+// Sums and products of integers, such as "1+2*(3+4)".
+Expr    = Product { "+" Product } .
+Product = Factor { "*" Factor } .
+Factor  = Integer | "(" Expr ")" .
+Integer = "0" … "9" { "0" ... "9" } ;
+`
+
+// arithmeticParser produces a parser evaluating the arithmetic grammar's
+// expressions, using actions.
+func arithmeticParser(t testing.TB) func(string) (any, string, error) {
+	t.Helper()
+
+	g, err := Parse[string](arithmetic)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sequences of an operand followed by a repetition of operator and operand
+	// pairs are folded using the provided operation.
+	fold := func(operation func(int, int) int) Action[string] {
+		return func(_ string, value any) (any, error) {
+			elements := value.([]any)
+
+			acc := elements[0].(int)
+			for _, pair := range elements[1].([]any) {
+				acc = operation(acc, pair.([]any)[1].(int))
+			}
+
+			return acc, nil
+		}
+	}
+
+	assert.NoError(t, g.Action("Expr", fold(func(left, right int) int { return left + right })))
+	assert.NoError(t, g.Action("Product", fold(func(left, right int) int { return left * right })))
+	assert.NoError(t, g.Action("Factor", func(_ string, value any) (any, error) {
+		if elements, ok := value.([]any); ok {
+			return elements[1], nil
+		}
+
+		return value, nil
+	}))
+	assert.NoError(t, g.Action("Integer", func(text string, _ any) (any, error) {
+		return strconv.Atoi(text)
+	}))
+
+	parser, err := g.Parser("Expr")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return func(input string) (any, string, error) {
+		result := parser(input)
+		if result.Err != nil {
+			return result.Output, result.Remaining, result.Err
+		}
+
+		return result.Output, result.Remaining, nil
+	}
+}
+
+func TestGrammarActions(t *testing.T) {
+	t.Parallel()
+
+	parse := arithmeticParser(t)
+
+	testCases := []struct {
+		name          string
+		input         string
+		wantErr       bool
+		wantOutput    any
+		wantRemaining string
+	}{
+		{
+			name:          "parsing an integer should succeed",
+			input:         "42",
+			wantErr:       false,
+			wantOutput:    42,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing operators should apply their precedence",
+			input:         "1+2*3+4",
+			wantErr:       false,
+			wantOutput:    11,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing parenthesized expressions should group them",
+			input:         "(1+2)*(3+4);",
+			wantErr:       false,
+			wantOutput:    21,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an operator missing its operand should leave it in the remaining input",
+			input:         "1+2+",
+			wantErr:       false,
+			wantOutput:    3,
+			wantRemaining: "+",
+		},
+		{
+			name:          "parsing input not starting with an expression should fail",
+			input:         "+1",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "+1",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotOutput, gotRemaining, gotErr := parse(tc.input)
+			if (gotErr != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotErr, tc.wantErr)
+			}
+
+			if gotOutput != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotOutput, tc.wantOutput)
+			}
+
+			if gotRemaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotRemaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestGrammarValues(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[[]byte](`
+		Assignment = Name [ " " ] "=" Value .
+		Name       = !Keyword ( "a"..."z" | "_" ) { "a"..."z" | "_" } .
+		Keyword    = "if" | "else" .
+		Value      = &"0"..."9" Digits | "'" { !"'" Any } "'" .
+		Digits     = "0"..."9" { "0"..."9" } .
+		Any        = "\0"..."` + "\U0010FFFF" + `" .
+		Empty      = .
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, []string{"Assignment", "Name", "Keyword", "Value", "Digits", "Any", "Empty"}, g.Rules())
+
+	assignment, err := g.Parser("Assignment")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := assignment([]byte("ab =12;"))
+	assert.Nil(t, result.Err)
+	assert.Equal(t, []byte(";"), result.Remaining)
+	assert.Equal(t, []any{
+		[]any{nil, []byte("a"), []any{[]byte("b")}},
+		[]byte(" "),
+		[]byte("="),
+		[]any{nil, []any{[]byte("1"), []any{[]byte("2")}}},
+	}, result.Output)
+
+	result = assignment([]byte("x='é'"))
+	assert.Nil(t, result.Err)
+	assert.Equal(t, []any{
+		[]any{nil, []byte("x"), []any{}},
+		nil,
+		[]byte("="),
+		[]any{[]byte("'"), []any{[]any{nil, []byte("é")}}, []byte("'")},
+	}, result.Output)
+
+	result = assignment([]byte("if=1"))
+	assert.Error(t, result.Err)
+	assert.Equal(t, []string{"Assignment"}, result.Err.Expected)
+
+	empty, err := g.Parser("Empty")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result = empty([]byte("abc"))
+	assert.Nil(t, result.Err)
+	assert.Nil(t, result.Output)
+	assert.Equal(t, []byte("abc"), result.Remaining)
+}
+
+func TestGrammarActionError(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[string](`Digit = "0"..."9" .`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.NoError(t, g.Action("Digit", func(text string, _ any) (any, error) {
+		if text == "0" {
+			return nil, errors.New("zero is not allowed")
+		}
+
+		return text, nil
+	}))
+	assert.Error(t, g.Action("Undefined", nil))
+
+	parser, err := g.Parser("Digit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, "1", parser("1").Output)
+	assert.Error(t, parser("0").Err)
+
+	_, err = g.Parser("Undefined")
+	assert.Error(t, err)
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		text    string
+		wantErr string
+	}{
+		{
+			name:    "unterminated rule should fail",
+			text:    "A = \"a\" .\nB = \"b\"",
+			wantErr: "grammar: syntax error at line 2, column 1",
+		},
+		{
+			name:    "invalid term should fail",
+			text:    "A = \"a\" | ) .",
+			wantErr: "grammar: syntax error at line 1, column 1",
+		},
+		{
+			name:    "invalid range should fail",
+			text:    "A = \"z\" … \"a\" .",
+			wantErr: "grammar: syntax error at line 1, column 1",
+		},
+		{
+			name:    "rule defined twice should fail",
+			text:    "A = \"a\" . A = \"b\" .",
+			wantErr: `grammar: rule "A" is defined more than once`,
+		},
+		{
+			name:    "undefined rule reference should fail",
+			text:    "A = \"a\" B .",
+			wantErr: `grammar: rule "A" refers to undefined rule "B"`,
+		},
+		{
+			name:    "directly left recursive rule should fail",
+			text:    "A = A \"a\" | \"a\" .",
+			wantErr: `grammar: rule "A" is left recursive`,
+		},
+		{
+			name:    "left recursion through nullable rules should fail",
+			text:    "A = [ \"b\" ] B . B = C \"a\" . C = { \"c\" } A .",
+			wantErr: `grammar: rule "A" is left recursive`,
+		},
+		{
+			name:    "repetition of an option should fail",
+			text:    "A = { [ \"a\" ] } .",
+			wantErr: `grammar: rule "A" repeats an expression which can match empty input`,
+		},
+		{
+			name:    "repetition of a nullable rule should fail",
+			text:    "A = \"b\" { \"a\" | B } . B = .",
+			wantErr: `grammar: rule "A" repeats an expression which can match empty input`,
+		},
+		{
+			name:    "repetition of a nested repetition should fail",
+			text:    "A = \"a\" . B = { A { A } } { { A } } .",
+			wantErr: `grammar: rule "B" repeats an expression which can match empty input`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse[string](tc.text)
+			if err == nil || err.Error() != tc.wantErr {
+				t.Errorf("got error %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseAllowsRightRecursion(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[string](`List = "(" { List } ")" .`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser, err := g.Parser("List")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := parser("(()(()))")
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "", result.Remaining)
+}
+
+func BenchmarkGrammar(b *testing.B) {
+	parse := arithmeticParser(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parse("1+2*(3+4)*5+6")
+	}
+}
@@ -0,0 +1,245 @@
+package grammar
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/oleiade/gomme"
+)
+
+// SampleOptions describes the inputs produced by Samples.
+type SampleOptions struct {
+	// Seed seeds the random walks through the grammar, so that a given seed
+	// always produces the same samples.
+	Seed int64
+
+	// Valid is the number of valid inputs to produce. It defaults to 16.
+	Valid int
+
+	// NearValid is the number of near-valid inputs to produce.
+	NearValid int
+
+	// MaxDepth is the number of nested rules past which walks take the shortest
+	// path out of the grammar. It defaults to 8.
+	MaxDepth int
+
+	// MaxRepetitions is the maximum number of times repetitions are repeated. It
+	// defaults to 3.
+	MaxRepetitions int
+}
+
+// Samples produces inputs for the parser of the named rule, out of random walks
+// through the grammar, which can seed fuzz tests or property based tests.
+//
+// The valid samples come first: each of them is checked to be entirely consumed
+// by the rule's parser, and walks producing duplicates, or failing to satisfy
+// a predicate, are retried a bounded number of times, so that fewer samples than
+// requested can be produced. They are followed by the near-valid samples, which
+// are valid ones altered by a single deletion, insertion, replacement, or
+// truncation, and which exercise the parser's failure paths, though some of
+// them may happen to be valid.
+//
+// It returns an error if the grammar has no such rule, or if the rule can't
+// produce any finite input.
+func (g *Grammar[Input]) Samples(start string, options SampleOptions) ([]Input, error) {
+	parser, err := g.Parser(start)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Valid == 0 {
+		options.Valid = 16
+	}
+
+	if options.MaxDepth == 0 {
+		options.MaxDepth = 8
+	}
+
+	if options.MaxRepetitions == 0 {
+		options.MaxRepetitions = 3
+	}
+
+	s := &sampler{
+		rules:   g.rules,
+		options: options,
+		random:  rand.New(rand.NewSource(options.Seed)), //nolint:gosec // samples needn't be unpredictable.
+		lengths: g.minimalLengths(),
+	}
+
+	if s.lengths[start] == math.MaxInt {
+		return nil, fmt.Errorf("grammar: rule %q cannot produce a finite input", start)
+	}
+
+	complete := gomme.Terminated(parser, gomme.EOF[Input]())
+
+	seen := make(map[string]bool, options.Valid)
+	samples := make([]Input, 0, options.Valid+options.NearValid)
+
+	for attempts := 0; len(samples) < options.Valid && attempts < 10*options.Valid; attempts++ {
+		var text strings.Builder
+		s.walk(&text, &expression{kind: kindReference, text: start}, 0)
+
+		sample := text.String()
+		if seen[sample] || complete(Input(sample)).Err != nil {
+			continue
+		}
+
+		seen[sample] = true
+		samples = append(samples, Input(sample))
+	}
+
+	if len(samples) == 0 {
+		return samples, nil
+	}
+
+	valid := samples
+	for idx := 0; idx < options.NearValid; idx++ {
+		samples = append(samples, Input(s.mutate(string(valid[s.random.Intn(len(valid))]))))
+	}
+
+	return samples, nil
+}
+
+// sampler holds the state of a Samples call.
+type sampler struct {
+	rules   map[string]*expression
+	options SampleOptions
+	random  *rand.Rand
+
+	// lengths associates each rule with the length of the shortest input it
+	// produces, or math.MaxInt if it can't produce a finite one.
+	lengths map[string]int
+}
+
+// walk writes an input the provided expression matches to the builder. Past the
+// maximum depth, it writes the shortest such input.
+func (s *sampler) walk(w *strings.Builder, e *expression, depth int) {
+	shortest := depth > s.options.MaxDepth
+
+	switch e.kind {
+	case kindAlternation:
+		choice := e.children[s.random.Intn(len(e.children))]
+		if shortest || minimalLength(choice, s.lengths) == math.MaxInt {
+			choice = e.children[0]
+			for _, child := range e.children[1:] {
+				if minimalLength(child, s.lengths) < minimalLength(choice, s.lengths) {
+					choice = child
+				}
+			}
+		}
+
+		s.walk(w, choice, depth)
+	case kindSequence:
+		for _, child := range e.children {
+			s.walk(w, child, depth)
+		}
+	case kindToken:
+		w.WriteString(e.text)
+	case kindRange:
+		c := e.low + rune(s.random.Int63n(int64(e.high-e.low)+1))
+		if !utf8.ValidRune(c) {
+			c = e.low
+		}
+
+		w.WriteRune(c)
+	case kindReference:
+		s.walk(w, s.rules[e.text], depth+1)
+	case kindOption:
+		if !shortest && s.random.Intn(2) == 0 {
+			s.walk(w, e.children[0], depth)
+		}
+	case kindRepetition:
+		if shortest {
+			break
+		}
+
+		for count := s.random.Intn(s.options.MaxRepetitions + 1); count > 0; count-- {
+			s.walk(w, e.children[0], depth)
+		}
+	}
+}
+
+// mutate alters the provided sample by a single deletion, insertion, replacement,
+// or truncation.
+func (s *sampler) mutate(sample string) string {
+	if sample == "" {
+		return string(rune(' ' + s.random.Intn('~'-' '+1)))
+	}
+
+	idx := s.random.Intn(len(sample))
+	printable := byte(' ' + s.random.Intn('~'-' '+1))
+
+	switch s.random.Intn(4) {
+	case 0:
+		return sample[:idx] + sample[idx+1:]
+	case 1:
+		return sample[:idx] + string(printable) + sample[idx:]
+	case 2:
+		return sample[:idx] + string(printable) + sample[idx+1:]
+	default:
+		return sample[:idx]
+	}
+}
+
+// minimalLengths returns the length of the shortest input each rule produces, or
+// math.MaxInt for the rules which can't produce a finite input.
+func (g *Grammar[Input]) minimalLengths() map[string]int {
+	lengths := make(map[string]int, len(g.rules))
+	for name := range g.rules {
+		lengths[name] = math.MaxInt
+	}
+
+	// The lengths of rules depend on the lengths of the rules they refer to:
+	// they are shrunk until they don't change anymore.
+	for changed := true; changed; {
+		changed = false
+		for name, body := range g.rules {
+			if length := minimalLength(body, lengths); length < lengths[name] {
+				lengths[name] = length
+				changed = true
+			}
+		}
+	}
+
+	return lengths
+}
+
+// minimalLength returns the length of the shortest input the provided expression
+// produces, given the lengths of the rules', or math.MaxInt if it can't produce
+// a finite one.
+func minimalLength(e *expression, lengths map[string]int) int {
+	switch e.kind {
+	case kindAlternation:
+		length := math.MaxInt
+		for _, child := range e.children {
+			if childLength := minimalLength(child, lengths); childLength < length {
+				length = childLength
+			}
+		}
+
+		return length
+	case kindSequence:
+		length := 0
+		for _, child := range e.children {
+			childLength := minimalLength(child, lengths)
+			if childLength == math.MaxInt {
+				return math.MaxInt
+			}
+
+			length += childLength
+		}
+
+		return length
+	case kindToken:
+		return len(e.text)
+	case kindRange:
+		return utf8.RuneLen(e.low)
+	case kindReference:
+		return lengths[e.text]
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,123 @@
+package grammar
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSamples(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[string](arithmetic)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parser, err := g.Parser("Expr")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	samples, err := g.Samples("Expr", SampleOptions{Seed: 1, Valid: 20, NearValid: 10})
+	assert.NoError(t, err)
+	assert.Len(t, samples, 30)
+
+	seen := make(map[string]bool)
+	for _, sample := range samples[:20] {
+		result := parser(sample)
+		assert.Nil(t, result.Err, "sample %q", sample)
+		assert.Equal(t, "", result.Remaining, "sample %q", sample)
+		assert.False(t, seen[sample], "sample %q is duplicated", sample)
+
+		seen[sample] = true
+	}
+
+	// A given seed produces the same samples.
+	again, err := g.Samples("Expr", SampleOptions{Seed: 1, Valid: 20, NearValid: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, samples, again)
+
+	other, err := g.Samples("Expr", SampleOptions{Seed: 2, Valid: 20, NearValid: 10})
+	assert.NoError(t, err)
+	assert.NotEqual(t, samples, other)
+}
+
+func TestSamplesSatisfyPredicates(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[[]byte](`
+		Name    = !Keyword ( "a"..."z" | "_" ) { "a"..."z" | "_" } .
+		Keyword = "if" | "else" .
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	samples, err := g.Samples("Name", SampleOptions{Valid: 50})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, samples)
+
+	for _, sample := range samples {
+		assert.NotEqual(t, []byte("if"), sample)
+		assert.NotEqual(t, []byte("else"), sample)
+	}
+}
+
+func TestSamplesTerminateOnRecursiveRules(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[string](`List = "(" { List } ")" .`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	samples, err := g.Samples("List", SampleOptions{Valid: 10, MaxDepth: 2, MaxRepetitions: 5})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, samples)
+
+	parser, err := g.Parser("List")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, sample := range samples {
+		result := gomme.Terminated(parser, gomme.EOF[string]())(sample)
+		assert.Nil(t, result.Err, "sample %q", sample)
+	}
+}
+
+func TestSamplesErrors(t *testing.T) {
+	t.Parallel()
+
+	g, err := Parse[string](`A = "a" A . B = "b" .`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = g.Samples("A", SampleOptions{})
+	assert.EqualError(t, err, `grammar: rule "A" cannot produce a finite input`)
+
+	_, err = g.Samples("C", SampleOptions{})
+	assert.EqualError(t, err, `grammar: undefined rule "C"`)
+
+	// Rules producing a single input produce a single sample.
+	samples, err := g.Samples("B", SampleOptions{Valid: 4, NearValid: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, samples[:1])
+	assert.Len(t, samples, 3)
+}
+
+func BenchmarkSamples(b *testing.B) {
+	g, err := Parse[string](arithmetic)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = g.Samples("Expr", SampleOptions{Seed: int64(i)})
+	}
+}
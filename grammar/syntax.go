@@ -0,0 +1,174 @@
+package grammar
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/oleiade/gomme"
+)
+
+// kind identifies the construct an expression of a grammar describes.
+type kind int
+
+const (
+	kindAlternation kind = iota
+	kindSequence
+	kindToken
+	kindRange
+	kindReference
+	kindOption
+	kindRepetition
+	kindAnd
+	kindNot
+	kindEmpty
+)
+
+// expression is a node of a grammar's syntax tree.
+type expression struct {
+	kind     kind
+	children []*expression
+
+	// text holds a token's literal, or a referenced rule's name.
+	text string
+
+	// low and high hold the bounds of a range.
+	low, high rune
+}
+
+// production associates a rule's name with its expression.
+type production struct {
+	name       string
+	expression *expression
+}
+
+// syntax produces the parser for grammar texts, following the syntax described
+// in the package documentation.
+func syntax() gomme.Parser[string, []production] {
+	space := gomme.SkipMany0(gomme.Alternative(
+		gomme.Whitespace1[string](),
+		gomme.CommentLine[string]("//"),
+	))
+
+	symbol := func(token string) gomme.Parser[string, string] {
+		return gomme.LexemeWith(space, gomme.Token[string](token))
+	}
+
+	name := gomme.LexemeWith(space, gomme.Recognize(gomme.Pair(
+		gomme.Satisfy[string](isNameStart),
+		gomme.TakeTill[string](func(c rune) bool { return !isNamePart(c) }),
+	)))
+
+	token := gomme.LexemeWith(space, gomme.Map(
+		gomme.Alternative(gomme.QuotedString[string](), gomme.QuotedString[string](gomme.WithQuote('\''))),
+		func(literal string) (string, error) {
+			if literal == "" {
+				return "", fmt.Errorf("empty token")
+			}
+
+			return literal, nil
+		},
+	))
+
+	// The expression parser is referenced by the group, option, and repetition
+	// parsers it is made of.
+	var alternation gomme.Parser[string, *expression]
+	nested := func(input string) gomme.Result[*expression, string] {
+		return alternation(input)
+	}
+
+	rangeTerm := gomme.Map(
+		gomme.SeparatedPair(token, gomme.Alternative(symbol("…"), symbol("...")), token),
+		func(bounds gomme.PairContainer[string, string]) (*expression, error) {
+			low, lowSize := utf8.DecodeRuneInString(bounds.Left)
+			high, highSize := utf8.DecodeRuneInString(bounds.Right)
+			if lowSize != len(bounds.Left) || highSize != len(bounds.Right) || low > high {
+				return nil, fmt.Errorf("invalid range")
+			}
+
+			return &expression{kind: kindRange, low: low, high: high}, nil
+		},
+	)
+
+	primary := gomme.Alternative(
+		gomme.Map(name, func(text string) (*expression, error) {
+			return &expression{kind: kindReference, text: text}, nil
+		}),
+		rangeTerm,
+		gomme.Map(token, func(text string) (*expression, error) {
+			return &expression{kind: kindToken, text: text}, nil
+		}),
+		gomme.Delimited(symbol("("), nested, symbol(")")),
+		gomme.Map(gomme.Delimited(symbol("["), nested, symbol("]")), wrap(kindOption)),
+		gomme.Map(gomme.Delimited(symbol("{"), nested, symbol("}")), wrap(kindRepetition)),
+	)
+
+	term := gomme.Alternative(
+		gomme.Map(gomme.Preceded(symbol("&"), primary), wrap(kindAnd)),
+		gomme.Map(gomme.Preceded(symbol("!"), primary), wrap(kindNot)),
+		primary,
+	)
+
+	sequence := gomme.Map(gomme.Many1(term), func(terms []*expression) (*expression, error) {
+		if len(terms) == 1 {
+			return terms[0], nil
+		}
+
+		return &expression{kind: kindSequence, children: terms}, nil
+	})
+
+	alternation = gomme.Map(
+		gomme.SeparatedList1(sequence, symbol("|")),
+		func(alternatives []*expression) (*expression, error) {
+			if len(alternatives) == 1 {
+				return alternatives[0], nil
+			}
+
+			return &expression{kind: kindAlternation, children: alternatives}, nil
+		},
+	)
+
+	rule := gomme.Map(
+		gomme.Pair(
+			gomme.Terminated(name, symbol("=")),
+			gomme.Terminated(gomme.Optional(alternation), gomme.Alternative(symbol("."), symbol(";"))),
+		),
+		func(rule gomme.PairContainer[string, *expression]) (production, error) {
+			body := rule.Right
+			if body == nil {
+				body = &expression{kind: kindEmpty}
+			}
+
+			return production{name: rule.Left, expression: body}, nil
+		},
+	)
+
+	return gomme.Preceded(space, gomme.Many0(rule))
+}
+
+// wrap produces a function wrapping an expression into a node of the provided kind.
+func wrap(k kind) func(*expression) (*expression, error) {
+	return func(child *expression) (*expression, error) {
+		return &expression{kind: k, children: []*expression{child}}, nil
+	}
+}
+
+// isNameStart returns true if the provided character can start a rule's name.
+func isNameStart(c rune) bool {
+	return gomme.IsAlpha(c) || c == '_'
+}
+
+// isNamePart returns true if the provided character can be part of a rule's name.
+func isNamePart(c rune) bool {
+	return gomme.IsAlphanumeric(c) || c == '_'
+}
+
+// syntaxError produces the error reporting that the grammar text couldn't be
+// parsed past the provided remaining input.
+func syntaxError(text, remaining string) error {
+	offset := len(text) - len(remaining)
+	line := strings.Count(text[:offset], "\n") + 1
+	column := offset - strings.LastIndexByte(text[:offset], '\n')
+
+	return fmt.Errorf("grammar: syntax error at line %d, column %d", line, column)
+}
@@ -0,0 +1,171 @@
+package gomme
+
+// Indentation tracks the indentation of the blocks of an indentation-sensitive
+// format, such as YAML or Python, while they are parsed by the Block and Indented
+// combinators. It holds a stack of columns: entering a block pushes its column,
+// as an INDENT token would, and leaving it, once a line is indented less than
+// the block, pops it, as a DEDENT token would.
+//
+// Indentation is measured in spaces and tabs at the start of lines, each of them
+// counting as a single column. Blank lines, holding nothing but spaces and tabs,
+// are skipped, regardless of their indentation.
+//
+// An Indentation is not safe for concurrent use: parsers tracking their blocks
+// using the same Indentation must not be invoked concurrently.
+type Indentation struct {
+	columns []int
+}
+
+// NewIndentation produces a new Indentation, outside of any block.
+func NewIndentation() *Indentation {
+	return &Indentation{}
+}
+
+// Level returns the column of the innermost block being parsed, or -1 outside
+// of any block.
+func (i *Indentation) Level() int {
+	if len(i.columns) == 0 {
+		return -1
+	}
+
+	return i.columns[len(i.columns)-1]
+}
+
+// Block parses a block of lines sharing the same indentation, applying the
+// provided parser to the contents of each of them, after their indentation. The
+// block's indentation is the one of its first non-blank line, which must be
+// greater than the indentation of the enclosing block, if any. The block ends
+// at the end of the input, or before the first line indented less than it, which
+// is left in the remaining input.
+//
+// The provided parser parses the contents of a line, up to, but excluding, its
+// line ending. Trailing spaces and tabs are skipped. It can also parse the
+// blocks nested under the line, using Indented, in which case the lines it
+// parsed end the block's line.
+//
+// Block expects the input to start at the beginning of a line. If the first
+// non-blank line is not indented more than the enclosing block, if the parser
+// fails, if a line holds anything past what the parser matched, or if a line
+// of the block is followed by a more indented one the parser didn't parse, the
+// parser returns an error result.
+func Block[Input Bytes, Output any](indentation *Indentation, parse Parser[Input, Output]) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		line, column := nextIndentedLine(input)
+		if len(line) == 0 || column <= indentation.Level() {
+			return Failure[Input, []Output](NewError(line, "Block"), input)
+		}
+
+		indentation.columns = append(indentation.columns, column)
+		defer func() {
+			indentation.columns = indentation.columns[:len(indentation.columns)-1]
+		}()
+
+		var outputs []Output
+		for {
+			contents := line[column:]
+			result := parse(contents)
+			if result.Err != nil {
+				return Failure[Input, []Output](result.Report(), input)
+			}
+
+			remaining := result.Remaining
+			consumed := len(contents) - len(remaining)
+
+			// Unless the parser ended the line, by parsing the blocks
+			// nested under it, the line must end after what it matched.
+			if consumed == 0 || contents[consumed-1] != '\n' {
+				end := lineEndingLength(remaining)
+				if end < 0 {
+					return Failure[Input, []Output](NewError(remaining, "Block"), input)
+				}
+
+				remaining = remaining[end:]
+			}
+
+			outputs = append(outputs, result.Output)
+
+			next, nextColumn := nextIndentedLine(remaining)
+			if len(next) == 0 || nextColumn < column {
+				return Success(outputs, next)
+			}
+
+			if nextColumn > column {
+				return Failure[Input, []Output](NewError(next, "Block"), input)
+			}
+
+			line = next
+		}
+	}
+}
+
+// Indented parses the block of lines nested under the current line, as Block
+// does. It is meant to be applied at the end of a line introducing a nested
+// block, such as a YAML key holding a mapping, or a Python statement ending with
+// a colon: it skips trailing spaces and tabs, and the line ending, before parsing
+// the following lines, which must be indented more than the current block.
+//
+// If the input doesn't start with a line ending, or if the following lines
+// don't form a block indented more than the current one, the parser returns an
+// error result.
+func Indented[Input Bytes, Output any](indentation *Indentation, parse Parser[Input, Output]) Parser[Input, []Output] {
+	block := Block(indentation, parse)
+
+	return func(input Input) Result[[]Output, Input] {
+		end := lineEndingLength(input)
+		if end <= 0 {
+			return Failure[Input, []Output](NewError(input, "Indented"), input)
+		}
+
+		result := block(input[end:])
+		if result.Err != nil {
+			return Failure[Input, []Output](result.Report(), input)
+		}
+
+		return result
+	}
+}
+
+// nextIndentedLine skips the blank lines at the start of the input, and returns
+// the input from the beginning of the first non-blank line, along with the width
+// of its indentation. If the input holds nothing but blank lines, an empty input
+// is returned.
+func nextIndentedLine[Input Bytes](input Input) (Input, int) {
+	for {
+		column := 0
+		for column < len(input) && (input[column] == ' ' || input[column] == '\t') {
+			column++
+		}
+
+		end := lineEndingLength(input[column:])
+		switch {
+		case column == len(input):
+			return input[column:], 0
+		case end <= 0:
+			return input, column
+		}
+
+		input = input[column+end:]
+	}
+}
+
+// lineEndingLength returns the length of the spaces and tabs at the start of
+// the input, followed by a "\n" or "\r\n" line ending. It returns 0 if the input
+// holds nothing but spaces and tabs, and -1 if they are followed by anything
+// else than a line ending.
+func lineEndingLength[Input Bytes](input Input) int {
+	pos := 0
+	for pos < len(input) && (input[pos] == ' ' || input[pos] == '\t') {
+		pos++
+	}
+
+	switch {
+	case pos == len(input):
+		return 0
+	case input[pos] == '\n':
+		return pos + 1
+	case input[pos] == '\r' && pos+1 < len(input) && input[pos+1] == '\n':
+		return pos + 2
+	}
+
+	return -1
+}
@@ -0,0 +1,238 @@
+package gomme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing lines sharing the same indentation should succeed",
+			input:         "a\nb\nc",
+			wantErr:       false,
+			wantOutput:    []string{"a", "b", "c"},
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an indented block should stop before a less indented line",
+			input:         "  a\n  b\nc",
+			wantErr:       false,
+			wantOutput:    []string{"a", "b"},
+			wantRemaining: "c",
+		},
+		{
+			name:          "parsing a block holding blank lines should skip them",
+			input:         "\na\n\n \t\nb\n",
+			wantErr:       false,
+			wantOutput:    []string{"a", "b"},
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a block with CRLF line endings and trailing spaces should succeed",
+			input:         "a \r\nb\t\r\n",
+			wantErr:       false,
+			wantOutput:    []string{"a", "b"},
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a block followed by a more indented line should fail",
+			input:         "a\n  b",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a\n  b",
+		},
+		{
+			name:          "parsing a line holding more than the parser matches should fail",
+			input:         "a b\n",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a b\n",
+		},
+		{
+			name:          "parsing a line the parser doesn't match should fail",
+			input:         "a\n1\n",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a\n1\n",
+		},
+		{
+			name:          "parsing blank lines only should fail",
+			input:         "\n  \n",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "\n  \n",
+		},
+		{
+			name:          "parsing empty input should fail",
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			indentation := NewIndentation()
+			gotResult := Block(indentation, Alpha1[string]())(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+			assert.Equal(t, -1, indentation.Level())
+		})
+	}
+}
+
+func BenchmarkBlock(b *testing.B) {
+	parser := Block(NewIndentation(), Alpha1[string]())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("  abc\n  def\n\n  ghi\njkl")
+	}
+}
+
+// indentNode is a node of the outlines parsed by indentTree.
+type indentNode struct {
+	Name     string
+	Children []indentNode
+}
+
+// indentTree produces a parser for outlines, whose nodes are names, optionally
+// followed by a colon introducing their children, indented under them.
+func indentTree(indentation *Indentation) Parser[string, []indentNode] {
+	var node Parser[string, indentNode]
+	children := Indented(indentation, func(input string) Result[indentNode, string] {
+		return node(input)
+	})
+
+	node = Map(
+		Pair(Alpha1[string](), Optional(Preceded(Char[string](':'), children))),
+		func(pair PairContainer[string, []indentNode]) (indentNode, error) {
+			return indentNode{Name: pair.Left, Children: pair.Right}, nil
+		},
+	)
+
+	return Block(indentation, node)
+}
+
+func TestIndented(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		input         string
+		wantErr       bool
+		wantOutput    []indentNode
+		wantRemaining string
+	}{
+		{
+			name:    "parsing nested blocks should succeed",
+			input:   "root:\n  a\n  b:\n    c\n\n  d\ne\n",
+			wantErr: false,
+			wantOutput: []indentNode{
+				{Name: "root", Children: []indentNode{
+					{Name: "a"},
+					{Name: "b", Children: []indentNode{{Name: "c"}}},
+					{Name: "d"},
+				}},
+				{Name: "e"},
+			},
+			wantRemaining: "",
+		},
+		{
+			name:    "parsing nested blocks ending with the input should succeed",
+			input:   "a:\n\tb:\n\t\tc",
+			wantErr: false,
+			wantOutput: []indentNode{
+				{Name: "a", Children: []indentNode{
+					{Name: "b", Children: []indentNode{{Name: "c"}}},
+				}},
+			},
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a nested block which isn't indented should fail",
+			input:         "a:\nb\n",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a:\nb\n",
+		},
+		{
+			name:          "parsing a dedent matching no enclosing block should fail",
+			input:         "a:\n    b\n  c\n",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a:\n    b\n  c\n",
+		},
+		{
+			name:          "parsing a nested block on the same line should fail",
+			input:         "a: b\n",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a: b\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			indentation := NewIndentation()
+			gotResult := indentTree(indentation)(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+			assert.Equal(t, -1, indentation.Level())
+		})
+	}
+}
+
+func BenchmarkIndented(b *testing.B) {
+	parser := indentTree(NewIndentation())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("root:\n  a\n  b:\n    c\n\n  d\ne\n")
+	}
+}
+
+func TestIndentationLevel(t *testing.T) {
+	t.Parallel()
+
+	indentation := NewIndentation()
+	var levels []int
+	parser := Block(indentation, func(input string) Result[string, string] {
+		levels = append(levels, indentation.Level())
+		return Alpha1[string]()(input)
+	})
+
+	assert.Equal(t, -1, indentation.Level())
+
+	result := parser("   a\n   b")
+	assert.Nil(t, result.Err)
+	assert.Equal(t, []int{3, 3}, levels)
+	assert.Equal(t, -1, indentation.Level())
+}
@@ -1,21 +1,23 @@
 package gomme
 
+import (
+	"fmt"
+	"math"
+)
+
 // Count runs the provided parser `count` times.
 //
 // If the provided parser cannot be successfully applied `count` times, the operation
-// fails and the Result will contain an error.
+// fails and the Result will contain an error. Note that a `count` of zero always
+// succeeds, and produces an empty slice without consuming any input.
 func Count[Input Bytes, Output any](parse Parser[Input, Output], count uint) Parser[Input, []Output] {
 	return func(input Input) Result[[]Output, Input] {
-		if len(input) == 0 || count == 0 {
-			return Failure[Input, []Output](NewError(input, "Count"), input)
-		}
-
 		outputs := make([]Output, 0, int(count))
 		remaining := input
 		for i := 0; uint(i) < count; i++ {
 			result := parse(remaining)
 			if result.Err != nil {
-				return Failure[Input, []Output](result.Err, input)
+				return Failure[Input, []Output](result.Report(), input)
 			}
 
 			remaining = result.Remaining
@@ -34,59 +36,480 @@ func Count[Input Bytes, Output any](parse Parser[Input, Output], count uint) Par
 // `Alpha0`) in order to prevent infinite loops.
 func Many0[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, []Output] {
 	return func(input Input) Result[[]Output, Input] {
-		results := []Output{}
+		return many(input, parse, 0, false, "Many0")
+	}
+}
+
+// Many1 applies a parser repeatedly until it fails, and returns a slice of all
+// the results as the Result's Output. Many1 will fail if the parser fails to
+// match at least once.
+//
+// Note that Many1 will fail if the provided parser accepts empty
+// inputs (such as `Digit0`, or `Alpha0`) in order to prevent infinite loops.
+func Many1[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return many(input, parse, 0, true, "Many1")
+	}
+}
+
+// Many0Cap behaves like Many0, but preallocates the results slice with the
+// provided capacity. When the expected number of results is known, or can be
+// estimated, it avoids growing the slice repeatedly while parsing.
+func Many0Cap[Input Bytes, Output any](parse Parser[Input, Output], capacity uint) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return many(input, parse, capacity, false, "Many0Cap")
+	}
+}
+
+// Many1Cap behaves like Many1, but preallocates the results slice with the
+// provided capacity. When the expected number of results is known, or can be
+// estimated, it avoids growing the slice repeatedly while parsing.
+func Many1Cap[Input Bytes, Output any](parse Parser[Input, Output], capacity uint) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return many(input, parse, capacity, true, "Many1Cap")
+	}
+}
+
+// Many0In behaves like Many0, but allocates the results slice from the provided
+// arena, rather than from the heap. The results are collected into a buffer the
+// arena reuses across invocations, and then copied into a slice of the exact
+// size, so that parsing lists doesn't repeatedly grow and discard slices.
+//
+// As parsers allocating from the same arena must not be invoked concurrently,
+// Many0In parsers must not be either.
+func Many0In[Input Bytes, Output any](arena *Arena[Output], parse Parser[Input, Output]) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return manyIn(input, arena, parse, false, "Many0In")
+	}
+}
+
+// Many1In behaves like Many1, but allocates the results slice from the provided
+// arena, rather than from the heap, as Many0In does.
+func Many1In[Input Bytes, Output any](arena *Arena[Output], parse Parser[Input, Output]) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return manyIn(input, arena, parse, true, "Many1In")
+	}
+}
+
+// manyIn holds the logic shared by the Many combinators allocating from an
+// arena. It mirrors many, but collects the results on the arena's scratch stack.
+func manyIn[Input Bytes, Output any](
+	input Input,
+	arena *Arena[Output],
+	parse Parser[Input, Output],
+	required bool,
+	name string,
+) Result[[]Output, Input] {
+	start := len(arena.scratch)
+
+	remaining := input
+	for {
+		res := parse(remaining)
+		if res.Err != nil {
+			if res.Err.IsFatal() || (required && len(arena.scratch) == start) {
+				arena.discard(start)
+				return Failure[Input, []Output](res.Err, input)
+			}
+
+			return Success(arena.collect(start), remaining)
+		}
+
+		// Checking for infinite loops, if nothing was consumed,
+		// the provided parser would make us go around in circles.
+		if len(res.Remaining) == len(remaining) {
+			arena.discard(start)
+			return Failure[Input, []Output](NewError(input, name), input)
+		}
+
+		arena.scratch = append(arena.scratch, res.Output)
+		remaining = res.Remaining
+	}
+}
+
+// many holds the logic shared by the Many combinators. It applies the parser
+// repeatedly until it fails, collecting its results in a slice preallocated with
+// the provided capacity. If `required` is true, the parser must match at least
+// once. The provided name is used to produce error Results.
+func many[Input Bytes, Output any](
+	input Input,
+	parse Parser[Input, Output],
+	capacity uint,
+	required bool,
+	name string,
+) Result[[]Output, Input] {
+	results := make([]Output, 0, int(capacity))
+
+	remaining := input
+	for {
+		res := parse(remaining)
+		if res.Err != nil {
+			if res.Err.IsFatal() || (required && len(results) == 0) {
+				return Failure[Input, []Output](res.Err, input)
+			}
+
+			return Success(results, remaining)
+		}
+
+		// Checking for infinite loops, if nothing was consumed,
+		// the provided parser would make us go around in circles.
+		if len(res.Remaining) == len(remaining) {
+			return Failure[Input, []Output](NewError(input, name), input)
+		}
+
+		results = append(results, res.Output)
+		remaining = res.Remaining
+	}
+}
+
+// Fold0 applies a parser repeatedly until it fails, and reduces each of its
+// results into an accumulator using the provided function. The accumulator,
+// starting from the provided initial value, is returned as the Result's Output.
+//
+// Unlike Many0, Fold0 does not allocate an intermediate slice to hold the
+// parser's results.
+//
+// Note that Fold0 will succeed even if the parser fails to match at all. It will
+// however fail if the provided parser accepts empty inputs (such as `Digit0`, or
+// `Alpha0`) in order to prevent infinite loops.
+func Fold0[Input Bytes, Output, Acc any](
+	parse Parser[Input, Output],
+	init Acc,
+	fn func(Acc, Output) Acc,
+) Parser[Input, Acc] {
+	return func(input Input) Result[Acc, Input] {
+		acc := init
 
 		remaining := input
 		for {
 			res := parse(remaining)
 			if res.Err != nil {
-				return Success(results, remaining)
+				if res.Err.IsFatal() {
+					return Failure[Input, Acc](res.Err, input)
+				}
+
+				return Success(acc, remaining)
 			}
 
 			// Checking for infinite loops, if nothing was consumed,
 			// the provided parser would make us go around in circles.
 			if len(res.Remaining) == len(remaining) {
-				return Failure[Input, []Output](NewError(input, "Many0"), input)
+				return Failure[Input, Acc](NewError(input, "Fold0"), input)
 			}
 
-			results = append(results, res.Output)
+			acc = fn(acc, res.Output)
 			remaining = res.Remaining
 		}
 	}
 }
 
-// Many1 applies a parser repeatedly until it fails, and returns a slice of all
-// the results as the Result's Output. Many1 will fail if the parser fails to
-// match at least once.
+// Fold1 applies a parser repeatedly until it fails, and reduces each of its
+// results into an accumulator using the provided function. The accumulator,
+// starting from the provided initial value, is returned as the Result's Output.
+// Fold1 will fail if the parser fails to match at least once.
 //
-// Note that Many1 will fail if the provided parser accepts empty
+// Note that Fold1 will fail if the provided parser accepts empty
 // inputs (such as `Digit0`, or `Alpha0`) in order to prevent infinite loops.
-func Many1[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, []Output] {
-	return func(input Input) Result[[]Output, Input] {
+func Fold1[Input Bytes, Output, Acc any](
+	parse Parser[Input, Output],
+	init Acc,
+	fn func(Acc, Output) Acc,
+) Parser[Input, Acc] {
+	return func(input Input) Result[Acc, Input] {
 		first := parse(input)
 		if first.Err != nil {
-			return Failure[Input, []Output](first.Err, input)
+			return Failure[Input, Acc](first.Err, input)
 		}
 
 		// Checking for infinite loops, if nothing was consumed,
 		// the provided parser would make us go around in circles.
 		if len(first.Remaining) == len(input) {
-			return Failure[Input, []Output](NewError(input, "Many1"), input)
+			return Failure[Input, Acc](NewError(input, "Fold1"), input)
 		}
 
-		results := []Output{first.Output}
+		acc := fn(init, first.Output)
 		remaining := first.Remaining
 
 		for {
 			res := parse(remaining)
 			if res.Err != nil {
+				if res.Err.IsFatal() {
+					return Failure[Input, Acc](res.Err, input)
+				}
+
+				return Success(acc, remaining)
+			}
+
+			// Checking for infinite loops, if nothing was consumed,
+			// the provided parser would make us go around in circles.
+			if len(res.Remaining) == len(remaining) {
+				return Failure[Input, Acc](NewError(input, "Fold1"), input)
+			}
+
+			acc = fn(acc, res.Output)
+			remaining = res.Remaining
+		}
+	}
+}
+
+// ChainL1 parses one or more operands separated by operators, and combines them
+// from the left using the functions the operator parser produces: "1-2-3" is
+// evaluated as "(1-2)-3". It allows parsing left associative binary expressions
+// without describing a whole grammar using Expression.
+//
+// An operator which isn't followed by an operand is not consumed, and is left
+// in the remaining input. ChainL1 fails if the first operand doesn't match.
+//
+// Note that ChainL1 will fail if an operator and its operand both accept empty
+// inputs, in order to prevent infinite loops.
+func ChainL1[Input Bytes, Output any](
+	operand Parser[Input, Output],
+	operator Parser[Input, func(Output, Output) Output],
+) Parser[Input, Output] {
+	return func(input Input) Result[Output, Input] {
+		first := operand(input)
+		if first.Err != nil {
+			return Failure[Input, Output](first.Err, input)
+		}
+
+		acc, remaining := first.Output, first.Remaining
+		for {
+			combine, next, err := chainLink(operand, operator, remaining, "ChainL1")
+			if err != nil {
+				return Failure[Input, Output](err, input)
+			}
+
+			if combine == nil {
+				return Success(acc, remaining)
+			}
+
+			acc, remaining = combine(acc, next.Output), next.Remaining
+		}
+	}
+}
+
+// ChainR1 behaves like ChainL1, but combines the operands from the right: "2^3^2"
+// is evaluated as "2^(3^2)". It allows parsing right associative binary expressions.
+func ChainR1[Input Bytes, Output any](
+	operand Parser[Input, Output],
+	operator Parser[Input, func(Output, Output) Output],
+) Parser[Input, Output] {
+	return func(input Input) Result[Output, Input] {
+		first := operand(input)
+		if first.Err != nil {
+			return Failure[Input, Output](first.Err, input)
+		}
+
+		operands := []Output{first.Output}
+		var combiners []func(Output, Output) Output
+
+		remaining := first.Remaining
+		for {
+			combine, next, err := chainLink(operand, operator, remaining, "ChainR1")
+			if err != nil {
+				return Failure[Input, Output](err, input)
+			}
+
+			if combine == nil {
+				break
+			}
+
+			operands = append(operands, next.Output)
+			combiners = append(combiners, combine)
+			remaining = next.Remaining
+		}
+
+		acc := operands[len(operands)-1]
+		for idx := len(combiners) - 1; idx >= 0; idx-- {
+			acc = combiners[idx](operands[idx], acc)
+		}
+
+		return Success(acc, remaining)
+	}
+}
+
+// chainLink parses an operator followed by an operand, as found between the
+// operands of ChainL1 and ChainR1, and returns the operator's combining function
+// along with the operand's Result. If either doesn't match, the returned function
+// is nil. Fatal errors are returned as is. The provided name is used to produce
+// error Results.
+func chainLink[Input Bytes, Output any](
+	operand Parser[Input, Output],
+	operator Parser[Input, func(Output, Output) Output],
+	input Input,
+	name string,
+) (func(Output, Output) Output, Result[Output, Input], *Error[Input]) {
+	op := operator(input)
+	if op.Err != nil {
+		if op.Err.IsFatal() {
+			return nil, Result[Output, Input]{}, op.Err
+		}
+
+		return nil, Result[Output, Input]{}, nil
+	}
+
+	next := operand(op.Remaining)
+	if next.Err != nil {
+		if next.Err.IsFatal() {
+			return nil, next, next.Err
+		}
+
+		return nil, next, nil
+	}
+
+	// Checking for infinite loops, if nothing was consumed,
+	// the provided parsers would make us go around in circles.
+	if len(next.Remaining) == len(input) {
+		return nil, next, NewError(input, name)
+	}
+
+	return op.Output, next, nil
+}
+
+// ManyIndexed applies a parser repeatedly until it fails, and transforms each of
+// its results using the provided function, which is also passed the zero-based
+// index of the element. The transformed values are returned as a slice in the
+// Result's Output.
+//
+// If the provided function returns an error, the operation fails and the Result
+// will contain an error.
+//
+// Note that ManyIndexed will succeed even if the parser fails to match at all. It will
+// however fail if the provided parser accepts empty inputs (such as `Digit0`, or
+// `Alpha0`) in order to prevent infinite loops.
+func ManyIndexed[Input Bytes, Output, MapperOutput any](
+	parse Parser[Input, Output],
+	fn func(index int, output Output) (MapperOutput, error),
+) Parser[Input, []MapperOutput] {
+	return func(input Input) Result[[]MapperOutput, Input] {
+		results := []MapperOutput{}
+
+		remaining := input
+		for {
+			res := parse(remaining)
+			if res.Err != nil {
+				if res.Err.IsFatal() {
+					return Failure[Input, []MapperOutput](res.Err, input)
+				}
+
 				return Success(results, remaining)
 			}
 
 			// Checking for infinite loops, if nothing was consumed,
 			// the provided parser would make us go around in circles.
 			if len(res.Remaining) == len(remaining) {
-				return Failure[Input, []Output](NewError(input, "Many1"), input)
+				return Failure[Input, []MapperOutput](NewError(input, "ManyIndexed"), input)
+			}
+
+			output, err := fn(len(results), res.Output)
+			if err != nil {
+				return Failure[Input, []MapperOutput](NewError(remaining, err.Error()), input)
+			}
+
+			results = append(results, output)
+			remaining = res.Remaining
+		}
+	}
+}
+
+// ManyMN applies a parser repeatedly, at least `atLeast` times and at most `atMost`
+// times, and returns a slice of all the results as the Result's Output.
+//
+// If the provided parser cannot be applied at least `atLeast` times, or if `atLeast`
+// is greater than `atMost`, the operation fails and the Result will contain an error.
+//
+// Note that ManyMN will fail if the provided parser accepts empty
+// inputs (such as `Digit0`, or `Alpha0`) in order to prevent infinite loops.
+func ManyMN[Input Bytes, Output any](atLeast, atMost uint, parse Parser[Input, Output]) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		if atLeast > atMost {
+			return Failure[Input, []Output](NewError(input, "ManyMN"), input)
+		}
+
+		results := make([]Output, 0, preallocation(atLeast))
+
+		remaining := input
+		for count := uint(0); count < atMost; count++ {
+			res := parse(remaining)
+			if res.Err != nil {
+				if res.Err.IsFatal() {
+					return Failure[Input, []Output](res.Err, input)
+				}
+
+				break
+			}
+
+			// Checking for infinite loops, if nothing was consumed,
+			// the provided parser would make us go around in circles.
+			if len(res.Remaining) == len(remaining) {
+				return Failure[Input, []Output](NewError(input, "ManyMN"), input)
+			}
+
+			results = append(results, res.Output)
+			remaining = res.Remaining
+		}
+
+		if uint(len(results)) < atLeast {
+			return Failure[Input, []Output](NewError(input, "ManyMN"), input)
+		}
+
+		return Success(results, remaining)
+	}
+}
+
+// maxPreallocation is the largest capacity preallocated from the minimum number
+// of results a repetition is bound to, which can be arbitrarily large.
+const maxPreallocation = 64
+
+// preallocation returns the capacity to preallocate for the results of a
+// repetition producing at least the provided number of them.
+func preallocation(atLeast uint) int {
+	if atLeast > maxPreallocation {
+		return maxPreallocation
+	}
+
+	return int(atLeast)
+}
+
+// ManyTill applies a parser repeatedly until the provided end parser succeeds,
+// and returns a slice of all the results as the Result's Output. The input
+// matched by the end parser is consumed, but its output is discarded.
+//
+// If the parser fails before the end parser could match, the operation fails
+// and the Result will contain an error.
+//
+// Note that ManyTill will fail if the provided parser accepts empty
+// inputs (such as `Digit0`, or `Alpha0`) in order to prevent infinite loops.
+func ManyTill[Input Bytes, Output, EndOutput any](
+	parse Parser[Input, Output],
+	end Parser[Input, EndOutput],
+) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		results := []Output{}
+
+		remaining := input
+		for {
+			endResult := end(remaining)
+			if endResult.Err == nil {
+				return Success(results, endResult.Remaining)
+			}
+
+			if endResult.Err.IsFatal() {
+				return Failure[Input, []Output](endResult.Err, input)
+			}
+
+			res := parse(remaining)
+			if res.Err != nil {
+				if res.Err.IsFatal() {
+					return Failure[Input, []Output](res.Err, input)
+				}
+
+				return Failure[Input, []Output](NewError(input, "ManyTill"), input)
+			}
+
+			// Checking for infinite loops, if nothing was consumed,
+			// the provided parser would make us go around in circles.
+			if len(res.Remaining) == len(remaining) {
+				return Failure[Input, []Output](NewError(input, "ManyTill"), input)
 			}
 
 			results = append(results, res.Output)
@@ -95,6 +518,77 @@ func Many1[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, [
 	}
 }
 
+// SkipMany0 applies a parser repeatedly until it fails, and discards all of its
+// results. Unlike Many0, it does not allocate a slice to hold them, which makes
+// it well suited to skip over runs of whitespace or comments between tokens.
+//
+// Note that SkipMany0 will succeed even if the parser fails to match at all. It will
+// however fail if the provided parser accepts empty inputs (such as `Digit0`, or
+// `Alpha0`) in order to prevent infinite loops.
+func SkipMany0[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, struct{}] {
+	return func(input Input) Result[struct{}, Input] {
+		remaining := input
+		for {
+			res := parse(remaining)
+			if res.Err != nil {
+				if res.Err.IsFatal() {
+					return Failure[Input, struct{}](res.Err, input)
+				}
+
+				return Success(struct{}{}, remaining)
+			}
+
+			// Checking for infinite loops, if nothing was consumed,
+			// the provided parser would make us go around in circles.
+			if len(res.Remaining) == len(remaining) {
+				return Failure[Input, struct{}](NewError(input, "SkipMany0"), input)
+			}
+
+			remaining = res.Remaining
+		}
+	}
+}
+
+// SkipMany1 applies a parser repeatedly until it fails, and discards all of its
+// results. SkipMany1 will fail if the parser fails to match at least once.
+//
+// Note that SkipMany1 will fail if the provided parser accepts empty
+// inputs (such as `Digit0`, or `Alpha0`) in order to prevent infinite loops.
+func SkipMany1[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, struct{}] {
+	return func(input Input) Result[struct{}, Input] {
+		first := parse(input)
+		if first.Err != nil {
+			return Failure[Input, struct{}](first.Err, input)
+		}
+
+		// Checking for infinite loops, if nothing was consumed,
+		// the provided parser would make us go around in circles.
+		if len(first.Remaining) == len(input) {
+			return Failure[Input, struct{}](NewError(input, "SkipMany1"), input)
+		}
+
+		remaining := first.Remaining
+		for {
+			res := parse(remaining)
+			if res.Err != nil {
+				if res.Err.IsFatal() {
+					return Failure[Input, struct{}](res.Err, input)
+				}
+
+				return Success(struct{}{}, remaining)
+			}
+
+			// Checking for infinite loops, if nothing was consumed,
+			// the provided parser would make us go around in circles.
+			if len(res.Remaining) == len(remaining) {
+				return Failure[Input, struct{}](NewError(input, "SkipMany1"), input)
+			}
+
+			remaining = res.Remaining
+		}
+	}
+}
+
 // SeparatedList0 applies an element parser and a separator parser repeatedly in order
 // to produce a list of elements.
 //
@@ -106,100 +600,595 @@ func Many1[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, [
 // from the provided main parser, it will succeed even if the separator parser fails to
 // match at all. It will however fail if the provided separator parser accepts empty
 // inputs in order to prevent infinite loops.
-func SeparatedList0[Input Bytes, Output any, S Separator](
+//
+// The output of the separator parser is discarded, and can thus be of any type.
+func SeparatedList0[Input Bytes, Output, S any](
 	parse Parser[Input, Output],
 	separator Parser[Input, S],
 ) Parser[Input, []Output] {
 	return func(input Input) Result[[]Output, Input] {
-		results := []Output{}
+		return separatedList(input, parse, separator, 0, math.MaxUint, 0, false, false, "SeparatedList0")
+	}
+}
 
-		res := parse(input)
-		if res.Err != nil {
-			return Success(results, input)
+// SeparatedList1 applies an element parser and a separator parser repeatedly in order
+// to produce a list of elements.
+//
+// Note that SeparatedList1 will fail if the element parser fails to match at all.
+//
+// Because the `SeparatedList1` is really looking to produce a list of elements resulting
+// from the provided main parser, it will succeed even if the separator parser fails to
+// match at all.
+//
+// The output of the separator parser is discarded, and can thus be of any type.
+func SeparatedList1[Input Bytes, Output, S any](
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return separatedList(input, parse, separator, 1, math.MaxUint, 0, false, false, "SeparatedList1")
+	}
+}
+
+// SeparatedList0Cap behaves like SeparatedList0, but preallocates the results
+// slice with the provided capacity. When the expected number of elements is known,
+// or can be estimated, it avoids growing the slice repeatedly while parsing.
+func SeparatedList0Cap[Input Bytes, Output, S any](
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+	capacity uint,
+) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return separatedList(input, parse, separator, 0, math.MaxUint, capacity, false, false, "SeparatedList0Cap")
+	}
+}
+
+// SeparatedList1Cap behaves like SeparatedList1, but preallocates the results
+// slice with the provided capacity. When the expected number of elements is known,
+// or can be estimated, it avoids growing the slice repeatedly while parsing.
+func SeparatedList1Cap[Input Bytes, Output, S any](
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+	capacity uint,
+) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return separatedList(input, parse, separator, 1, math.MaxUint, capacity, false, false, "SeparatedList1Cap")
+	}
+}
+
+// SeparatedListMN applies an element parser and a separator parser repeatedly in order
+// to produce a list of at least `atLeast`, and at most `atMost` elements. Once `atMost`
+// elements have been parsed, any following separator is left in the Result's Remaining.
+//
+// If fewer than `atLeast` elements could be parsed, or if `atLeast` is greater than
+// `atMost`, the operation fails and the Result will contain an error stating how many
+// elements were expected, and found.
+//
+// Note that SeparatedListMN will fail if the provided element or separator parsers
+// accept empty inputs in order to prevent infinite loops.
+func SeparatedListMN[Input Bytes, Output, S any](
+	atLeast, atMost uint,
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return separatedList(input, parse, separator, atLeast, atMost, uint(preallocation(atLeast)), false, true, "SeparatedListMN")
+	}
+}
+
+// SeparatedListTrailing0 applies an element parser and a separator parser repeatedly
+// in order to produce a list of elements. Unlike SeparatedList0, it accepts, and
+// consumes, an optional trailing separator following the last element of the list.
+//
+// Note that SeparatedListTrailing0 will succeed even if the element parser fails to
+// match at all. It will however fail if the provided element or separator parsers
+// accept empty inputs in order to prevent infinite loops.
+func SeparatedListTrailing0[Input Bytes, Output, S any](
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return separatedList(input, parse, separator, 0, math.MaxUint, 0, true, false, "SeparatedListTrailing0")
+	}
+}
+
+// SeparatedListTrailing1 applies an element parser and a separator parser repeatedly
+// in order to produce a list of elements. Unlike SeparatedList1, it accepts, and
+// consumes, an optional trailing separator following the last element of the list.
+//
+// Note that SeparatedListTrailing1 will fail if the element parser fails to match at
+// all, or if the provided element or separator parsers accept empty inputs in order
+// to prevent infinite loops.
+func SeparatedListTrailing1[Input Bytes, Output, S any](
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return separatedList(input, parse, separator, 1, math.MaxUint, 0, true, false, "SeparatedListTrailing1")
+	}
+}
+
+// separatedList holds the logic shared by the separated list combinators. It
+// parses at least `atLeast`, and at most `atMost` elements separated by the
+// separator parser into a slice preallocated with the provided capacity, and
+// consumes a trailing separator if `trailing` is true. The provided name is
+// used to produce error Results, which state how many elements were expected,
+// and found, if `counted` is true.
+func separatedList[Input Bytes, Output, SeparatorOutput any](
+	input Input,
+	parse Parser[Input, Output],
+	separator Parser[Input, SeparatorOutput],
+	atLeast, atMost uint,
+	capacity uint,
+	trailing, counted bool,
+	name string,
+) Result[[]Output, Input] {
+	results := make([]Output, 0, int(capacity))
+
+	if atLeast > atMost {
+		return Failure[Input, []Output](NewError(input, name), input)
+	}
+
+	res := parse(input)
+	if res.Err != nil && res.Err.IsFatal() {
+		return Failure[Input, []Output](res.Err, input)
+	}
+
+	if res.Err != nil || atMost == 0 {
+		if atLeast > 0 && counted {
+			return Failure[Input, []Output](separatedListCountError(input, name, atLeast, 0), input)
+		}
+
+		if atLeast > 0 {
+			return Failure[Input, []Output](res.Err, input)
+		}
+
+		return Success(results, input)
+	}
+
+	// Checking for infinite loops, if nothing was consumed,
+	// the provided parser would make us go around in circles.
+	if len(res.Remaining) == len(input) {
+		return Failure[Input, []Output](NewError(input, name), input)
+	}
+
+	results = append(results, res.Output)
+	remaining := res.Remaining
+
+	for uint(len(results)) < atMost {
+		separatorResult := separator(remaining)
+		if separatorResult.Err != nil {
+			if separatorResult.Err.IsFatal() {
+				return Failure[Input, []Output](separatorResult.Err, input)
+			}
+
+			break
 		}
 
 		// Checking for infinite loops, if nothing was consumed,
 		// the provided parser would make us go around in circles.
-		if len(res.Remaining) == len(input) {
-			return Failure[Input, []Output](NewError(input, "SeparatedList0"), input)
+		if len(separatorResult.Remaining) == len(remaining) {
+			return Failure[Input, []Output](NewError(input, name), input)
 		}
 
-		results = append(results, res.Output)
-		remaining := res.Remaining
+		parserResult := parse(separatorResult.Remaining)
+		if parserResult.Err != nil {
+			if parserResult.Err.IsFatal() {
+				return Failure[Input, []Output](parserResult.Err, input)
+			}
+
+			if trailing {
+				remaining = separatorResult.Remaining
+			}
+
+			break
+		}
+
+		results = append(results, parserResult.Output)
+		remaining = parserResult.Remaining
+	}
+
+	if uint(len(results)) < atLeast {
+		if !counted {
+			return Failure[Input, []Output](NewError(input, name), input)
+		}
+
+		return Failure[Input, []Output](separatedListCountError(input, name, atLeast, len(results)), input)
+	}
 
+	return Success(results, remaining)
+}
+
+// separatedListCountError produces an error describing how many elements a
+// separated list combinator expected, and how many it found.
+func separatedListCountError[Input Bytes](input Input, name string, atLeast uint, found int) *Error[Input] {
+	elements := "elements"
+	if atLeast == 1 {
+		elements = "element"
+	}
+
+	return NewError(input, fmt.Sprintf("%s with at least %d %s, found %d", name, atLeast, elements, found))
+}
+
+// SeparatedFold0 applies an element parser and a separator parser repeatedly, and
+// reduces each of the elements into an accumulator using the provided function as
+// they are parsed. The accumulator is returned as the Result's Output.
+//
+// The init function is called once per parse to produce the accumulator's initial
+// value, which allows reference types such as maps to be used as accumulators. If
+// the optional separatorFn function is not nil, separators are reduced into the
+// accumulator too.
+//
+// Note that SeparatedFold0 will succeed even if the element parser fails to match
+// at all. It will however fail if the provided element or separator parsers accept
+// empty inputs in order to prevent infinite loops.
+func SeparatedFold0[Input Bytes, Output, S, Acc any](
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+	init func() Acc,
+	fn func(Acc, Output) Acc,
+	separatorFn func(Acc, S) Acc,
+) Parser[Input, Acc] {
+	return func(input Input) Result[Acc, Input] {
+		return separatedFold(input, parse, separator, init, fn, separatorFn, false, "SeparatedFold0")
+	}
+}
+
+// SeparatedFold1 applies an element parser and a separator parser repeatedly, and
+// reduces each of the elements into an accumulator using the provided function as
+// they are parsed. The accumulator is returned as the Result's Output.
+//
+// The init function is called once per parse to produce the accumulator's initial
+// value, which allows reference types such as maps to be used as accumulators. If
+// the optional separatorFn function is not nil, separators are reduced into the
+// accumulator too.
+//
+// Note that SeparatedFold1 will fail if the element parser fails to match at all,
+// or if the provided element or separator parsers accept empty inputs in order to
+// prevent infinite loops.
+func SeparatedFold1[Input Bytes, Output, S, Acc any](
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+	init func() Acc,
+	fn func(Acc, Output) Acc,
+	separatorFn func(Acc, S) Acc,
+) Parser[Input, Acc] {
+	return func(input Input) Result[Acc, Input] {
+		return separatedFold(input, parse, separator, init, fn, separatorFn, true, "SeparatedFold1")
+	}
+}
+
+// separatedFold holds the logic shared by the separated fold combinators.
+func separatedFold[Input Bytes, Output, S, Acc any](
+	input Input,
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+	init func() Acc,
+	fn func(Acc, Output) Acc,
+	separatorFn func(Acc, S) Acc,
+	required bool,
+	name string,
+) Result[Acc, Input] {
+	acc := init()
+
+	res := parse(input)
+	if res.Err != nil {
+		if required || res.Err.IsFatal() {
+			return Failure[Input, Acc](res.Err, input)
+		}
+
+		return Success(acc, input)
+	}
+
+	// Checking for infinite loops, if nothing was consumed,
+	// the provided parser would make us go around in circles.
+	if len(res.Remaining) == len(input) {
+		return Failure[Input, Acc](NewError(input, name), input)
+	}
+
+	acc = fn(acc, res.Output)
+	remaining := res.Remaining
+
+	for {
+		separatorResult := separator(remaining)
+		if separatorResult.Err != nil {
+			if separatorResult.Err.IsFatal() {
+				return Failure[Input, Acc](separatorResult.Err, input)
+			}
+
+			return Success(acc, remaining)
+		}
+
+		// Checking for infinite loops, if nothing was consumed,
+		// the provided parser would make us go around in circles.
+		if len(separatorResult.Remaining) == len(remaining) {
+			return Failure[Input, Acc](NewError(input, name), input)
+		}
+
+		parserResult := parse(separatorResult.Remaining)
+		if parserResult.Err != nil {
+			if parserResult.Err.IsFatal() {
+				return Failure[Input, Acc](parserResult.Err, input)
+			}
+
+			return Success(acc, remaining)
+		}
+
+		if separatorFn != nil {
+			acc = separatorFn(acc, separatorResult.Output)
+		}
+
+		acc = fn(acc, parserResult.Output)
+		remaining = parserResult.Remaining
+	}
+}
+
+// DuplicateKeyPolicy describes how KeyValues handles keys found more than once.
+type DuplicateKeyPolicy uint8
+
+const (
+	// DuplicateKeyOverwrite keeps the value of the last occurrence of a key, as
+	// most JSON decoders do.
+	DuplicateKeyOverwrite DuplicateKeyPolicy = iota
+
+	// DuplicateKeyKeepFirst keeps the value of the first occurrence of a key,
+	// and ignores the following ones.
+	DuplicateKeyKeepFirst
+
+	// DuplicateKeyReject fails with a fatal error holding a DuplicateKeyError
+	// when a key is found more than once.
+	DuplicateKeyReject
+)
+
+// KeyValuesOption configures the KeyValues combinator.
+type KeyValuesOption func(*keyValuesOptions)
+
+// keyValuesOptions holds the configuration of the KeyValues combinator.
+type keyValuesOptions struct {
+	duplicates DuplicateKeyPolicy
+}
+
+// WithDuplicateKeys sets how KeyValues handles keys found more than once. It
+// defaults to DuplicateKeyOverwrite.
+func WithDuplicateKeys(policy DuplicateKeyPolicy) KeyValuesOption {
+	return func(options *keyValuesOptions) {
+		options.duplicates = policy
+	}
+}
+
+// KeyValues applies KeyValue, and the pair separator parser, repeatedly, in order
+// to parse a list of key/value pairs, such as "a=1&b=2", straight into a map. Keys
+// found more than once are handled according to the duplicate key policy set
+// using the WithDuplicateKeys option.
+//
+// Note that KeyValues will succeed, and produce an empty map, even if no pair
+// could be parsed. It will however fail if the provided pair or pair separator
+// parsers accept empty inputs in order to prevent infinite loops.
+func KeyValues[Input Bytes, K comparable, S, V, PS any](
+	key Parser[Input, K],
+	separator Parser[Input, S],
+	value Parser[Input, V],
+	pairSeparator Parser[Input, PS],
+	options ...KeyValuesOption,
+) Parser[Input, map[K]V] {
+	var config keyValuesOptions
+	for _, option := range options {
+		option(&config)
+	}
+
+	pair := KeyValue(key, separator, value)
+
+	return func(input Input) Result[map[K]V, Input] {
+		values := make(map[K]V)
+
+		remaining := input
 		for {
-			separatorResult := separator(remaining)
-			if separatorResult.Err != nil {
-				return Success(results, remaining)
+			start := remaining
+			if len(values) > 0 {
+				separatorResult := pairSeparator(remaining)
+				if separatorResult.Err != nil {
+					if separatorResult.Err.IsFatal() {
+						return Failure[Input, map[K]V](separatorResult.Err, input)
+					}
+
+					return Success(values, remaining)
+				}
+
+				// Checking for infinite loops, if nothing was consumed,
+				// the provided parser would make us go around in circles.
+				if len(separatorResult.Remaining) == len(remaining) {
+					return Failure[Input, map[K]V](NewError(input, "KeyValues"), input)
+				}
+
+				start = separatorResult.Remaining
+			}
+
+			res := pair(start)
+			if res.Err != nil {
+				if res.Err.IsFatal() {
+					return Failure[Input, map[K]V](res.Err, input)
+				}
+
+				return Success(values, remaining)
 			}
 
 			// Checking for infinite loops, if nothing was consumed,
 			// the provided parser would make us go around in circles.
-			if len(separatorResult.Remaining) == len(remaining) {
-				return Failure[Input, []Output](NewError(input, "SeparatedList0"), input)
+			if len(res.Remaining) == len(start) {
+				return Failure[Input, map[K]V](NewError(input, "KeyValues"), input)
 			}
 
-			parserResult := parse(separatorResult.Remaining)
-			if parserResult.Err != nil {
-				return Success(results, remaining)
+			if _, found := values[res.Output.Left]; found {
+				switch config.duplicates {
+				case DuplicateKeyKeepFirst:
+					remaining = res.Remaining
+					continue
+				case DuplicateKeyReject:
+					return Failure[Input, map[K]V](newDuplicateKeyError(start, res.Output.Left), input)
+				}
+			}
+
+			values[res.Output.Left] = res.Output.Right
+			remaining = res.Remaining
+		}
+	}
+}
+
+// LinesOf applies a parser to each line of the input, and returns a slice of the
+// per-line results as the Result's Output. Lines are terminated either by a `\n`,
+// or a `\r\n` sequence; the last line of the input does not need to be terminated.
+//
+// The provided parser is expected to consume the entirety of each line. If it fails
+// to, the operation fails and the Result will contain an error mentioning the
+// (one-based) number of the offending line.
+func LinesOf[Input Bytes, Output any](parse Parser[Input, Output]) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		results := []Output{}
+
+		remaining := input
+		for lineNumber := 1; len(remaining) > 0; lineNumber++ {
+			end := 0
+			for end < len(remaining) && remaining[end] != '\n' {
+				end++
+			}
+
+			next := end
+			if next < len(remaining) {
+				next++
+			}
+
+			if end > 0 && remaining[end-1] == '\r' && end < len(remaining) {
+				end--
+			}
+
+			res := parse(remaining[:end])
+			if res.Err != nil && res.Err.IsFatal() {
+				return Failure[Input, []Output](res.Err, input)
 			}
 
-			results = append(results, parserResult.Output)
+			if res.Err != nil || len(res.Remaining) != 0 {
+				return Failure[Input, []Output](
+					NewError(remaining, fmt.Sprintf("LinesOf at line %d", lineNumber)),
+					input,
+				)
+			}
 
-			remaining = parserResult.Remaining
+			results = append(results, res.Output)
+			remaining = remaining[next:]
 		}
+
+		return Success(results, remaining)
 	}
 }
 
-// SeparatedList1 applies an element parser and a separator parser repeatedly in order
-// to produce a list of elements.
+// SeparatedTerminatedList0 applies an element parser and a separator parser repeatedly
+// in order to produce a list of elements, which must be followed by a match of the
+// terminator parser. The input matched by the terminator is consumed, but its output
+// is discarded.
 //
-// Note that SeparatedList1 will fail if the element parser fails to match at all.
+// After each element, the terminator parser is tried before the separator parser,
+// which allows to tell the end of the list apart from a separator sharing a prefix
+// with the terminator.
 //
-// Because the `SeparatedList1` is really looking to produce a list of elements resulting
-// from the provided main parser, it will succeed even if the separator parser fails to
-// match at all.
-func SeparatedList1[Input Bytes, Output any, S Separator](
+// Note that SeparatedTerminatedList0 will succeed if the terminator directly matches
+// the input. It will however fail if the list is not terminated, or if the provided
+// element or separator parsers accept empty inputs in order to prevent infinite loops.
+func SeparatedTerminatedList0[Input Bytes, Output, S, T any](
 	parse Parser[Input, Output],
 	separator Parser[Input, S],
+	terminator Parser[Input, T],
 ) Parser[Input, []Output] {
 	return func(input Input) Result[[]Output, Input] {
-		results := []Output{}
+		terminatorResult := terminator(input)
+		if terminatorResult.Err == nil {
+			return Success([]Output{}, terminatorResult.Remaining)
+		}
+
+		if terminatorResult.Err.IsFatal() {
+			return Failure[Input, []Output](terminatorResult.Err, input)
+		}
+
+		return separatedTerminatedList(input, parse, separator, terminator, "SeparatedTerminatedList0")
+	}
+}
 
-		res := parse(input)
+// SeparatedTerminatedList1 applies an element parser and a separator parser repeatedly
+// in order to produce a list of at least one element, which must be followed by a match
+// of the terminator parser. The input matched by the terminator is consumed, but its
+// output is discarded.
+//
+// After each element, the terminator parser is tried before the separator parser,
+// which allows to tell the end of the list apart from a separator sharing a prefix
+// with the terminator.
+//
+// Note that SeparatedTerminatedList1 will fail if the element parser fails to match at
+// all, if the list is not terminated, or if the provided element or separator parsers
+// accept empty inputs in order to prevent infinite loops.
+func SeparatedTerminatedList1[Input Bytes, Output, S, T any](
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+	terminator Parser[Input, T],
+) Parser[Input, []Output] {
+	return func(input Input) Result[[]Output, Input] {
+		return separatedTerminatedList(input, parse, separator, terminator, "SeparatedTerminatedList1")
+	}
+}
+
+// separatedTerminatedList holds the logic shared by the separated terminated list
+// combinators. It parses at least one element.
+func separatedTerminatedList[Input Bytes, Output, S, T any](
+	input Input,
+	parse Parser[Input, Output],
+	separator Parser[Input, S],
+	terminator Parser[Input, T],
+	name string,
+) Result[[]Output, Input] {
+	results := []Output{}
+
+	remaining := input
+	for {
+		res := parse(remaining)
 		if res.Err != nil {
-			return Failure[Input, []Output](res.Err, input)
+			if res.Err.IsFatal() {
+				return Failure[Input, []Output](res.Err, input)
+			}
+
+			return Failure[Input, []Output](NewError(input, name), input)
 		}
 
 		// Checking for infinite loops, if nothing was consumed,
 		// the provided parser would make us go around in circles.
-		if len(res.Remaining) == len(input) {
-			return Failure[Input, []Output](NewError(input, "SeparatedList0"), input)
+		if len(res.Remaining) == len(remaining) {
+			return Failure[Input, []Output](NewError(input, name), input)
 		}
 
 		results = append(results, res.Output)
-		remaining := res.Remaining
+		remaining = res.Remaining
 
-		for {
-			separatorResult := separator(remaining)
-			if separatorResult.Err != nil {
-				return Success(results, remaining)
-			}
+		terminatorResult := terminator(remaining)
+		if terminatorResult.Err == nil {
+			return Success(results, terminatorResult.Remaining)
+		}
 
-			// Checking for infinite loops, if nothing was consumed,
-			// the provided parser would make us go around in circles.
-			if len(separatorResult.Remaining) == len(remaining) {
-				return Failure[Input, []Output](NewError(input, "SeparatedList0"), input)
-			}
+		if terminatorResult.Err.IsFatal() {
+			return Failure[Input, []Output](terminatorResult.Err, input)
+		}
 
-			parserResult := parse(separatorResult.Remaining)
-			if parserResult.Err != nil {
-				return Success(results, remaining)
+		separatorResult := separator(remaining)
+		if separatorResult.Err != nil {
+			if separatorResult.Err.IsFatal() {
+				return Failure[Input, []Output](separatorResult.Err, input)
 			}
 
-			results = append(results, parserResult.Output)
+			return Failure[Input, []Output](NewError(input, name), input)
+		}
 
-			remaining = parserResult.Remaining
+		// Checking for infinite loops, if nothing was consumed,
+		// the provided parser would make us go around in circles.
+		if len(separatorResult.Remaining) == len(remaining) {
+			return Failure[Input, []Output](NewError(input, name), input)
 		}
+
+		remaining = separatorResult.Remaining
 	}
 }
@@ -1,6 +1,10 @@
 package gomme
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -57,6 +61,30 @@ func TestCount(t *testing.T) {
 			wantOutput:    nil,
 			wantRemaining: "",
 		},
+		{
+			name:          "zero count should succeed",
+			parser:        Count(Token[string]("abc"), 0),
+			input:         "abcabc",
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: "abcabc",
+		},
+		{
+			name:          "zero count on empty input should succeed",
+			parser:        Count(Token[string]("abc"), 0),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: "",
+		},
+		{
+			name:          "parser accepting empty input on empty input should succeed",
+			parser:        Count(Digit0[string](), 2),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    []string{"", ""},
+			wantRemaining: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -296,88 +324,116 @@ func BenchmarkMany1(b *testing.B) {
 	}
 }
 
-func TestSeparatedList0(t *testing.T) {
+func TestMany0In(t *testing.T) {
 	t.Parallel()
 
-	type args struct {
-		p Parser[string, []string]
-	}
 	testCases := []struct {
 		name          string
-		args          args
+		parser        Parser[string, []string]
 		input         string
 		wantErr       bool
 		wantOutput    []string
 		wantRemaining string
 	}{
 		{
-			name:  "matching parser should succeed",
-			input: "abc,abc,abc",
-			args: args{
-				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
-			},
+			name:          "matching parser should succeed",
+			parser:        Many0In(NewArena[string](0), Lexeme(Alpha1[string]())),
+			input:         "abc def 123",
 			wantErr:       false,
-			wantOutput:    []string{"abc", "abc", "abc"},
-			wantRemaining: "",
+			wantOutput:    []string{"abc", "def"},
+			wantRemaining: "123",
 		},
 		{
-			name:  "matching parser and missing separator should succeed",
-			input: "abc123abc",
-			args: args{
-				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
-			},
+			name:          "no match should succeed",
+			parser:        Many0In(NewArena[string](0), Lexeme(Alpha1[string]())),
+			input:         "123",
 			wantErr:       false,
-			wantOutput:    []string{"abc"},
-			wantRemaining: "123abc",
+			wantOutput:    []string{},
+			wantRemaining: "123",
 		},
 		{
-			name:  "parser with separator but non-matching right side should succeed",
-			input: "abc,def",
-			args: args{
-				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
-			},
+			name:          "empty input should succeed",
+			parser:        Many0In(NewArena[string](0), Lexeme(Alpha1[string]())),
+			input:         "",
 			wantErr:       false,
-			wantOutput:    []string{"abc"},
-			wantRemaining: ",def",
+			wantOutput:    []string{},
+			wantRemaining: "",
 		},
 		{
-			name:  "parser matching on the right of the separator should succeed",
-			input: "def,abc",
-			args: args{
-				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
-			},
-			wantErr:       false,
-			wantOutput:    []string{},
-			wantRemaining: "def,abc",
+			name:          "parser accepting empty input should fail",
+			parser:        Many0In(NewArena[string](0), Digit0[string]()),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    []string(nil),
+			wantRemaining: "abc",
 		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkMany0In(b *testing.B) {
+	parser := Many0In(NewArena[string](0), Lexeme(Alpha1[string]()))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("abc def ghi jkl 123")
+	}
+}
+
+func TestMany1In(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []string]
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
 		{
-			name:  "empty input should succeed",
-			input: "",
-			args: args{
-				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
-			},
+			name:          "matching parser should succeed",
+			parser:        Many1In(NewArena[string](0), Lexeme(Alpha1[string]())),
+			input:         "abc def 123",
 			wantErr:       false,
-			wantOutput:    []string{},
-			wantRemaining: "",
+			wantOutput:    []string{"abc", "def"},
+			wantRemaining: "123",
 		},
 		{
-			name:  "parsing input without separator should succeed",
-			input: "123",
-			args: args{
-				p: SeparatedList0(Digit0[string](), Char[string](',')),
-			},
-			wantErr:       false,
-			wantOutput:    []string{"123"},
-			wantRemaining: "",
+			name:          "no match should fail",
+			parser:        Many1In(NewArena[string](0), Lexeme(Alpha1[string]())),
+			input:         "123",
+			wantErr:       true,
+			wantOutput:    []string(nil),
+			wantRemaining: "123",
 		},
 		{
-			name:  "using a parser accepting empty input should fail",
-			input: "",
-			args: args{
-				p: SeparatedList0(Digit0[string](), Char[string](',')),
-			},
+			name:          "empty input should fail",
+			parser:        Many1In(NewArena[string](0), Lexeme(Alpha1[string]())),
+			input:         "",
 			wantErr:       true,
-			wantOutput:    nil,
+			wantOutput:    []string(nil),
 			wantRemaining: "",
 		},
 	}
@@ -387,14 +443,14 @@ func TestSeparatedList0(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			gotResult := tc.args.p(tc.input)
+			gotResult := tc.parser(tc.input)
 			if (gotResult.Err != nil) != tc.wantErr {
 				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
 			}
 
-			// testify makes it easier comparing slices
 			assert.Equal(t,
-				tc.wantOutput, gotResult.Output,
+				tc.wantOutput,
+				gotResult.Output,
 				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
 			)
 
@@ -405,96 +461,166 @@ func TestSeparatedList0(t *testing.T) {
 	}
 }
 
-func BenchmarkSeparatedList0(t *testing.B) {
-	parser := SeparatedList0(Char[string]('#'), Char[string](','))
+func BenchmarkMany1In(b *testing.B) {
+	parser := Many1In(NewArena[string](0), Lexeme(Alpha1[string]()))
 
-	t.ResetTimer()
-	for i := 0; i < t.N; i++ {
-		parser("#,#,#")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("abc def ghi jkl 123")
 	}
 }
 
-func TestSeparatedList1(t *testing.T) {
+func TestMany0InNested(t *testing.T) {
 	t.Parallel()
 
-	type args struct {
-		p Parser[string, []string]
+	arena := NewArena[any](0)
+	word := Untyped(Lexeme(Alpha1[string]()))
+	group := Map(
+		Delimited(Lexeme(Char[string]('(')), Many0In(arena, word), Lexeme(Char[string](')'))),
+		func(words []any) (any, error) { return words, nil },
+	)
+	parser := Many0In(arena, Alternative(group, word))
+
+	result := parser("a (b c) d (e) ;")
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, []any{"a", []any{"b", "c"}, "d", []any{"e"}}, result.Output)
+	assert.Equal(t, ";", result.Remaining)
+	assert.Empty(t, arena.scratch, "completed lists should be popped from the scratch stack")
+}
+
+func TestMany0FatalError(t *testing.T) {
+	t.Parallel()
+
+	result := Many0(Terminated(Int8[string](), Char[string](',')))("1,2,300,4,")
+
+	assert.Error(t, result.Err)
+	assert.True(t, errors.Is(result.Err, strconv.ErrRange))
+	assert.Equal(t, "1,2,300,4,", result.Remaining)
+}
+
+func TestRepetitionsPropagateFatalErrors(t *testing.T) {
+	t.Parallel()
+
+	element := Terminated(Int8[string](), Char[string](','))
+	add := func(acc, n int8) int8 { return acc + n }
+	index := func(_ int, n int8) (int8, error) { return n, nil }
+
+	testCases := []struct {
+		name  string
+		parse func(input string) *Error[string]
+		input string
+	}{
+		{"Fold0", func(input string) *Error[string] { return Fold0(element, 0, add)(input).Err }, "1,2,300,"},
+		{"Fold1", func(input string) *Error[string] { return Fold1(element, 0, add)(input).Err }, "1,2,300,"},
+		{"ManyIndexed", func(input string) *Error[string] { return ManyIndexed(element, index)(input).Err }, "1,2,300,"},
+		{"ManyMN", func(input string) *Error[string] { return ManyMN(0, 5, element)(input).Err }, "1,2,300,"},
+		{"ManyTill", func(input string) *Error[string] { return ManyTill(element, Char[string](';'))(input).Err }, "1,2,300,;"},
+		{"ManyTill end", func(input string) *Error[string] {
+			return ManyTill(element, Preceded(Char[string]('#'), Int8[string]()))(input).Err
+		}, "1,2,#300"},
+		{"SkipMany0", func(input string) *Error[string] { return SkipMany0(element)(input).Err }, "1,2,300,"},
+		{"SkipMany1", func(input string) *Error[string] { return SkipMany1(element)(input).Err }, "1,2,300,"},
+		{"SeparatedList0", func(input string) *Error[string] {
+			return SeparatedList0(Int8[string](), Char[string](','))(input).Err
+		}, "1,2,300,"},
+		{"SeparatedList0 separator", func(input string) *Error[string] {
+			return SeparatedList0(Char[string]('a'), Int8[string]())(input).Err
+		}, "a1a300a"},
+		{"SeparatedList1", func(input string) *Error[string] {
+			return SeparatedList1(Int8[string](), Char[string](','))(input).Err
+		}, "300,"},
+		{"SeparatedListTrailing0", func(input string) *Error[string] {
+			return SeparatedListTrailing0(Int8[string](), Char[string](','))(input).Err
+		}, "1,2,300,"},
+		{"SeparatedListMN", func(input string) *Error[string] {
+			return SeparatedListMN(0, 5, Int8[string](), Char[string](','))(input).Err
+		}, "1,2,300,"},
+		{"SeparatedFold0", func(input string) *Error[string] {
+			return SeparatedFold0(Int8[string](), Char[string](','), func() int8 { return 0 }, add, nil)(input).Err
+		}, "1,2,300,"},
+		{"SeparatedTerminatedList0", func(input string) *Error[string] {
+			return SeparatedTerminatedList0(Int8[string](), Char[string](','), Char[string](';'))(input).Err
+		}, "1,2,300;"},
+		{"LinesOf", func(input string) *Error[string] { return LinesOf(Int8[string]())(input).Err }, "1\n2\n300\n"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.parse(tc.input)
+
+			var rangeErr *RangeError
+			assert.True(t, err.IsFatal(), "got error %v, want a fatal error", err)
+			assert.True(t, errors.As(err, &rangeErr), "got error %v, want a range error", err)
+		})
 	}
+}
+
+func TestFold0(t *testing.T) {
+	t.Parallel()
+
+	sumDigits := func(acc int, digit rune) int { return acc + int(digit-'0') }
+	digit := Satisfy[string](IsDigit)
+
 	testCases := []struct {
 		name          string
-		args          args
+		parser        Parser[string, int]
 		input         string
 		wantErr       bool
-		wantOutput    []string
+		wantOutput    int
 		wantRemaining string
 	}{
 		{
-			name:  "matching parser should succeed",
-			input: "abc,abc,abc",
-			args: args{
-				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
-			},
+			name:          "matching parser should succeed",
+			parser:        Fold0(digit, 10, sumDigits),
+			input:         "123abc",
 			wantErr:       false,
-			wantOutput:    []string{"abc", "abc", "abc"},
-			wantRemaining: "",
+			wantOutput:    16,
+			wantRemaining: "abc",
 		},
 		{
-			name:  "matching parser and missing separator should succeed",
-			input: "abc123abc",
-			args: args{
-				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
-			},
+			name:          "matching parser until the end of input should succeed",
+			parser:        Fold0(digit, 0, sumDigits),
+			input:         "999",
 			wantErr:       false,
-			wantOutput:    []string{"abc"},
-			wantRemaining: "123abc",
+			wantOutput:    27,
+			wantRemaining: "",
 		},
 		{
-			name:  "parser with separator but non-matching right side should succeed",
-			input: "abc,def",
-			args: args{
-				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
-			},
+			name:          "no match should succeed",
+			parser:        Fold0(digit, 10, sumDigits),
+			input:         "abc",
 			wantErr:       false,
-			wantOutput:    []string{"abc"},
-			wantRemaining: ",def",
-		},
-		{
-			name:  "parser matching on the right of the separator should succeed",
-			input: "def,abc",
-			args: args{
-				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
-			},
-			wantErr:       true,
-			wantOutput:    nil,
-			wantRemaining: "def,abc",
+			wantOutput:    10,
+			wantRemaining: "abc",
 		},
 		{
-			name:  "empty input should fail",
-			input: "",
-			args: args{
-				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
-			},
-			wantErr:       true,
-			wantOutput:    nil,
+			name:          "empty input should succeed",
+			parser:        Fold0(digit, 10, sumDigits),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    10,
 			wantRemaining: "",
 		},
 	}
+
 	for _, tc := range testCases {
 		tc := tc
 
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			gotResult := tc.args.p(tc.input)
+			gotResult := tc.parser(tc.input)
 			if (gotResult.Err != nil) != tc.wantErr {
 				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
 			}
 
-			// testify makes it easier comparing slices
-			assert.Equal(t,
-				tc.wantOutput, gotResult.Output,
-				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
-			)
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
 
 			if gotResult.Remaining != tc.wantRemaining {
 				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
@@ -503,11 +629,2003 @@ func TestSeparatedList1(t *testing.T) {
 	}
 }
 
-func BenchmarkSeparatedList1(t *testing.B) {
-	parser := SeparatedList1(Char[string]('#'), Char[string](','))
+func TestFold0DetectsInfiniteLoops(t *testing.T) {
+	t.Parallel()
 
-	t.ResetTimer()
-	for i := 0; i < t.N; i++ {
-		parser("#,#,#")
+	// Digit0 accepts empty input, and would cause an infinite loop if not detected
+	input := "abcdef"
+	parser := Fold0(Digit0[string](), 0, func(acc int, _ string) int { return acc + 1 })
+
+	result := parser(input)
+
+	assert.Error(t, result.Err)
+	assert.Equal(t, 0, result.Output)
+	assert.Equal(t, input, result.Remaining)
+}
+
+func BenchmarkFold0(b *testing.B) {
+	parser := Fold0(Char[string]('#'), 0, func(acc int, _ rune) int { return acc + 1 })
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("###")
+	}
+}
+
+func TestFold1(t *testing.T) {
+	t.Parallel()
+
+	sumDigits := func(acc int, digit rune) int { return acc + int(digit-'0') }
+	digit := Satisfy[string](IsDigit)
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int]
+		input         string
+		wantErr       bool
+		wantOutput    int
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser should succeed",
+			parser:        Fold1(digit, 10, sumDigits),
+			input:         "123abc",
+			wantErr:       false,
+			wantOutput:    16,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "matching parser until the end of input should succeed",
+			parser:        Fold1(digit, 0, sumDigits),
+			input:         "999",
+			wantErr:       false,
+			wantOutput:    27,
+			wantRemaining: "",
+		},
+		{
+			name:          "no match should fail",
+			parser:        Fold1(digit, 10, sumDigits),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        Fold1(digit, 10, sumDigits),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestFold1DetectsInfiniteLoops(t *testing.T) {
+	t.Parallel()
+
+	// Digit0 accepts empty input, and would cause an infinite loop if not detected
+	input := "abcdef"
+	parser := Fold1(Digit0[string](), 0, func(acc int, _ string) int { return acc + 1 })
+
+	result := parser(input)
+
+	assert.Error(t, result.Err)
+	assert.Equal(t, 0, result.Output)
+	assert.Equal(t, input, result.Remaining)
+}
+
+func BenchmarkFold1(b *testing.B) {
+	parser := Fold1(Char[string]('#'), 0, func(acc int, _ rune) int { return acc + 1 })
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("###")
+	}
+}
+
+func TestChainL1(t *testing.T) {
+	t.Parallel()
+
+	subtract := Assign(func(left, right int64) int64 { return left - right }, Char[string]('-'))
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "single operand should succeed",
+			parser:        ChainL1(Int64[string](), subtract),
+			input:         "1abc",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "chained operands should be combined from the left",
+			parser:        ChainL1(Int64[string](), subtract),
+			input:         "10-2-3abc",
+			wantErr:       false,
+			wantOutput:    5,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "operator not followed by an operand should be left in the remaining input",
+			parser:        ChainL1(Int64[string](), subtract),
+			input:         "10-2-abc",
+			wantErr:       false,
+			wantOutput:    8,
+			wantRemaining: "-abc",
+		},
+		{
+			name:          "no operand should fail",
+			parser:        ChainL1(Int64[string](), subtract),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        ChainL1(Int64[string](), subtract),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestChainL1DetectsInfiniteLoops(t *testing.T) {
+	t.Parallel()
+
+	// Both Digit0 and the operator accept empty input, and would cause an
+	// infinite loop if not detected
+	input := "abcdef"
+	operator := Assign(func(left, right string) string { return left + right }, Digit0[string]())
+	parser := ChainL1(Digit0[string](), operator)
+
+	result := parser(input)
+
+	assert.Error(t, result.Err)
+	assert.Equal(t, "", result.Output)
+	assert.Equal(t, input, result.Remaining)
+}
+
+func BenchmarkChainL1(b *testing.B) {
+	parser := ChainL1(Int64[string](), Assign(func(left, right int64) int64 { return left - right }, Char[string]('-')))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("10-2-3-4")
+	}
+}
+
+func TestChainR1(t *testing.T) {
+	t.Parallel()
+
+	subtract := Assign(func(left, right int64) int64 { return left - right }, Char[string]('-'))
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "single operand should succeed",
+			parser:        ChainR1(Int64[string](), subtract),
+			input:         "1abc",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "chained operands should be combined from the right",
+			parser:        ChainR1(Int64[string](), subtract),
+			input:         "10-2-3abc",
+			wantErr:       false,
+			wantOutput:    11,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "operator not followed by an operand should be left in the remaining input",
+			parser:        ChainR1(Int64[string](), subtract),
+			input:         "10-2-abc",
+			wantErr:       false,
+			wantOutput:    8,
+			wantRemaining: "-abc",
+		},
+		{
+			name:          "no operand should fail",
+			parser:        ChainR1(Int64[string](), subtract),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        ChainR1(Int64[string](), subtract),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkChainR1(b *testing.B) {
+	parser := ChainR1(Int64[string](), Assign(func(left, right int64) int64 { return left - right }, Char[string]('-')))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("10-2-3-4")
+	}
+}
+
+func TestManyIndexed(t *testing.T) {
+	t.Parallel()
+
+	column := Terminated(Alphanumeric1[string](), Optional(Char[string](',')))
+	label := func(index int, value string) (string, error) {
+		if index > 2 {
+			return "", errors.New("too many columns")
+		}
+
+		return fmt.Sprintf("%d=%s", index, value), nil
+	}
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []string]
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser should succeed",
+			parser:        ManyIndexed(column, label),
+			input:         "abc,12,d3\n",
+			wantErr:       false,
+			wantOutput:    []string{"0=abc", "1=12", "2=d3"},
+			wantRemaining: "\n",
+		},
+		{
+			name:          "no match should succeed",
+			parser:        ManyIndexed(column, label),
+			input:         "\n",
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: "\n",
+		},
+		{
+			name:          "failing mapper should fail",
+			parser:        ManyIndexed(column, label),
+			input:         "a,b,c,d",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a,b,c,d",
+		},
+		{
+			name:          "empty input should succeed",
+			parser:        ManyIndexed(column, label),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkManyIndexed(b *testing.B) {
+	parser := ManyIndexed(Char[string]('#'), func(index int, c rune) (int, error) { return index, nil })
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("###")
+	}
+}
+
+func TestManyMN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []rune]
+		input         string
+		wantErr       bool
+		wantOutput    []rune
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser within bounds should succeed",
+			parser:        ManyMN(2, 4, Char[string]('#')),
+			input:         "###abc",
+			wantErr:       false,
+			wantOutput:    []rune{'#', '#', '#'},
+			wantRemaining: "abc",
+		},
+		{
+			name:          "matching parser should stop at the upper bound",
+			parser:        ManyMN(2, 4, Char[string]('#')),
+			input:         "######",
+			wantErr:       false,
+			wantOutput:    []rune{'#', '#', '#', '#'},
+			wantRemaining: "##",
+		},
+		{
+			name:          "matching parser exactly the lower bound should succeed",
+			parser:        ManyMN(2, 4, Char[string]('#')),
+			input:         "##abc",
+			wantErr:       false,
+			wantOutput:    []rune{'#', '#'},
+			wantRemaining: "abc",
+		},
+		{
+			name:          "zero lower bound and no match should succeed",
+			parser:        ManyMN(0, 4, Char[string]('#')),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    []rune{},
+			wantRemaining: "abc",
+		},
+		{
+			name:          "matching parser less than the lower bound should fail",
+			parser:        ManyMN(2, 4, Char[string]('#')),
+			input:         "#abc",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "#abc",
+		},
+		{
+			name:          "lower bound greater than upper bound should fail",
+			parser:        ManyMN(4, 2, Char[string]('#')),
+			input:         "####",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "####",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        ManyMN(1, 4, Char[string]('#')),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+		{
+			name:          "matching parser with huge bounds should not preallocate them",
+			parser:        ManyMN(math.MaxUint, math.MaxUint, Char[string]('#')),
+			input:         "###",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "###",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestManyMNDetectsInfiniteLoops(t *testing.T) {
+	t.Parallel()
+
+	// Digit0 accepts empty input, and would cause an infinite loop if not detected
+	input := "abcdef"
+	parser := ManyMN(0, 10, Digit0[string]())
+
+	result := parser(input)
+
+	assert.Error(t, result.Err)
+	assert.Nil(t, result.Output)
+	assert.Equal(t, input, result.Remaining)
+}
+
+func BenchmarkManyMN(b *testing.B) {
+	parser := ManyMN(1, 3, Char[string]('#'))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("###")
+	}
+}
+
+func TestManyTill(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []rune]
+		input         string
+		wantErr       bool
+		wantOutput    []rune
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser followed by end should succeed",
+			parser:        ManyTill(AnyChar[string](), Token[string]("*/")),
+			input:         "abc*/def",
+			wantErr:       false,
+			wantOutput:    []rune{'a', 'b', 'c'},
+			wantRemaining: "def",
+		},
+		{
+			name:          "immediately matching end should succeed",
+			parser:        ManyTill(AnyChar[string](), Token[string]("*/")),
+			input:         "*/def",
+			wantErr:       false,
+			wantOutput:    []rune{},
+			wantRemaining: "def",
+		},
+		{
+			name:          "missing end should fail",
+			parser:        ManyTill(AnyChar[string](), Token[string]("*/")),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "failing parser before end should fail",
+			parser:        ManyTill(Char[string]('#'), Token[string]("*/")),
+			input:         "##abc*/",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "##abc*/",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        ManyTill(AnyChar[string](), Token[string]("*/")),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestManyTillDetectsInfiniteLoops(t *testing.T) {
+	t.Parallel()
+
+	// Digit0 accepts empty input, and would cause an infinite loop if not detected
+	input := "abcdef"
+	parser := ManyTill(Digit0[string](), Token[string]("*/"))
+
+	result := parser(input)
+
+	assert.Error(t, result.Err)
+	assert.Nil(t, result.Output)
+	assert.Equal(t, input, result.Remaining)
+}
+
+func BenchmarkManyTill(b *testing.B) {
+	parser := ManyTill(AnyChar[string](), Token[string]("*/"))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("abc*/")
+	}
+}
+
+func TestSkipMany0(t *testing.T) {
+	t.Parallel()
+
+	blank := Alternative(Whitespace1[string](), Recognize(Delimited(Token[string]("/*"), TakeUntil(Token[string]("*/")), Token[string]("*/"))))
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, struct{}]
+		input         string
+		wantErr       bool
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser should succeed",
+			parser:        SkipMany0(blank),
+			input:         "  /* comment */ \tabc",
+			wantErr:       false,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "no match should succeed",
+			parser:        SkipMany0(blank),
+			input:         "abc",
+			wantErr:       false,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "empty input should succeed",
+			parser:        SkipMany0(blank),
+			input:         "",
+			wantErr:       false,
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestSkipMany0DetectsInfiniteLoops(t *testing.T) {
+	t.Parallel()
+
+	// Digit0 accepts empty input, and would cause an infinite loop if not detected
+	input := "abcdef"
+	parser := SkipMany0(Digit0[string]())
+
+	result := parser(input)
+
+	assert.Error(t, result.Err)
+	assert.Equal(t, input, result.Remaining)
+}
+
+func BenchmarkSkipMany0(b *testing.B) {
+	parser := SkipMany0(Char[string]('#'))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("###")
+	}
+}
+
+func TestSkipMany1(t *testing.T) {
+	t.Parallel()
+
+	blank := Alternative(Whitespace1[string](), Recognize(Delimited(Token[string]("/*"), TakeUntil(Token[string]("*/")), Token[string]("*/"))))
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, struct{}]
+		input         string
+		wantErr       bool
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser should succeed",
+			parser:        SkipMany1(blank),
+			input:         "  /* comment */ \tabc",
+			wantErr:       false,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "no match should fail",
+			parser:        SkipMany1(blank),
+			input:         "abc",
+			wantErr:       true,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        SkipMany1(blank),
+			input:         "",
+			wantErr:       true,
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestSkipMany1DetectsInfiniteLoops(t *testing.T) {
+	t.Parallel()
+
+	// Digit0 accepts empty input, and would cause an infinite loop if not detected
+	input := "abcdef"
+	parser := SkipMany1(Digit0[string]())
+
+	result := parser(input)
+
+	assert.Error(t, result.Err)
+	assert.Equal(t, input, result.Remaining)
+}
+
+func BenchmarkSkipMany1(b *testing.B) {
+	parser := SkipMany1(Char[string]('#'))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("###")
+	}
+}
+
+func TestSeparatedList0(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		p Parser[string, []string]
+	}
+	testCases := []struct {
+		name          string
+		args          args
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:  "matching parser should succeed",
+			input: "abc,abc,abc",
+			args: args{
+				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{"abc", "abc", "abc"},
+			wantRemaining: "",
+		},
+		{
+			name:  "matching parser and missing separator should succeed",
+			input: "abc123abc",
+			args: args{
+				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{"abc"},
+			wantRemaining: "123abc",
+		},
+		{
+			name:  "parser with separator but non-matching right side should succeed",
+			input: "abc,def",
+			args: args{
+				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{"abc"},
+			wantRemaining: ",def",
+		},
+		{
+			name:  "parser matching on the right of the separator should succeed",
+			input: "def,abc",
+			args: args{
+				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: "def,abc",
+		},
+		{
+			name:  "empty input should succeed",
+			input: "",
+			args: args{
+				p: SeparatedList0(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: "",
+		},
+		{
+			name:  "parsing input without separator should succeed",
+			input: "123",
+			args: args{
+				p: SeparatedList0(Digit0[string](), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{"123"},
+			wantRemaining: "",
+		},
+		{
+			name:  "using a parser accepting empty input should fail",
+			input: "",
+			args: args{
+				p: SeparatedList0(Digit0[string](), Char[string](',')),
+			},
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.args.p(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			// testify makes it easier comparing slices
+			assert.Equal(t,
+				tc.wantOutput, gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestSeparatedList0AcceptsAnySeparatorOutput(t *testing.T) {
+	t.Parallel()
+
+	type comma struct{}
+
+	separator := Map(
+		Delimited(Whitespace0[string](), Char[string](','), Whitespace0[string]()),
+		func(rune) (comma, error) { return comma{}, nil },
+	)
+	parser := SeparatedList0(Digit1[string](), separator)
+
+	result := parser("1 , 2,3 ;")
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, []string{"1", "2", "3"}, result.Output)
+	assert.Equal(t, " ;", result.Remaining)
+}
+
+func BenchmarkSeparatedList0(t *testing.B) {
+	parser := SeparatedList0(Char[string]('#'), Char[string](','))
+
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		parser("#,#,#")
+	}
+}
+
+func TestSeparatedList1(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		p Parser[string, []string]
+	}
+	testCases := []struct {
+		name          string
+		args          args
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:  "matching parser should succeed",
+			input: "abc,abc,abc",
+			args: args{
+				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{"abc", "abc", "abc"},
+			wantRemaining: "",
+		},
+		{
+			name:  "matching parser and missing separator should succeed",
+			input: "abc123abc",
+			args: args{
+				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{"abc"},
+			wantRemaining: "123abc",
+		},
+		{
+			name:  "parser with separator but non-matching right side should succeed",
+			input: "abc,def",
+			args: args{
+				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       false,
+			wantOutput:    []string{"abc"},
+			wantRemaining: ",def",
+		},
+		{
+			name:  "parser matching on the right of the separator should succeed",
+			input: "def,abc",
+			args: args{
+				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "def,abc",
+		},
+		{
+			name:  "empty input should fail",
+			input: "",
+			args: args{
+				p: SeparatedList1(Token[string]("abc"), Char[string](',')),
+			},
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.args.p(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			// testify makes it easier comparing slices
+			assert.Equal(t,
+				tc.wantOutput, gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSeparatedList1(t *testing.B) {
+	parser := SeparatedList1(Char[string]('#'), Char[string](','))
+
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		parser("#,#,#")
+	}
+}
+
+func TestSeparatedListTrailing0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []string]
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser without trailing separator should succeed",
+			parser:        SeparatedListTrailing0(Token[string]("abc"), Char[string](',')),
+			input:         "abc,abc,abc",
+			wantErr:       false,
+			wantOutput:    []string{"abc", "abc", "abc"},
+			wantRemaining: "",
+		},
+		{
+			name:          "matching parser with trailing separator should succeed",
+			parser:        SeparatedListTrailing0(Token[string]("abc"), Char[string](',')),
+			input:         "abc,abc,}",
+			wantErr:       false,
+			wantOutput:    []string{"abc", "abc"},
+			wantRemaining: "}",
+		},
+		{
+			name:          "no match should succeed",
+			parser:        SeparatedListTrailing0(Token[string]("abc"), Char[string](',')),
+			input:         ",abc",
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: ",abc",
+		},
+		{
+			name:          "empty input should succeed",
+			parser:        SeparatedListTrailing0(Token[string]("abc"), Char[string](',')),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: "",
+		},
+		{
+			name:          "parser accepting empty input should fail",
+			parser:        SeparatedListTrailing0(Digit0[string](), Char[string](',')),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "abc",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSeparatedListTrailing0(t *testing.B) {
+	parser := SeparatedListTrailing0(Char[string]('#'), Char[string](','))
+
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		parser("#,#,#,")
+	}
+}
+
+func TestSeparatedListTrailing1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []string]
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser without trailing separator should succeed",
+			parser:        SeparatedListTrailing1(Token[string]("abc"), Char[string](',')),
+			input:         "abc,abc,abc",
+			wantErr:       false,
+			wantOutput:    []string{"abc", "abc", "abc"},
+			wantRemaining: "",
+		},
+		{
+			name:          "matching parser with trailing separator should succeed",
+			parser:        SeparatedListTrailing1(Token[string]("abc"), Char[string](',')),
+			input:         "abc,}",
+			wantErr:       false,
+			wantOutput:    []string{"abc"},
+			wantRemaining: "}",
+		},
+		{
+			name:          "no match should fail",
+			parser:        SeparatedListTrailing1(Token[string]("abc"), Char[string](',')),
+			input:         ",abc",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: ",abc",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        SeparatedListTrailing1(Token[string]("abc"), Char[string](',')),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSeparatedListTrailing1(t *testing.B) {
+	parser := SeparatedListTrailing1(Char[string]('#'), Char[string](','))
+
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		parser("#,#,#,")
+	}
+}
+
+func TestSeparatedListMN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []uint8]
+		input         string
+		wantErr       bool
+		wantOutput    []uint8
+		wantRemaining string
+	}{
+		{
+			name:          "exact number of elements should succeed",
+			parser:        SeparatedListMN(4, 4, UInt8[string](), Char[string]('.')),
+			input:         "192.168.0.1",
+			wantErr:       false,
+			wantOutput:    []uint8{192, 168, 0, 1},
+			wantRemaining: "",
+		},
+		{
+			name:          "more than the maximum number of elements should succeed",
+			parser:        SeparatedListMN(4, 4, UInt8[string](), Char[string]('.')),
+			input:         "192.168.0.1.2",
+			wantErr:       false,
+			wantOutput:    []uint8{192, 168, 0, 1},
+			wantRemaining: ".2",
+		},
+		{
+			name:          "number of elements within bounds should succeed",
+			parser:        SeparatedListMN(1, 3, UInt8[string](), Char[string]('.')),
+			input:         "1.2:",
+			wantErr:       false,
+			wantOutput:    []uint8{1, 2},
+			wantRemaining: ":",
+		},
+		{
+			name:          "less than the minimum number of elements should fail",
+			parser:        SeparatedListMN(4, 4, UInt8[string](), Char[string]('.')),
+			input:         "192.168.0",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "192.168.0",
+		},
+		{
+			name:          "minimum greater than maximum should fail",
+			parser:        SeparatedListMN(4, 2, UInt8[string](), Char[string]('.')),
+			input:         "192.168.0.1",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "192.168.0.1",
+		},
+		{
+			name:          "zero bounds should succeed",
+			parser:        SeparatedListMN(0, 0, UInt8[string](), Char[string]('.')),
+			input:         "192.168",
+			wantErr:       false,
+			wantOutput:    []uint8{},
+			wantRemaining: "192.168",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        SeparatedListMN(1, 4, UInt8[string](), Char[string]('.')),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing with huge bounds should not preallocate them",
+			parser:        SeparatedListMN(math.MaxUint, math.MaxUint, UInt8[string](), Char[string](',')),
+			input:         "1,2,3",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "1,2,3",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestSeparatedListMNReportsCount(t *testing.T) {
+	t.Parallel()
+
+	parser := SeparatedListMN(4, 4, UInt8[string](), Char[string]('.'))
+
+	result := parser("192.168.0")
+
+	assert.EqualError(t, result.Err, "expected SeparatedListMN with at least 4 elements, found 3")
+
+	single := SeparatedListMN(1, 2, UInt8[string](), Char[string]('.'))("abc")
+	assert.EqualError(t, single.Err, "expected SeparatedListMN with at least 1 element, found 0")
+
+	// Other separated lists keep reporting the element parser's error.
+	list := SeparatedList1(UInt8[string](), Char[string]('.'))("abc")
+	assert.EqualError(t, list.Err, "expected UInt8")
+}
+
+func BenchmarkSeparatedListMN(t *testing.B) {
+	parser := SeparatedListMN(4, 4, UInt8[string](), Char[string]('.'))
+
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		parser("192.168.0.1")
+	}
+}
+
+func TestSeparatedFold0(t *testing.T) {
+	t.Parallel()
+
+	type kv = PairContainer[string, string]
+
+	member := SeparatedPair(Alpha1[string](), Char[string]('='), Alphanumeric0[string]())
+	newMap := func() map[string]string { return map[string]string{} }
+	insert := func(m map[string]string, p kv) map[string]string {
+		m[p.Left] = p.Right
+		return m
+	}
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, map[string]string]
+		input         string
+		wantErr       bool
+		wantOutput    map[string]string
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser should succeed",
+			parser:        SeparatedFold0(member, Char[string]('&'), newMap, insert, nil),
+			input:         "a=1&b=2&c=#",
+			wantErr:       false,
+			wantOutput:    map[string]string{"a": "1", "b": "2", "c": ""},
+			wantRemaining: "#",
+		},
+		{
+			name:          "separator with non-matching right side should succeed",
+			parser:        SeparatedFold0(member, Char[string]('&'), newMap, insert, nil),
+			input:         "a=1&#",
+			wantErr:       false,
+			wantOutput:    map[string]string{"a": "1"},
+			wantRemaining: "&#",
+		},
+		{
+			name:          "no match should succeed",
+			parser:        SeparatedFold0(member, Char[string]('&'), newMap, insert, nil),
+			input:         "#",
+			wantErr:       false,
+			wantOutput:    map[string]string{},
+			wantRemaining: "#",
+		},
+		{
+			name:          "empty input should succeed",
+			parser:        SeparatedFold0(member, Char[string]('&'), newMap, insert, nil),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    map[string]string{},
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestSeparatedFold0ReducesSeparators(t *testing.T) {
+	t.Parallel()
+
+	// Evaluates a sum of digits, where the separator decides of each term's sign
+	sign := 1
+	parser := SeparatedFold0(
+		Satisfy[string](IsDigit),
+		OneOf[string]('+', '-'),
+		func() int { sign = 1; return 0 },
+		func(acc int, digit rune) int { return acc + sign*int(digit-'0') },
+		func(acc int, operator rune) int {
+			sign = 1
+			if operator == '-' {
+				sign = -1
+			}
+			return acc
+		},
+	)
+
+	result := parser("9-3+1")
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, 7, result.Output)
+	assert.Equal(t, "", result.Remaining)
+}
+
+func BenchmarkSeparatedFold0(t *testing.B) {
+	parser := SeparatedFold0(
+		Char[string]('#'),
+		Char[string](','),
+		func() int { return 0 },
+		func(acc int, _ rune) int { return acc + 1 },
+		nil,
+	)
+
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		parser("#,#,#")
+	}
+}
+
+func TestSeparatedFold1(t *testing.T) {
+	t.Parallel()
+
+	count := func(acc int, _ string) int { return acc + 1 }
+	zero := func() int { return 0 }
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int]
+		input         string
+		wantErr       bool
+		wantOutput    int
+		wantRemaining string
+	}{
+		{
+			name:          "matching parser should succeed",
+			parser:        SeparatedFold1(Token[string]("abc"), Char[string](','), zero, count, nil),
+			input:         "abc,abc,abc",
+			wantErr:       false,
+			wantOutput:    3,
+			wantRemaining: "",
+		},
+		{
+			name:          "no match should fail",
+			parser:        SeparatedFold1(Token[string]("abc"), Char[string](','), zero, count, nil),
+			input:         "def,abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "def,abc",
+		},
+		{
+			name:          "parser accepting empty input should fail",
+			parser:        SeparatedFold1(Digit0[string](), Char[string](','), zero, count, nil),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        SeparatedFold1(Token[string]("abc"), Char[string](','), zero, count, nil),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSeparatedFold1(t *testing.B) {
+	parser := SeparatedFold1(
+		Char[string]('#'),
+		Char[string](','),
+		func() int { return 0 },
+		func(acc int, _ rune) int { return acc + 1 },
+		nil,
+	)
+
+	t.ResetTimer()
+	for i := 0; i < t.N; i++ {
+		parser("#,#,#")
+	}
+}
+
+func TestKeyValues(t *testing.T) {
+	t.Parallel()
+
+	pairs := func(options ...KeyValuesOption) Parser[string, map[string]int64] {
+		return KeyValues(Alpha1[string](), Char[string]('='), Int64[string](), Char[string]('&'), options...)
+	}
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, map[string]int64]
+		input         string
+		wantErr       bool
+		wantOutput    map[string]int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing pairs should succeed",
+			parser:        pairs(),
+			input:         "a=1&b=2&c=3 rest",
+			wantErr:       false,
+			wantOutput:    map[string]int64{"a": 1, "b": 2, "c": 3},
+			wantRemaining: " rest",
+		},
+		{
+			name:          "parsing pairs followed by a dangling separator should leave it",
+			parser:        pairs(),
+			input:         "a=1&",
+			wantErr:       false,
+			wantOutput:    map[string]int64{"a": 1},
+			wantRemaining: "&",
+		},
+		{
+			name:          "parsing no pair should succeed with an empty map",
+			parser:        pairs(),
+			input:         "123",
+			wantErr:       false,
+			wantOutput:    map[string]int64{},
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing duplicate keys should keep the last value by default",
+			parser:        pairs(),
+			input:         "a=1&b=2&a=3",
+			wantErr:       false,
+			wantOutput:    map[string]int64{"a": 3, "b": 2},
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing duplicate keys with DuplicateKeyKeepFirst should keep the first value",
+			parser:        pairs(WithDuplicateKeys(DuplicateKeyKeepFirst)),
+			input:         "a=1&b=2&a=3",
+			wantErr:       false,
+			wantOutput:    map[string]int64{"a": 1, "b": 2},
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing duplicate keys with DuplicateKeyReject should fail",
+			parser:        pairs(WithDuplicateKeys(DuplicateKeyReject)),
+			input:         "a=1&b=2&a=3",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a=1&b=2&a=3",
+		},
+		{
+			name:          "parsing a value overflowing its type should fail",
+			parser:        pairs(),
+			input:         "a=1&b=99999999999999999999",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a=1&b=99999999999999999999",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+		})
+	}
+}
+
+func TestKeyValuesDuplicateKeyError(t *testing.T) {
+	t.Parallel()
+
+	parser := KeyValues(Alpha1[string](), Char[string]('='), Int64[string](), Char[string]('&'), WithDuplicateKeys(DuplicateKeyReject))
+
+	result := parser("a=1&b=2&a=3")
+	assert.True(t, result.Err.IsFatal())
+	assert.Equal(t, "a=3", result.Err.Input)
+
+	var duplicate *DuplicateKeyError
+	assert.True(t, errors.As(result.Err, &duplicate))
+	assert.Equal(t, "a", duplicate.Key)
+	assert.EqualError(t, result.Err, "expected KeyValues: duplicate key a")
+}
+
+func BenchmarkKeyValues(b *testing.B) {
+	parser := KeyValues(Alpha1[string](), Char[string]('='), Int64[string](), Char[string]('&'))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a=1&b=2&c=3")
+	}
+}
+
+func TestLinesOf(t *testing.T) {
+	t.Parallel()
+
+	row := SeparatedList1(Alphanumeric1[string](), Char[string](','))
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, [][]string]
+		input         string
+		wantErr       bool
+		wantOutput    [][]string
+		wantRemaining string
+	}{
+		{
+			name:          "lines terminated by LF should succeed",
+			parser:        LinesOf(row),
+			input:         "a,b\nc,d\n",
+			wantErr:       false,
+			wantOutput:    [][]string{{"a", "b"}, {"c", "d"}},
+			wantRemaining: "",
+		},
+		{
+			name:          "lines terminated by CRLF should succeed",
+			parser:        LinesOf(row),
+			input:         "a,b\r\nc,d\r\n",
+			wantErr:       false,
+			wantOutput:    [][]string{{"a", "b"}, {"c", "d"}},
+			wantRemaining: "",
+		},
+		{
+			name:          "unterminated last line should succeed",
+			parser:        LinesOf(row),
+			input:         "a,b\nc,d",
+			wantErr:       false,
+			wantOutput:    [][]string{{"a", "b"}, {"c", "d"}},
+			wantRemaining: "",
+		},
+		{
+			name:          "partially consumed line should fail",
+			parser:        LinesOf(row),
+			input:         "a,b\nc;d\n",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a,b\nc;d\n",
+		},
+		{
+			name:          "failing line should fail",
+			parser:        LinesOf(row),
+			input:         "a,b\n\nc,d",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a,b\n\nc,d",
+		},
+		{
+			name:          "empty input should succeed",
+			parser:        LinesOf(row),
+			input:         "",
+			wantErr:       false,
+			wantOutput:    [][]string{},
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestLinesOfReportsLineNumber(t *testing.T) {
+	t.Parallel()
+
+	parser := LinesOf(Digit1[string]())
+
+	result := parser("1\r\n2\r\nabc\r\n4")
+
+	assert.EqualError(t, result.Err, "expected LinesOf at line 3")
+	assert.Equal(t, "abc\r\n4", result.Err.Input)
+}
+
+func BenchmarkLinesOf(b *testing.B) {
+	parser := LinesOf(SeparatedList1(Alphanumeric1[string](), Char[string](',')))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a,b\r\nc,d\r\n")
+	}
+}
+
+func TestCapacityHints(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []rune]
+		input         string
+		wantErr       bool
+		wantOutput    []rune
+		wantRemaining string
+		wantCap       int
+	}{
+		{
+			name:          "Many0Cap matching parser should succeed",
+			parser:        Many0Cap(Char[string]('#'), 8),
+			input:         "###abc",
+			wantErr:       false,
+			wantOutput:    []rune{'#', '#', '#'},
+			wantRemaining: "abc",
+			wantCap:       8,
+		},
+		{
+			name:          "Many0Cap no match should succeed",
+			parser:        Many0Cap(Char[string]('#'), 8),
+			input:         "abc",
+			wantErr:       false,
+			wantOutput:    []rune{},
+			wantRemaining: "abc",
+			wantCap:       8,
+		},
+		{
+			name:          "Many1Cap matching parser should succeed",
+			parser:        Many1Cap(Char[string]('#'), 8),
+			input:         "###abc",
+			wantErr:       false,
+			wantOutput:    []rune{'#', '#', '#'},
+			wantRemaining: "abc",
+			wantCap:       8,
+		},
+		{
+			name:          "Many1Cap no match should fail",
+			parser:        Many1Cap(Char[string]('#'), 8),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "abc",
+			wantCap:       0,
+		},
+		{
+			name:          "SeparatedList0Cap matching parser should succeed",
+			parser:        SeparatedList0Cap(Char[string]('#'), Char[string](','), 8),
+			input:         "#,#,#abc",
+			wantErr:       false,
+			wantOutput:    []rune{'#', '#', '#'},
+			wantRemaining: "abc",
+			wantCap:       8,
+		},
+		{
+			name:          "SeparatedList1Cap matching parser should succeed",
+			parser:        SeparatedList1Cap(Char[string]('#'), Char[string](','), 8),
+			input:         "#,#,#abc",
+			wantErr:       false,
+			wantOutput:    []rune{'#', '#', '#'},
+			wantRemaining: "abc",
+			wantCap:       8,
+		},
+		{
+			name:          "SeparatedList1Cap no match should fail",
+			parser:        SeparatedList1Cap(Char[string]('#'), Char[string](','), 8),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "abc",
+			wantCap:       0,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if cap(gotResult.Output) != tc.wantCap {
+				t.Errorf("got capacity %v, want capacity %v", cap(gotResult.Output), tc.wantCap)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkMany0Cap(b *testing.B) {
+	parser := Many0Cap(Char[string]('#'), 16)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("################")
+	}
+}
+
+func BenchmarkSeparatedList0Cap(b *testing.B) {
+	parser := SeparatedList0Cap(Char[string]('#'), Char[string](','), 8)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("#,#,#,#,#,#,#,#")
+	}
+}
+
+func TestSeparatedTerminatedList0(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []string]
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:          "terminated list should succeed",
+			parser:        SeparatedTerminatedList0(Alphanumeric1[string](), Char[string](','), CRLF[string]()),
+			input:         "a,b,c\r\nd,e",
+			wantErr:       false,
+			wantOutput:    []string{"a", "b", "c"},
+			wantRemaining: "d,e",
+		},
+		{
+			name:          "terminator sharing the separator's prefix should succeed",
+			parser:        SeparatedTerminatedList0(Alphanumeric1[string](), Char[string](';'), Token[string](";;")),
+			input:         "a;b;;c",
+			wantErr:       false,
+			wantOutput:    []string{"a", "b"},
+			wantRemaining: "c",
+		},
+		{
+			name:          "immediate terminator should succeed",
+			parser:        SeparatedTerminatedList0(Alphanumeric1[string](), Char[string](','), CRLF[string]()),
+			input:         "\r\na",
+			wantErr:       false,
+			wantOutput:    []string{},
+			wantRemaining: "a",
+		},
+		{
+			name:          "missing terminator should fail",
+			parser:        SeparatedTerminatedList0(Alphanumeric1[string](), Char[string](','), CRLF[string]()),
+			input:         "a,b,c",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a,b,c",
+		},
+		{
+			name:          "separator followed by terminator should fail",
+			parser:        SeparatedTerminatedList0(Alphanumeric1[string](), Char[string](','), CRLF[string]()),
+			input:         "a,b,\r\n",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a,b,\r\n",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        SeparatedTerminatedList0(Alphanumeric1[string](), Char[string](','), CRLF[string]()),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSeparatedTerminatedList0(b *testing.B) {
+	parser := SeparatedTerminatedList0(Alphanumeric1[string](), Char[string](','), CRLF[string]())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a,b,c\r\n")
+	}
+}
+
+func TestSeparatedTerminatedList1(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, []string]
+		input         string
+		wantErr       bool
+		wantOutput    []string
+		wantRemaining string
+	}{
+		{
+			name:          "terminated list should succeed",
+			parser:        SeparatedTerminatedList1(Alphanumeric1[string](), Char[string](','), Char[string](';')),
+			input:         "a,b,c;d",
+			wantErr:       false,
+			wantOutput:    []string{"a", "b", "c"},
+			wantRemaining: "d",
+		},
+		{
+			name:          "immediate terminator should fail",
+			parser:        SeparatedTerminatedList1(Alphanumeric1[string](), Char[string](','), Char[string](';')),
+			input:         ";d",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: ";d",
+		},
+		{
+			name:          "parser accepting empty input should fail",
+			parser:        SeparatedTerminatedList1(Digit0[string](), Char[string](','), Char[string](';')),
+			input:         "a;",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "a;",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        SeparatedTerminatedList1(Alphanumeric1[string](), Char[string](','), Char[string](';')),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSeparatedTerminatedList1(b *testing.B) {
+	parser := SeparatedTerminatedList1(Alphanumeric1[string](), Char[string](','), Char[string](';'))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("a,b,c;")
 	}
 }
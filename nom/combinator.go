@@ -0,0 +1,160 @@
+package nom
+
+import "github.com/oleiade/gomme"
+
+// Alt mirrors nom's alt: it tries the provided parsers in order, and produces
+// the output of the first one which succeeds.
+func Alt[I gomme.Bytes, O any](parsers ...gomme.Parser[I, O]) gomme.Parser[I, O] {
+	return gomme.Alternative(parsers...)
+}
+
+// Tuple mirrors nom's tuple: it applies the provided parsers in order, and
+// produces their outputs.
+func Tuple[I gomme.Bytes, O any](parsers ...gomme.Parser[I, O]) gomme.Parser[I, []O] {
+	return gomme.Sequence(parsers...)
+}
+
+// Pair mirrors nom's pair: it applies both parsers in order, and produces their
+// outputs.
+func Pair[I gomme.Bytes, LO, RO any](first gomme.Parser[I, LO], second gomme.Parser[I, RO]) gomme.Parser[I, gomme.PairContainer[LO, RO]] {
+	return gomme.Pair(first, second)
+}
+
+// SeparatedPair mirrors nom's separated_pair: it applies the three parsers in
+// order, and produces the outputs of the first and the last one.
+func SeparatedPair[I gomme.Bytes, LO, S, RO any](
+	first gomme.Parser[I, LO],
+	separator gomme.Parser[I, S],
+	second gomme.Parser[I, RO],
+) gomme.Parser[I, gomme.PairContainer[LO, RO]] {
+	return gomme.Pair(first, gomme.Preceded(separator, second))
+}
+
+// Preceded mirrors nom's preceded: it applies both parsers in order, and
+// produces the output of the second one.
+func Preceded[I gomme.Bytes, OP, O any](first gomme.Parser[I, OP], second gomme.Parser[I, O]) gomme.Parser[I, O] {
+	return gomme.Preceded(first, second)
+}
+
+// Terminated mirrors nom's terminated: it applies both parsers in order, and
+// produces the output of the first one.
+func Terminated[I gomme.Bytes, O, OS any](first gomme.Parser[I, O], second gomme.Parser[I, OS]) gomme.Parser[I, O] {
+	return gomme.Terminated(first, second)
+}
+
+// Delimited mirrors nom's delimited: it applies the three parsers in order, and
+// produces the output of the second one.
+func Delimited[I gomme.Bytes, OP, O, OS any](
+	first gomme.Parser[I, OP],
+	second gomme.Parser[I, O],
+	third gomme.Parser[I, OS],
+) gomme.Parser[I, O] {
+	return gomme.Delimited(first, second, third)
+}
+
+// Many0 mirrors nom's many0.
+func Many0[I gomme.Bytes, O any](parser gomme.Parser[I, O]) gomme.Parser[I, []O] {
+	return gomme.Many0(parser)
+}
+
+// Many1 mirrors nom's many1.
+func Many1[I gomme.Bytes, O any](parser gomme.Parser[I, O]) gomme.Parser[I, []O] {
+	return gomme.Many1(parser)
+}
+
+// ManyMN mirrors nom's many_m_n: it applies the parser between m and n times.
+func ManyMN[I gomme.Bytes, O any](m, n uint, parser gomme.Parser[I, O]) gomme.Parser[I, []O] {
+	return gomme.ManyMN(m, n, parser)
+}
+
+// ManyTill mirrors nom's many_till: it applies the parser until the end parser
+// succeeds, and produces the parser's outputs, along with the end parser's.
+func ManyTill[I gomme.Bytes, O, E any](parser gomme.Parser[I, O], end gomme.Parser[I, E]) gomme.Parser[I, gomme.PairContainer[[]O, E]] {
+	return gomme.Pair(gomme.ManyTill(parser, gomme.Peek(end)), end)
+}
+
+// Count mirrors nom's count: it applies the parser exactly count times.
+func Count[I gomme.Bytes, O any](parser gomme.Parser[I, O], count uint) gomme.Parser[I, []O] {
+	return gomme.Count(parser, count)
+}
+
+// FoldMany0 mirrors nom's fold_many0: it applies the parser as many times as
+// possible, folding its outputs into an accumulator.
+func FoldMany0[I gomme.Bytes, O, Acc any](parser gomme.Parser[I, O], init func() Acc, fold func(Acc, O) Acc) gomme.Parser[I, Acc] {
+	return func(input I) gomme.Result[Acc, I] {
+		return gomme.Fold0(parser, init(), fold)(input)
+	}
+}
+
+// FoldMany1 mirrors nom's fold_many1: it behaves like FoldMany0, but fails
+// unless the parser succeeds at least once.
+func FoldMany1[I gomme.Bytes, O, Acc any](parser gomme.Parser[I, O], init func() Acc, fold func(Acc, O) Acc) gomme.Parser[I, Acc] {
+	return func(input I) gomme.Result[Acc, I] {
+		return gomme.Fold1(parser, init(), fold)(input)
+	}
+}
+
+// SeparatedList0 mirrors nom's separated_list0. Unlike gomme's SeparatedList0,
+// it takes the separator first.
+func SeparatedList0[I gomme.Bytes, S, O any](separator gomme.Parser[I, S], element gomme.Parser[I, O]) gomme.Parser[I, []O] {
+	return gomme.SeparatedList0(element, separator)
+}
+
+// SeparatedList1 mirrors nom's separated_list1. Unlike gomme's SeparatedList1,
+// it takes the separator first.
+func SeparatedList1[I gomme.Bytes, S, O any](separator gomme.Parser[I, S], element gomme.Parser[I, O]) gomme.Parser[I, []O] {
+	return gomme.SeparatedList1(element, separator)
+}
+
+// Map mirrors nom's map: it converts the parser's output using an infallible
+// function.
+func Map[I gomme.Bytes, O, M any](parser gomme.Parser[I, O], fn func(O) M) gomme.Parser[I, M] {
+	return gomme.Map(parser, func(output O) (M, error) { return fn(output), nil })
+}
+
+// MapRes mirrors nom's map_res: it converts the parser's output using a fallible
+// function, and fails if the function returns an error.
+func MapRes[I gomme.Bytes, O, M any](parser gomme.Parser[I, O], fn func(O) (M, error)) gomme.Parser[I, M] {
+	return gomme.Map(parser, fn)
+}
+
+// Opt mirrors nom's opt: it produces the parser's output, or the zero value if
+// the parser fails, without consuming any input.
+func Opt[I gomme.Bytes, O any](parser gomme.Parser[I, O]) gomme.Parser[I, O] {
+	return gomme.Optional(parser)
+}
+
+// Value mirrors nom's value: it produces the provided value if the parser
+// succeeds.
+func Value[I gomme.Bytes, V, O any](value V, parser gomme.Parser[I, O]) gomme.Parser[I, V] {
+	return gomme.Assign(value, parser)
+}
+
+// Recognize mirrors nom's recognize: it produces the part of the input the
+// parser consumed.
+func Recognize[I gomme.Bytes, O any](parser gomme.Parser[I, O]) gomme.Parser[I, I] {
+	return gomme.Recognize(parser)
+}
+
+// Peek mirrors nom's peek: it produces the parser's output without consuming
+// any input.
+func Peek[I gomme.Bytes, O any](parser gomme.Parser[I, O]) gomme.Parser[I, O] {
+	return gomme.Peek(parser)
+}
+
+// Eof mirrors nom's eof: it succeeds only at the end of the input.
+func Eof[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.EOF[I]()
+}
+
+// LengthData mirrors nom's length_data: it produces as many bytes of the input
+// as the count parser's output.
+func LengthData[I gomme.Bytes, N gomme.Integer](count gomme.Parser[I, N]) gomme.Parser[I, I] {
+	return gomme.LengthData(count)
+}
+
+// LengthValue mirrors nom's length_value: it applies the parser to as many bytes
+// of the input as the count parser's output.
+func LengthValue[I gomme.Bytes, N gomme.Integer, O any](count gomme.Parser[I, N], parser gomme.Parser[I, O]) gomme.Parser[I, O] {
+	return gomme.LengthValue(count, parser)
+}
@@ -0,0 +1,97 @@
+package nom
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+// color is the output of hexColor.
+type color struct {
+	red, green, blue uint8
+}
+
+// hexColor ports the hex color parser of nom's README as is:
+//
+//	fn hex_primary(input: &str) -> IResult<&str, u8> {
+//	    map_res(take_while_m_n(2, 2, is_hex_digit), from_hex)(input)
+//	}
+//
+//	fn hex_color(input: &str) -> IResult<&str, Color> {
+//	    let (input, _) = tag("#")(input)?;
+//	    let (input, (red, green, blue)) = tuple((hex_primary, hex_primary, hex_primary))(input)?;
+//	    Ok((input, Color { red, green, blue }))
+//	}
+func hexColor() gomme.Parser[string, color] {
+	hexPrimary := MapRes(TakeWhileMN[string](2, 2, gomme.IsHexDigit), func(input string) (uint8, error) {
+		value, err := strconv.ParseUint(input, 16, 8)
+		return uint8(value), err
+	})
+
+	return Map(Preceded(Tag[string]("#"), Tuple(hexPrimary, hexPrimary, hexPrimary)), func(primaries []uint8) color {
+		return color{red: primaries[0], green: primaries[1], blue: primaries[2]}
+	})
+}
+
+func TestHexColor(t *testing.T) {
+	t.Parallel()
+
+	result := hexColor()("#2F14DF")
+	assert.Nil(t, result.Err)
+	assert.Equal(t, color{red: 47, green: 20, blue: 223}, result.Output)
+	assert.Equal(t, "", result.Remaining)
+
+	assert.Error(t, hexColor()("#2F14D").Err)
+}
+
+func TestCombinators(t *testing.T) {
+	t.Parallel()
+
+	digits := Map(Digit1[string](), func(input string) int {
+		value, _ := strconv.Atoi(input)
+		return value
+	})
+
+	list := Delimited(Char[string]('['), SeparatedList0(Tag[string](","), digits), Char[string](']'))
+	assert.Equal(t, []int{1, 22, 3}, list("[1,22,3]").Output)
+	assert.Equal(t, []int{}, list("[]").Output)
+	assert.Error(t, Delimited(Char[string]('['), SeparatedList1(Tag[string](","), digits), Char[string](']'))("[]").Err)
+
+	pair := SeparatedPair(Alpha1[string](), Char[string]('='), digits)
+	assert.Equal(t, gomme.PairContainer[string, int]{Left: "a", Right: 1}, pair("a=1").Output)
+
+	till := ManyTill(AnyChar[string](), Tag[string]("end"))
+	result := till("abend!")
+	assert.Nil(t, result.Err)
+	assert.Equal(t, gomme.PairContainer[[]rune, string]{Left: []rune("ab"), Right: "end"}, result.Output)
+	assert.Equal(t, "!", result.Remaining)
+
+	sum := FoldMany0(Terminated(digits, Opt(Char[string]('+'))), func() int { return 0 }, func(acc, value int) int { return acc + value })
+	assert.Equal(t, 6, sum("1+2+3").Output)
+	assert.Equal(t, 0, sum("x").Output)
+	assert.Error(t, FoldMany1(digits, func() int { return 0 }, func(acc, value int) int { return acc + value })("x").Err)
+
+	assert.Equal(t, true, Value(true, Tag[string]("yes"))("yes").Output)
+	assert.Equal(t, "ab", Recognize(Pair(Char[string]('a'), Char[string]('b')))("abc").Output)
+	assert.Equal(t, "12", Recognize(Many1(OneOf[string]("12")))("123").Output)
+	assert.Equal(t, "a", ManyMN(1, 2, Tag[string]("a"))("aaa").Remaining)
+	assert.Len(t, Count(Alpha1[string](), 0)("abc").Output, 0)
+	assert.Nil(t, Eof[string]()("").Err)
+	assert.Equal(t, "ab", Peek(Alpha1[string]())("ab").Remaining)
+	assert.Equal(t, "ab", Alt(Tag[string]("x"), Tag[string]("ab"))("ab").Output)
+	assert.Equal(t, []byte("abc"), LengthData(U8[[]byte]())([]byte("3abcd")).Output)
+	assert.Equal(t, []byte("ab"), LengthValue(U8[[]byte](), Alpha1[[]byte]())([]byte("2ab1")).Output)
+	assert.Error(t, Many0(Digit0[string]())("1").Err)
+}
+
+func BenchmarkHexColor(b *testing.B) {
+	parser := hexColor()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("#2F14DF")
+	}
+}
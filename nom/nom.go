@@ -0,0 +1,265 @@
+// Package nom exposes gomme's parsers and combinators under the names of their
+// equivalents in nom, the Rust parser combinator library, so that the existing
+// nom grammars, and the documentation written about them, can be ported with
+// little more than a change of case: nom's separated_list0 is SeparatedList0,
+// its tag is Tag, and so on.
+//
+// The functions of this package are thin wrappers around gomme's, and produce
+// regular gomme parsers, which can be freely mixed with the ones of the gomme
+// package. Where nom and gomme differ, the functions follow nom's argument
+// order and semantics: Map expects an infallible function, like nom's map, while
+// MapRes expects a fallible one, like nom's map_res.
+//
+// As gomme parsers work on complete inputs, the functions mirror the ones of
+// nom's complete modules, such as nom::bytes::complete and
+// nom::character::complete.
+package nom
+
+import (
+	"strings"
+
+	"github.com/oleiade/gomme"
+)
+
+// Tag mirrors nom's tag: it matches the provided token, and produces it.
+func Tag[I gomme.Bytes](token string) gomme.Parser[I, I] {
+	return gomme.Token[I](token)
+}
+
+// TagNoCase mirrors nom's tag_no_case: it matches the provided token, regardless
+// of case, and produces the matched input.
+func TagNoCase[I gomme.Bytes](token string) gomme.Parser[I, I] {
+	return gomme.TokenNoCase[I](token)
+}
+
+// Take mirrors nom's take: it produces the next count bytes of the input.
+func Take[I gomme.Bytes](count uint) gomme.Parser[I, I] {
+	return gomme.Take[I](count)
+}
+
+// TakeWhile mirrors nom's take_while: it produces the longest, possibly empty,
+// prefix of the input whose characters satisfy the predicate.
+func TakeWhile[I gomme.Bytes](predicate func(rune) bool) gomme.Parser[I, I] {
+	return gomme.TakeTill[I](func(c rune) bool { return !predicate(c) })
+}
+
+// TakeWhile1 mirrors nom's take_while1: it behaves like TakeWhile, but fails if
+// the prefix is empty.
+func TakeWhile1[I gomme.Bytes](predicate func(rune) bool) gomme.Parser[I, I] {
+	return gomme.TakeTill1[I](func(c rune) bool { return !predicate(c) })
+}
+
+// TakeWhileMN mirrors nom's take_while_m_n: it behaves like TakeWhile, but fails
+// unless the prefix is between m and n bytes long, and stops after n bytes.
+func TakeWhileMN[I gomme.Bytes](m, n uint, predicate func(rune) bool) gomme.Parser[I, I] {
+	return gomme.TakeWhileMN[I](m, n, predicate)
+}
+
+// TakeTill mirrors nom's take_till: it produces the longest, possibly empty,
+// prefix of the input whose characters don't satisfy the predicate.
+func TakeTill[I gomme.Bytes](predicate func(rune) bool) gomme.Parser[I, I] {
+	return gomme.TakeTill[I](predicate)
+}
+
+// TakeTill1 mirrors nom's take_till1: it behaves like TakeTill, but fails if the
+// prefix is empty.
+func TakeTill1[I gomme.Bytes](predicate func(rune) bool) gomme.Parser[I, I] {
+	return gomme.TakeTill1[I](predicate)
+}
+
+// TakeUntil mirrors nom's take_until: it produces the input up to, but excluding,
+// the first occurrence of the provided token, and fails if there is none.
+func TakeUntil[I gomme.Bytes](token string) gomme.Parser[I, I] {
+	return gomme.TakeUntilToken[I](token)
+}
+
+// IsA mirrors nom's is_a: it produces the longest, non-empty, prefix of the
+// input made of the provided characters.
+func IsA[I gomme.Bytes](characters string) gomme.Parser[I, I] {
+	return gomme.TakeWhileOneOf[I]([]rune(characters)...)
+}
+
+// IsNot mirrors nom's is_not: it produces the longest, non-empty, prefix of the
+// input made of characters other than the provided ones.
+func IsNot[I gomme.Bytes](characters string) gomme.Parser[I, I] {
+	return gomme.TakeTill1[I](func(c rune) bool { return strings.ContainsRune(characters, c) })
+}
+
+// Char mirrors nom's char: it matches the provided character, and produces it.
+func Char[I gomme.Bytes](character rune) gomme.Parser[I, rune] {
+	return gomme.Char[I](character)
+}
+
+// AnyChar mirrors nom's anychar: it produces the next character of the input.
+func AnyChar[I gomme.Bytes]() gomme.Parser[I, rune] {
+	return gomme.AnyRune[I]()
+}
+
+// OneOf mirrors nom's one_of: it matches any of the provided characters, and
+// produces it.
+func OneOf[I gomme.Bytes](characters string) gomme.Parser[I, rune] {
+	return gomme.OneOfString[I](characters)
+}
+
+// NoneOf mirrors nom's none_of: it matches any character but the provided ones,
+// and produces it.
+func NoneOf[I gomme.Bytes](characters string) gomme.Parser[I, rune] {
+	return gomme.NoneOf[I]([]rune(characters)...)
+}
+
+// Satisfy mirrors nom's satisfy: it matches a character satisfying the
+// predicate, and produces it.
+func Satisfy[I gomme.Bytes](predicate func(rune) bool) gomme.Parser[I, rune] {
+	return gomme.Satisfy[I](predicate)
+}
+
+// Alpha0 mirrors nom's alpha0.
+func Alpha0[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Alpha0[I]()
+}
+
+// Alpha1 mirrors nom's alpha1.
+func Alpha1[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Alpha1[I]()
+}
+
+// Digit0 mirrors nom's digit0.
+func Digit0[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Digit0[I]()
+}
+
+// Digit1 mirrors nom's digit1.
+func Digit1[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Digit1[I]()
+}
+
+// HexDigit0 mirrors nom's hex_digit0.
+func HexDigit0[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.HexDigit0[I]()
+}
+
+// HexDigit1 mirrors nom's hex_digit1.
+func HexDigit1[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.HexDigit1[I]()
+}
+
+// OctDigit0 mirrors nom's oct_digit0.
+func OctDigit0[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.OctDigit0[I]()
+}
+
+// OctDigit1 mirrors nom's oct_digit1.
+func OctDigit1[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.OctDigit1[I]()
+}
+
+// Alphanumeric0 mirrors nom's alphanumeric0.
+func Alphanumeric0[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Alphanumeric0[I]()
+}
+
+// Alphanumeric1 mirrors nom's alphanumeric1.
+func Alphanumeric1[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Alphanumeric1[I]()
+}
+
+// Space0 mirrors nom's space0: it produces the longest, possibly empty, prefix
+// of the input made of spaces and tabs.
+func Space0[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Whitespace0[I](gomme.HorizontalWhitespace)
+}
+
+// Space1 mirrors nom's space1: it behaves like Space0, but fails if the prefix
+// is empty.
+func Space1[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Whitespace1[I](gomme.HorizontalWhitespace)
+}
+
+// Multispace0 mirrors nom's multispace0: it produces the longest, possibly
+// empty, prefix of the input made of spaces, tabs, carriage returns, and line
+// feeds.
+func Multispace0[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Whitespace0[I]()
+}
+
+// Multispace1 mirrors nom's multispace1: it behaves like Multispace0, but fails
+// if the prefix is empty.
+func Multispace1[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.Whitespace1[I]()
+}
+
+// LineEnding mirrors nom's line_ending: it matches a "\n" or "\r\n" line ending.
+func LineEnding[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.LineEnding[I]()
+}
+
+// CRLF mirrors nom's crlf.
+func CRLF[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.CRLF[I]()
+}
+
+// Newline mirrors nom's newline: it matches a line feed, and produces it.
+func Newline[I gomme.Bytes]() gomme.Parser[I, rune] {
+	return gomme.LF[I]()
+}
+
+// Tab mirrors nom's tab.
+func Tab[I gomme.Bytes]() gomme.Parser[I, rune] {
+	return gomme.Tab[I]()
+}
+
+// NotLineEnding mirrors nom's not_line_ending: it produces the input up to the
+// next line ending, or to its end.
+func NotLineEnding[I gomme.Bytes]() gomme.Parser[I, I] {
+	return gomme.NotLineEnding0[I]()
+}
+
+// I8 mirrors nom's i8.
+func I8[I gomme.Bytes]() gomme.Parser[I, int8] {
+	return gomme.Int8[I]()
+}
+
+// I16 mirrors nom's i16.
+func I16[I gomme.Bytes]() gomme.Parser[I, int16] {
+	return gomme.Int16[I]()
+}
+
+// I32 mirrors nom's i32.
+func I32[I gomme.Bytes]() gomme.Parser[I, int32] {
+	return gomme.Int32[I]()
+}
+
+// I64 mirrors nom's i64.
+func I64[I gomme.Bytes]() gomme.Parser[I, int64] {
+	return gomme.Int64[I]()
+}
+
+// U8 mirrors nom's u8.
+func U8[I gomme.Bytes]() gomme.Parser[I, uint8] {
+	return gomme.UInt8[I]()
+}
+
+// U16 mirrors nom's u16.
+func U16[I gomme.Bytes]() gomme.Parser[I, uint16] {
+	return gomme.UInt16[I]()
+}
+
+// U32 mirrors nom's u32.
+func U32[I gomme.Bytes]() gomme.Parser[I, uint32] {
+	return gomme.UInt32[I]()
+}
+
+// U64 mirrors nom's u64.
+func U64[I gomme.Bytes]() gomme.Parser[I, uint64] {
+	return gomme.UInt64[I]()
+}
+
+// Float mirrors nom's float.
+func Float[I gomme.Bytes]() gomme.Parser[I, float32] {
+	return gomme.Float32[I]()
+}
+
+// Double mirrors nom's double.
+func Double[I gomme.Bytes]() gomme.Parser[I, float64] {
+	return gomme.Float64[I]()
+}
@@ -0,0 +1,66 @@
+package nom
+
+import (
+	"testing"
+
+	"github.com/oleiade/gomme"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsers(t *testing.T) {
+	t.Parallel()
+
+	isDigit := func(c rune) bool { return c >= '0' && c <= '9' }
+
+	testCases := []struct {
+		name          string
+		parser        gomme.Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{name: "Tag should match its token", parser: Tag[string]("let"), input: "let x", wantOutput: "let", wantRemaining: " x"},
+		{name: "TagNoCase should ignore case", parser: TagNoCase[string]("let"), input: "LET x", wantOutput: "LET", wantRemaining: " x"},
+		{name: "TakeWhile should accept empty matches", parser: TakeWhile[string](isDigit), input: "abc", wantOutput: "", wantRemaining: "abc"},
+		{name: "TakeWhile1 should take matching characters", parser: TakeWhile1[string](isDigit), input: "12ab", wantOutput: "12", wantRemaining: "ab"},
+		{name: "TakeWhile1 should reject empty matches", parser: TakeWhile1[string](isDigit), input: "ab", wantErr: true, wantRemaining: "ab"},
+		{name: "TakeWhileMN should stop after n characters", parser: TakeWhileMN[string](1, 2, isDigit), input: "123", wantOutput: "12", wantRemaining: "3"},
+		{name: "TakeUntil should stop before its token", parser: TakeUntil[string]("*/"), input: "abc*/", wantOutput: "abc", wantRemaining: "*/"},
+		{name: "IsA should take the provided characters", parser: IsA[string]("ab"), input: "abbac", wantOutput: "abba", wantRemaining: "c"},
+		{name: "IsNot should take other characters", parser: IsNot[string](" \t"), input: "word rest", wantOutput: "word", wantRemaining: " rest"},
+		{name: "IsNot should reject empty matches", parser: IsNot[string](" "), input: " rest", wantErr: true, wantRemaining: " rest"},
+		{name: "Space0 should not take line endings", parser: Space0[string](), input: " \t\nx", wantOutput: " \t", wantRemaining: "\nx"},
+		{name: "Space1 should reject empty matches", parser: Space1[string](), input: "\nx", wantErr: true, wantRemaining: "\nx"},
+		{name: "Multispace0 should take line endings", parser: Multispace0[string](), input: " \t\nx", wantOutput: " \t\n", wantRemaining: "x"},
+		{name: "NotLineEnding should stop before line endings", parser: NotLineEnding[string](), input: "ab\r\ncd", wantOutput: "ab", wantRemaining: "\r\ncd"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+		})
+	}
+}
+
+func TestCharacterParsers(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 'b', OneOf[string]("abc")("b").Output)
+	assert.Error(t, NoneOf[string]("abc")("b").Err)
+	assert.Equal(t, 'é', AnyChar[string]()("é").Output)
+	assert.Equal(t, '\n', Newline[[]byte]()([]byte("\n")).Output)
+	assert.Equal(t, int8(-12), I8[string]()("-12").Output)
+	assert.Equal(t, uint64(12), U64[string]()("12").Output)
+	assert.Equal(t, 1.5, Double[string]()("1.5").Output)
+}
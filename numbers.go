@@ -1,59 +1,1125 @@
 package gomme
 
-// import "math"
-
-// Float parses a sequence of numerical characters into a float64.
-// The '.' character is used as the optional decimal delimiter. Any
-// number without a decimal part will still be parsed as a float64.
-//
-// N.B: it is not the parser's role to make sure the floating point
-// number you're attempting to parse fits into a 64 bits float.
-
-// func Float[I Bytes]() Parser[I, float64] {
-// 	digitsParser := TakeWhileOneOf[I]([]rune("0123456789")...)
-// 	minusParser := Char[I]('-')
-// 	dotParser := Char[I]('.')
-
-// 	return func(input I) Result[float64, I] {
-// 		var negative bool
-
-// 		minusresult := minusParser(input)
-// 		if result.Err == nil {
-// 			negative = true
-// 		}
-
-// 		result = digitsParser(result.Remaining)
-// 		// result = Expect(digitsParser, "digits")(result.Remaining)
-// 		// if result.Err != nil {
-// 		// 	return result
-// 		// }
-
-// 		parsed, ok := result.Output.(string)
-// 		if !ok {
-// 			err := fmt.Errorf("failed parsing floating point value; " +
-// 				"reason: converting Float() parser result's output to string failed",
-// 			)
-// 			return Failure(NewFatalError(input, err, "float"), input)
-// 		}
-// 		if resultTest := dotParser(result.Remaining); resultTest.Err == nil {
-// 			if resultTest = digitsParser(resultTest.Remaining); resultTest.Err == nil {
-// 				parsed = parsed + "." + resultTest.Output.(string)
-// 				result = resultTest
-// 			}
-// 		}
-
-// 		floatingPointValue, err := strconv.ParseFloat(parsed, 64)
-// 		if err != nil {
-// 			err = fmt.Errorf("failed to parse '%v' as float; reason: %w", parsed, err)
-// 			return Failure(NewFatalError(input, err), input)
-// 		}
-
-// 		if negative {
-// 			floatingPointValue = -floatingPointValue
-// 		}
-
-// 		result.Output = floatingPointValue
-
-// 		return result
-// 	}
-// }
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FloatFormat describes the syntax of the floating point numbers accepted by
+// the Float parsers. Formats are combined using the bitwise OR operator. The
+// '.' character is always used as the optional decimal delimiter, and a
+// number can always be preceded by a '-' sign.
+type FloatFormat uint8
+
+const (
+	// FloatExponent allows numbers to hold an exponent, as in "1.5e-3" or "2E8".
+	FloatExponent FloatFormat = 1 << iota
+
+	// FloatLeadingPlus allows numbers to be preceded by a '+' sign.
+	FloatLeadingPlus
+
+	// FloatLeadingDot allows numbers to omit their integer part, as in ".5".
+	FloatLeadingDot
+
+	// FloatInfNaN allows the "inf", "infinity" and "nan" special values,
+	// regardless of their case. Only infinities can be signed.
+	FloatInfNaN
+
+	// FloatDigitSeparators allows digits to be grouped using underscores, as
+	// in "1_000.000_1". Each underscore must sit between two digits; any other
+	// placement makes the parser fail.
+	FloatDigitSeparators
+)
+
+// DefaultFloatFormat is the format accepted by the Float32 and Float64 parsers.
+const DefaultFloatFormat = FloatExponent | FloatLeadingPlus
+
+// Float32 parses a floating point number, following the DefaultFloatFormat
+// syntax, from the input into a float32. Any number without a decimal part
+// will still be parsed as a float32.
+//
+// If the input doesn't start with a number, or if the number doesn't fit
+// into a 32 bits float, the parser returns an error result.
+func Float32[Input Bytes]() Parser[Input, float32] {
+	return Float32With[Input](DefaultFloatFormat)
+}
+
+// Float32With behaves like Float32, but accepts numbers following the
+// provided format.
+func Float32With[Input Bytes](format FloatFormat) Parser[Input, float32] {
+	failure := newSharedError[Input]("Float32")
+
+	return func(input Input) Result[float32, Input] {
+		length := floatLength(input, format)
+		if length == 0 {
+			return Failure[Input, float32](failure, input)
+		}
+
+		if f, ok := exactFloat(input[:length], 32); ok {
+			return Success(float32(f), input[length:])
+		}
+
+		f, err := strconv.ParseFloat(stripDigitSeparators(input[:length], format&FloatDigitSeparators != 0), 32)
+		if err != nil {
+			return Failure[Input, float32](conversionError(input, string(input[:length]), err, "Float32"), input)
+		}
+
+		return Success(float32(f), input[length:])
+	}
+}
+
+// Float64 parses a floating point number, following the DefaultFloatFormat
+// syntax, from the input into a float64. Any number without a decimal part
+// will still be parsed as a float64.
+//
+// If the input doesn't start with a number, or if the number doesn't fit
+// into a 64 bits float, the parser returns an error result.
+func Float64[Input Bytes]() Parser[Input, float64] {
+	return Float64With[Input](DefaultFloatFormat)
+}
+
+// Float64With behaves like Float64, but accepts numbers following the
+// provided format.
+func Float64With[Input Bytes](format FloatFormat) Parser[Input, float64] {
+	failure := newSharedError[Input]("Float64")
+
+	return func(input Input) Result[float64, Input] {
+		return parseFloat64(input, format, failure)
+	}
+}
+
+// NumberOption configures the Number parser.
+type NumberOption func(*numberOptions)
+
+// numberOptions holds the configuration of the Number parser.
+type numberOptions struct {
+	format FloatFormat
+	hex    bool
+}
+
+// WithFormat sets the syntax Number accepts to the provided format, replacing
+// its default one, so that stricter syntaxes can be parsed: Number(WithFormat(0))
+// only accepts optionally negative integers and decimal numbers, such as "42" or
+// "-3.5". The options following it extend the provided format.
+func WithFormat(format FloatFormat) NumberOption {
+	return func(options *numberOptions) {
+		options.format = format
+	}
+}
+
+// WithExponent allows the numbers Number parses to hold an exponent, as in
+// "1.5e-3" or "2E8".
+func WithExponent() NumberOption {
+	return func(options *numberOptions) {
+		options.format |= FloatExponent
+	}
+}
+
+// WithLeadingPlus allows the numbers Number parses to be preceded by a '+' sign.
+func WithLeadingPlus() NumberOption {
+	return func(options *numberOptions) {
+		options.format |= FloatLeadingPlus
+	}
+}
+
+// WithLeadingDot allows the numbers Number parses to omit their integer part,
+// as in ".5".
+func WithLeadingDot() NumberOption {
+	return func(options *numberOptions) {
+		options.format |= FloatLeadingDot
+	}
+}
+
+// WithInfNaN allows Number to parse the "inf", "infinity" and "nan" special
+// values, regardless of their case.
+func WithInfNaN() NumberOption {
+	return func(options *numberOptions) {
+		options.format |= FloatInfNaN
+	}
+}
+
+// WithDigitSeparators allows the digits of the numbers Number parses to be
+// grouped using underscores, as in "1_000.5".
+func WithDigitSeparators() NumberOption {
+	return func(options *numberOptions) {
+		options.format |= FloatDigitSeparators
+	}
+}
+
+// WithHex allows Number to parse hexadecimal integers, prefixed with "0x" or
+// "0X", as in "0x1F" or "-0xff". Their digits can't be grouped using digit
+// separators.
+func WithHex() NumberOption {
+	return func(options *numberOptions) {
+		options.hex = true
+	}
+}
+
+// Number parses a number from the input into a float64. Without options, it
+// accepts the most common numeric syntax found in data formats: integers and
+// decimal numbers, optionally signed with either '-' or '+', whose integer part
+// can be omitted, and which can hold an exponent; such as "42", "+3.5", ".5" or
+// "-1.5e9".
+//
+// Options extend the default syntax, in order: Number(WithHex()) accepts "0x1F"
+// along with all of the above. Use WithFormat to start from a stricter syntax:
+// Number(WithFormat(0), WithExponent()) accepts "1.5e9", but neither "+3.5" nor
+// ".5".
+//
+// If the input doesn't start with a number, or if the number doesn't fit
+// into a 64 bits float, the parser returns an error result.
+func Number[Input Bytes](options ...NumberOption) Parser[Input, float64] {
+	config := numberOptions{format: FloatExponent | FloatLeadingPlus | FloatLeadingDot}
+	for _, option := range options {
+		option(&config)
+	}
+
+	failure := newSharedError[Input]("Number")
+
+	return func(input Input) Result[float64, Input] {
+		if config.hex {
+			if result, ok := hexNumber(input, config.format); ok {
+				return result
+			}
+		}
+
+		return parseFloat64(input, config.format, failure)
+	}
+}
+
+// hexNumber parses an optionally signed hexadecimal integer, prefixed with "0x"
+// or "0X", into a float64. The sign follows the provided format. If the input
+// doesn't start with such an integer, the returned boolean is false, and the
+// input should be parsed as a decimal number instead.
+func hexNumber[Input Bytes](input Input, format FloatFormat) (Result[float64, Input], bool) {
+	pos := 0
+	if pos < len(input) && (input[pos] == '-' || (input[pos] == '+' && format&FloatLeadingPlus != 0)) {
+		pos++
+	}
+
+	if len(input) < pos+3 || input[pos] != '0' || input[pos+1]|0x20 != 'x' || !IsHexDigit(rune(input[pos+2])) {
+		return Result[float64, Input]{}, false
+	}
+
+	end := pos + 2
+	for end < len(input) && IsHexDigit(rune(input[end])) {
+		end++
+	}
+
+	// Hexadecimal literals are only accepted by strconv.ParseFloat along with a
+	// binary exponent, which rounds them correctly, regardless of their length.
+	f, err := strconv.ParseFloat(string(input[:end])+"p0", 64)
+	if err != nil {
+		return Failure[Input, float64](conversionError(input, string(input[:end]), err, "Number"), input), true
+	}
+
+	return Success(f, input[end:]), true
+}
+
+// parseFloat64 holds the logic shared by the parsers producing a float64 out
+// of numbers following the provided format. The provided failure is reported
+// when the input doesn't match.
+func parseFloat64[Input Bytes](input Input, format FloatFormat, failure *Error[Input]) Result[float64, Input] {
+	length := floatLength(input, format)
+	if length == 0 {
+		return Failure[Input, float64](failure, input)
+	}
+
+	if f, ok := exactFloat(input[:length], 64); ok {
+		return Success(f, input[length:])
+	}
+
+	f, err := strconv.ParseFloat(stripDigitSeparators(input[:length], format&FloatDigitSeparators != 0), 64)
+	if err != nil {
+		return Failure[Input, float64](conversionError(input, string(input[:length]), err, failure.Expected[0]), input)
+	}
+
+	return Success(f, input[length:])
+}
+
+// exactFloat converts the provided floating point literal, as recognized by
+// floatLength, into a float of the provided bit size without allocating; by
+// accumulating its digits into an integer mantissa, and scaling it by a power
+// of ten. This is only exact when both the mantissa and the power of ten are
+// exactly representable as floats of the provided size, in which case a single
+// rounding occurs, which is true of most numbers found in data formats. If it
+// isn't, or if the literal is a special value, the returned boolean is false,
+// and the literal should be converted using strconv.ParseFloat instead.
+func exactFloat[Input Bytes](literal Input, bitSize int) (float64, bool) {
+	maxMantissa, maxExponent := uint64(1)<<53, 22
+	if bitSize == 32 {
+		maxMantissa, maxExponent = uint64(1)<<24, 10
+	}
+
+	pos := 0
+	negative := false
+	if pos < len(literal) && (literal[pos] == '-' || literal[pos] == '+') {
+		negative = literal[pos] == '-'
+		pos++
+	}
+
+	var mantissa uint64
+	exponent := 0
+	fraction := false
+	for ; pos < len(literal); pos++ {
+		c := literal[pos]
+		switch {
+		case '0' <= c && c <= '9':
+			if mantissa > maxMantissa {
+				return 0, false
+			}
+
+			mantissa = mantissa*10 + uint64(c-'0')
+			if fraction {
+				exponent--
+			}
+		case c == '.':
+			fraction = true
+		case c == '_':
+		case c == 'e' || c == 'E':
+			e, ok := exactFloatExponent(literal[pos+1:], maxExponent)
+			if !ok {
+				return 0, false
+			}
+
+			exponent += e
+			pos = len(literal)
+		default:
+			return 0, false
+		}
+	}
+
+	if mantissa > maxMantissa || exponent < -maxExponent || exponent > maxExponent {
+		return 0, false
+	}
+
+	var f float64
+	if bitSize == 32 {
+		f32 := float32(mantissa)
+		if exponent < 0 {
+			f32 /= float32(exactPowersOfTen[-exponent])
+		} else {
+			f32 *= float32(exactPowersOfTen[exponent])
+		}
+		f = float64(f32)
+	} else {
+		f = float64(mantissa)
+		if exponent < 0 {
+			f /= exactPowersOfTen[-exponent]
+		} else {
+			f *= exactPowersOfTen[exponent]
+		}
+	}
+
+	if negative {
+		f = -f
+	}
+
+	return f, true
+}
+
+// exactFloatExponent returns the value of the optionally signed exponent found
+// in the input. If its magnitude exceeds the provided maximum, the returned
+// boolean is false.
+func exactFloatExponent[Input Bytes](input Input, maxExponent int) (int, bool) {
+	pos := 0
+	negative := false
+	if pos < len(input) && (input[pos] == '-' || input[pos] == '+') {
+		negative = input[pos] == '-'
+		pos++
+	}
+
+	exponent := 0
+	for ; pos < len(input); pos++ {
+		if input[pos] == '_' {
+			continue
+		}
+
+		exponent = exponent*10 + int(input[pos]-'0')
+		if exponent > maxExponent {
+			return 0, false
+		}
+	}
+
+	if negative {
+		return -exponent, true
+	}
+
+	return exponent, true
+}
+
+// exactPowersOfTen holds the powers of ten which are exactly representable
+// as float64 values.
+var exactPowersOfTen = [...]float64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10,
+	1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19, 1e20, 1e21, 1e22,
+}
+
+// floatLength returns the length of the floating point number, following the
+// provided format, found at the beginning of the input, or zero if there is
+// none. A decimal delimiter which isn't followed by any digit, or an incomplete
+// exponent, are not considered part of the number.
+func floatLength[Input Bytes](input Input, format FloatFormat) int {
+	separators := format&FloatDigitSeparators != 0
+
+	pos := 0
+	signed := false
+	if pos < len(input) && (input[pos] == '-' || (input[pos] == '+' && format&FloatLeadingPlus != 0)) {
+		signed = true
+		pos++
+	}
+
+	if format&FloatInfNaN != 0 {
+		for _, special := range [...]string{"infinity", "inf", "nan"} {
+			if signed && special == "nan" {
+				continue
+			}
+
+			if hasPrefixFold(input[pos:], special) {
+				return pos + len(special)
+			}
+		}
+	}
+
+	digits, ok := separatedDigitsLength(input[pos:], separators)
+	if !ok {
+		return 0
+	}
+	pos += digits
+
+	if pos < len(input) && input[pos] == '.' && (digits > 0 || format&FloatLeadingDot != 0) {
+		fraction, ok := separatedDigitsLength(input[pos+1:], separators)
+		if !ok {
+			return 0
+		}
+
+		if fraction > 0 {
+			digits += fraction
+			pos += 1 + fraction
+		}
+	}
+
+	if digits == 0 {
+		return 0
+	}
+
+	if format&FloatExponent != 0 && pos < len(input) && (input[pos] == 'e' || input[pos] == 'E') {
+		exponent := pos + 1
+		if exponent < len(input) && (input[exponent] == '-' || input[exponent] == '+') {
+			exponent++
+		}
+
+		exponentDigits, ok := separatedDigitsLength(input[exponent:], separators)
+		if !ok {
+			return 0
+		}
+
+		if exponentDigits > 0 {
+			pos = exponent + exponentDigits
+		}
+	}
+
+	return pos
+}
+
+// hasPrefixFold reports whether the input begins with the provided lowercase
+// ASCII token, regardless of the input's case.
+func hasPrefixFold[Input Bytes](input Input, token string) bool {
+	if len(input) < len(token) {
+		return false
+	}
+
+	for idx := 0; idx < len(token); idx++ {
+		c := input[idx]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+
+		if c != token[idx] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// conversionError produces the Error reported by the named numeric parser when
+// converting the provided literal failed with err. If the literal's value doesn't
+// fit into the parser's output type, the Error is a fatal one, holding a RangeError.
+func conversionError[Input Bytes](input Input, literal string, err error, name string) *Error[Input] {
+	if errors.Is(err, strconv.ErrRange) {
+		return newRangeError(input, literal, name)
+	}
+
+	return NewError(input, name)
+}
+
+// digitsLength returns the number of ASCII digits found at the beginning
+// of the input.
+//
+// Long runs of digits, such as the ones big numbers are made of, are scanned
+// eight bytes at a time.
+func digitsLength[Input Bytes](input Input) int {
+	pos := 0
+	for pos+8 <= len(input) && allDigits(load64(input[pos:pos+8])) {
+		pos += 8
+	}
+
+	for pos < len(input) && asciiClasses[input[pos]]&classDigit != 0 {
+		pos++
+	}
+
+	return pos
+}
+
+// load64 packs the first eight bytes of the input into an integer, the first
+// byte being the least significant one.
+func load64[Input Bytes](input Input) uint64 {
+	_ = input[7]
+
+	return uint64(input[0]) | uint64(input[1])<<8 | uint64(input[2])<<16 | uint64(input[3])<<24 |
+		uint64(input[4])<<32 | uint64(input[5])<<40 | uint64(input[6])<<48 | uint64(input[7])<<56
+}
+
+// allDigits returns true if each of the eight bytes packed into the provided
+// integer is an ASCII digit. A byte is a digit if its high nibble is 3, and
+// adding 6 to it doesn't carry into its high nibble, meaning its low nibble is
+// at most 9.
+func allDigits(x uint64) bool {
+	const (
+		highNibbles = 0xF0F0F0F0F0F0F0F0
+		threes      = 0x3333333333333333
+		sixes       = 0x0606060606060606
+	)
+
+	return (x&highNibbles)|(((x+sixes)&highNibbles)>>4) == threes
+}
+
+// separatedDigitsLength returns the number of bytes spanned by the ASCII digits
+// found at the beginning of the input. If `separators` is true, the digits can be
+// grouped using underscores, each of which must sit between two digits; if one
+// doesn't, the returned boolean is false.
+func separatedDigitsLength[Input Bytes](input Input, separators bool) (int, bool) {
+	if !separators {
+		return digitsLength(input), true
+	}
+
+	pos := 0
+	for pos < len(input) {
+		if IsDigit(rune(input[pos])) {
+			pos++
+			continue
+		}
+
+		if input[pos] == '_' && pos > 0 && pos+1 < len(input) && IsDigit(rune(input[pos+1])) {
+			pos++
+			continue
+		}
+
+		break
+	}
+
+	if pos < len(input) && input[pos] == '_' {
+		return pos, false
+	}
+
+	return pos, true
+}
+
+// stripDigitSeparators converts the input to a string, removing the underscores
+// used to group its digits if `separators` is true.
+func stripDigitSeparators[Input Bytes](input Input, separators bool) string {
+	if !separators {
+		return viewString(input)
+	}
+
+	return strings.ReplaceAll(string(input), "_", "")
+}
+
+// BigInt parses an optionally negative decimal integer of arbitrary length from
+// the input into a *big.Int. Unlike the sized integer parsers, it never overflows,
+// which makes it suitable for cryptographic, scientific, or financial data.
+// If the input doesn't start with an integer, the parser returns an error result.
+func BigInt[Input Bytes]() Parser[Input, *big.Int] {
+	failure := newSharedError[Input]("BigInt")
+
+	return func(input Input) Result[*big.Int, Input] {
+		length := 0
+		if len(input) > 0 && input[0] == '-' {
+			length++
+		}
+
+		digits := digitsLength(input[length:])
+		if digits == 0 {
+			return Failure[Input, *big.Int](failure, input)
+		}
+		length += digits
+
+		n, ok := new(big.Int).SetString(viewString(input[:length]), 10)
+		if !ok {
+			return Failure[Input, *big.Int](failure, input)
+		}
+
+		return Success(n, input[length:])
+	}
+}
+
+// DecimalValue is an exact decimal number, as produced by the Decimal parser.
+// Its value is Coefficient × 10^-Scale; which preserves every digit of the
+// parsed number, including the trailing zeros of its decimal part.
+type DecimalValue struct {
+	Coefficient *big.Int
+	Scale       int
+}
+
+// Rat returns the decimal number as a *big.Rat.
+func (d DecimalValue) Rat() *big.Rat {
+	denominator := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(d.Scale)), nil)
+
+	return new(big.Rat).SetFrac(d.Coefficient, denominator)
+}
+
+// String returns the decimal number's textual representation, holding exactly
+// Scale decimal digits.
+func (d DecimalValue) String() string {
+	digits := new(big.Int).Abs(d.Coefficient).String()
+	if d.Scale > 0 {
+		if len(digits) <= d.Scale {
+			digits = strings.Repeat("0", d.Scale-len(digits)+1) + digits
+		}
+
+		digits = digits[:len(digits)-d.Scale] + "." + digits[len(digits)-d.Scale:]
+	}
+
+	if d.Coefficient.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// Decimal parses an optionally negative decimal number, whose decimal part is
+// delimited by the '.' character, from the input into a DecimalValue. Unlike
+// Float64, it doesn't lose precision, which makes it suitable for monetary
+// amounts and other formats where every digit matters.
+// If the input doesn't start with a number, the parser returns an error result.
+func Decimal[Input Bytes]() Parser[Input, DecimalValue] {
+	failure := newSharedError[Input]("Decimal")
+
+	return func(input Input) Result[DecimalValue, Input] {
+		length := floatLength(input, 0)
+		if length == 0 {
+			return Failure[Input, DecimalValue](failure, input)
+		}
+
+		literal := string(input[:length])
+		scale := 0
+		if dot := strings.IndexByte(literal, '.'); dot >= 0 {
+			scale = len(literal) - dot - 1
+			literal = literal[:dot] + literal[dot+1:]
+		}
+
+		coefficient, ok := new(big.Int).SetString(literal, 10)
+		if !ok {
+			return Failure[Input, DecimalValue](failure, input)
+		}
+
+		return Success(DecimalValue{Coefficient: coefficient, Scale: scale}, input[length:])
+	}
+}
+
+// Duration parses a duration, expressed using Go's duration syntax, from the input
+// into a time.Duration. A duration is an optionally signed sequence of decimal
+// numbers, each with an optional fraction and a unit suffix, such as "250ms",
+// "-1.5h" or "1h30m". Valid units are "ns", "us" (or "µs"), "ms", "s", "m", and "h".
+// Only the duration as a whole can be signed: the parser stops before a sign
+// following a component, as in "1h-30m". As with time.ParseDuration, a bare "0"
+// is the only duration which doesn't need a unit.
+//
+// If the input doesn't start with a duration, the parser returns an error result.
+// If the duration doesn't fit into a time.Duration, the error is fatal, and holds
+// a RangeError.
+func Duration[Input Bytes]() Parser[Input, time.Duration] {
+	digits := Digit1[Input]()
+	number := Alternative(
+		Recognize(Pair(digits, Optional(Preceded(Char[Input]('.'), Digit0[Input]())))),
+		Recognize(Pair(Char[Input]('.'), digits)),
+	)
+
+	parser := Recognize(Pair(
+		Optional(OneOf[Input]('-', '+')),
+		Many1(Pair(
+			number,
+			Keywords[Input]("ns", "us", "µs", "μs", "ms", "s", "m", "h"),
+		)),
+	))
+
+	failure := newSharedError[Input]("Duration")
+
+	return func(input Input) Result[time.Duration, Input] {
+		result := parser(input)
+		if result.Err != nil {
+			if length := zeroDurationLength(input); length > 0 {
+				return Success(time.Duration(0), input[length:])
+			}
+
+			return Failure[Input, time.Duration](failure, input)
+		}
+
+		// As the parser only recognizes valid durations, time.ParseDuration
+		// only fails on those which don't fit into a time.Duration.
+		d, err := time.ParseDuration(viewString(result.Output))
+		if err != nil {
+			return Failure[Input, time.Duration](newRangeError(input, string(result.Output), "Duration"), input)
+		}
+
+		return Success(d, result.Remaining)
+	}
+}
+
+// zeroDurationLength returns the length of the optionally signed bare "0"
+// duration found at the beginning of the input, or zero if there is none.
+func zeroDurationLength[Input Bytes](input Input) int {
+	pos := 0
+	if pos < len(input) && (input[pos] == '-' || input[pos] == '+') {
+		pos++
+	}
+
+	if pos == len(input) || input[pos] != '0' {
+		return 0
+	}
+
+	pos++
+	if pos < len(input) && (input[pos] == '.' || ('0' <= input[pos] && input[pos] <= '9')) {
+		return 0
+	}
+
+	return pos
+}
+
+// ByteSize parses a human-readable size, such as "512", "10KB", "2MiB" or "1.5G",
+// from the input into a number of bytes. The size is a non-negative decimal number,
+// optionally followed by a unit. Units are case insensitive, and made of one of the
+// K, M, G, T, P, and E prefixes, optionally followed by an 'i', and optionally
+// followed by a 'B'; a lone 'B' stands for bytes. Prefixes followed by an 'i' are
+// binary ones, multiples of 1024, whereas the others are decimal ones, multiples
+// of 1000. Fractions of a byte are truncated.
+//
+// If the input doesn't start with a size, or if the size doesn't fit into an
+// int64, the parser returns an error result.
+func ByteSize[Input Bytes]() Parser[Input, int64] {
+	failure := newSharedError[Input]("ByteSize")
+
+	return func(input Input) Result[int64, Input] {
+		integer := 0
+		for integer < len(input) && '0' <= input[integer] && input[integer] <= '9' {
+			integer++
+		}
+
+		if integer == 0 {
+			return Failure[Input, int64](failure, input)
+		}
+
+		length := integer
+		if length+1 < len(input) && input[length] == '.' && '0' <= input[length+1] && input[length+1] <= '9' {
+			length += 2
+			for length < len(input) && '0' <= input[length] && input[length] <= '9' {
+				length++
+			}
+		}
+
+		multiplier, unit := byteSizeUnit(input[length:])
+
+		// The integer part is computed exactly, only the fraction is
+		// computed using floating point numbers.
+		size, ok := int64(0), true
+		for _, c := range []byte(input[:integer]) {
+			digit := int64(c - '0')
+			if size > (math.MaxInt64-digit)/10 {
+				ok = false
+				break
+			}
+
+			size = size*10 + digit
+		}
+
+		if ok && size > math.MaxInt64/multiplier {
+			ok = false
+		}
+
+		if ok {
+			size *= multiplier
+
+			if length > integer {
+				fraction, _ := strconv.ParseFloat(string(input[integer:length]), 64)
+				extra := int64(fraction * float64(multiplier))
+				if extra > math.MaxInt64-size {
+					ok = false
+				}
+
+				size += extra
+			}
+		}
+
+		if !ok {
+			literal := input[:length+unit]
+			return Failure[Input, int64](newRangeError(input, string(literal), "ByteSize"), input)
+		}
+
+		return Success(size, input[length+unit:])
+	}
+}
+
+// byteSizeUnit returns the multiplier expressed by the byte size unit found at
+// the beginning of the input, along with the unit's length. If there is no unit,
+// the multiplier is 1, and the length is zero.
+func byteSizeUnit[Input Bytes](input Input) (int64, int) {
+	if len(input) == 0 {
+		return 1, 0
+	}
+
+	exponent := strings.IndexByte("KMGTPE", input[0]&^0x20) + 1
+	if exponent == 0 {
+		if input[0]&^0x20 == 'B' {
+			return 1, 1
+		}
+
+		return 1, 0
+	}
+
+	pos := 1
+	base := int64(1000)
+	if pos < len(input) && input[pos]&^0x20 == 'I' {
+		base = 1024
+		pos++
+	}
+
+	if pos < len(input) && input[pos]&^0x20 == 'B' {
+		pos++
+	}
+
+	multiplier := int64(1)
+	for ; exponent > 0; exponent-- {
+		multiplier *= base
+	}
+
+	return multiplier, pos
+}
+
+// GroupedInt parses an optionally negative decimal integer, whose digits can
+// be grouped by thousands using the provided separator, from the input into an
+// int64; such as "9,223,372,036,854,775,807" using ','. The first group holds
+// one to three digits, and the following ones exactly three. A separator which
+// isn't followed by exactly three digits is not considered part of the number.
+// Integers holding no separator at all are accepted as well. The optional formats
+// describe the syntax of the integers accepted in place of grouped ones: using
+// IntDigitSeparators, GroupedInt(',', IntDigitSeparators) accepts "1_000" as
+// well as "1,000".
+//
+// If the input doesn't start with an integer, or if the integer doesn't fit
+// into an int64, the parser returns an error result.
+func GroupedInt[Input Bytes](separator rune, formats ...IntFormat) Parser[Input, int64] {
+	separators := intFormat(formats)&IntDigitSeparators != 0
+
+	failure := newSharedError[Input]("GroupedInt")
+
+	return func(input Input) Result[int64, Input] {
+		literal, length := groupedNumber(input, separator, 0, false)
+
+		// Integers grouped using underscores are preferred whenever they
+		// extend further than the grouped one.
+		if separators {
+			result := signedInteger[Input, int64](input, 64, true, failure)
+			if result.Err == nil && len(input)-len(result.Remaining) > length {
+				return result
+			}
+
+			if result.Err != nil && result.Err.IsFatal() {
+				return result
+			}
+		}
+
+		if length == 0 {
+			return Failure[Input, int64](failure, input)
+		}
+
+		n, err := strconv.ParseInt(literal, 10, 64)
+		if err != nil {
+			return Failure[Input, int64](conversionError(input, string(input[:length]), err, "GroupedInt"), input)
+		}
+
+		return Success(n, input[length:])
+	}
+}
+
+// GroupedNumber behaves like GroupedInt, but parses numbers which can hold a
+// decimal part, delimited by the provided decimal mark, into a float64. It
+// allows parsing numbers formatted after various locales' conventions, such
+// as "1,234,567.89" using ',' and '.', or "1.234.567,89" using '.' and ','.
+//
+// If the input doesn't start with a number, or if the number doesn't fit
+// into a float64, the parser returns an error result.
+func GroupedNumber[Input Bytes](separator, decimalMark rune) Parser[Input, float64] {
+	failure := newSharedError[Input]("GroupedNumber")
+
+	return func(input Input) Result[float64, Input] {
+		literal, length := groupedNumber(input, separator, decimalMark, true)
+		if length == 0 {
+			return Failure[Input, float64](failure, input)
+		}
+
+		f, err := strconv.ParseFloat(literal, 64)
+		if err != nil {
+			return Failure[Input, float64](conversionError(input, string(input[:length]), err, "GroupedNumber"), input)
+		}
+
+		return Success(f, input[length:])
+	}
+}
+
+// groupedNumber returns the number, whose digits are grouped by thousands
+// using the provided separator, found at the beginning of the input; along
+// with its length. The number is returned with its separators removed, and
+// its decimal mark, which is only looked for if `fraction` is true, replaced
+// with a '.'. If there is no number, the returned length is zero.
+func groupedNumber[Input Bytes](input Input, separator, decimalMark rune, fraction bool) (string, int) {
+	var literal strings.Builder
+
+	pos := 0
+	if len(input) > 0 && input[0] == '-' {
+		literal.WriteByte('-')
+		pos++
+	}
+
+	digits := digitsLength(input[pos:])
+	if digits == 0 {
+		return "", 0
+	}
+	literal.WriteString(viewString(input[pos : pos+digits]))
+	pos += digits
+
+	if digits <= 3 {
+		for pos < len(input) && rune(input[pos]) == separator {
+			if digitsLength(input[pos+1:]) != 3 {
+				break
+			}
+
+			literal.WriteString(viewString(input[pos+1 : pos+4]))
+			pos += 4
+		}
+	}
+
+	if fraction && pos < len(input) && rune(input[pos]) == decimalMark {
+		if decimals := digitsLength(input[pos+1:]); decimals > 0 {
+			literal.WriteByte('.')
+			literal.WriteString(viewString(input[pos+1 : pos+1+decimals]))
+			pos += 1 + decimals
+		}
+	}
+
+	return literal.String(), pos
+}
+
+// HexUint parses a hexadecimal integer, optionally prefixed with "0x" or "0X",
+// from the input into a uint64. Hexadecimal digits are case insensitive.
+//
+// If the input doesn't start with a hexadecimal number, or if the number doesn't
+// fit into 64 bits, the parser returns an error result.
+func HexUint[Input Bytes]() Parser[Input, uint64] {
+	failure := newSharedError[Input]("HexUint")
+
+	return func(input Input) Result[uint64, Input] {
+		return radixUnsigned(input, 'x', IsHexDigit, 16, failure)
+	}
+}
+
+// HexInt parses an optionally negative hexadecimal integer, optionally prefixed
+// with "0x" or "0X", from the input into an int64. The sign, if any, comes before
+// the prefix, as in "-0x1F".
+//
+// If the input doesn't start with a hexadecimal number, or if the number doesn't
+// fit into an int64, the parser returns an error result.
+func HexInt[Input Bytes]() Parser[Input, int64] {
+	failure := newSharedError[Input]("HexInt")
+
+	return func(input Input) Result[int64, Input] {
+		return radixSigned(input, 'x', IsHexDigit, 16, failure)
+	}
+}
+
+// OctUint parses an octal integer, optionally prefixed with "0o" or "0O", from
+// the input into a uint64.
+//
+// If the input doesn't start with an octal number, or if the number doesn't
+// fit into 64 bits, the parser returns an error result.
+func OctUint[Input Bytes]() Parser[Input, uint64] {
+	failure := newSharedError[Input]("OctUint")
+
+	return func(input Input) Result[uint64, Input] {
+		return radixUnsigned(input, 'o', IsOctDigit, 8, failure)
+	}
+}
+
+// OctInt parses an optionally negative octal integer, optionally prefixed with
+// "0o" or "0O", from the input into an int64. The sign, if any, comes before the
+// prefix, as in "-0o17".
+//
+// If the input doesn't start with an octal number, or if the number doesn't
+// fit into an int64, the parser returns an error result.
+func OctInt[Input Bytes]() Parser[Input, int64] {
+	failure := newSharedError[Input]("OctInt")
+
+	return func(input Input) Result[int64, Input] {
+		return radixSigned(input, 'o', IsOctDigit, 8, failure)
+	}
+}
+
+// BinUint parses a binary integer, optionally prefixed with "0b" or "0B", from
+// the input into a uint64.
+//
+// If the input doesn't start with a binary number, or if the number doesn't
+// fit into 64 bits, the parser returns an error result.
+func BinUint[Input Bytes]() Parser[Input, uint64] {
+	failure := newSharedError[Input]("BinUint")
+
+	return func(input Input) Result[uint64, Input] {
+		return radixUnsigned(input, 'b', IsBinDigit, 2, failure)
+	}
+}
+
+// BinInt parses an optionally negative binary integer, optionally prefixed with
+// "0b" or "0B", from the input into an int64. The sign, if any, comes before the
+// prefix, as in "-0b101".
+//
+// If the input doesn't start with a binary number, or if the number doesn't
+// fit into an int64, the parser returns an error result.
+func BinInt[Input Bytes]() Parser[Input, int64] {
+	failure := newSharedError[Input]("BinInt")
+
+	return func(input Input) Result[int64, Input] {
+		return radixSigned(input, 'b', IsBinDigit, 2, failure)
+	}
+}
+
+// IntegerBase parses an optionally negative integer expressed in the provided
+// base, from the input into an int64. Bases from 2 to 36 are supported; digits
+// beyond 9 are expressed using the letters 'a' to 'z', regardless of their case.
+// Unlike HexInt, OctInt, and BinInt, no base prefix is accepted.
+//
+// If the input doesn't start with a number in the provided base, if the number
+// doesn't fit into an int64, or if the base is not supported, the parser returns
+// an error result.
+func IntegerBase[Input Bytes](base int) Parser[Input, int64] {
+	isBaseDigit := func(c rune) bool {
+		return baseDigitValue(c) < base
+	}
+
+	failure := newSharedError[Input]("IntegerBase")
+
+	return func(input Input) Result[int64, Input] {
+		if base < 2 || base > 36 {
+			return Failure[Input, int64](failure, input)
+		}
+
+		return radixSigned(input, 0, isBaseDigit, base, failure)
+	}
+}
+
+// baseDigitValue returns the value of the provided digit, in bases up to 36. Runes
+// which aren't digits in any of those bases are given the value 36.
+func baseDigitValue(c rune) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c - '0')
+	case 'a' <= c && c <= 'z':
+		return int(c-'a') + 10
+	case 'A' <= c && c <= 'Z':
+		return int(c-'A') + 10
+	}
+
+	return 36
+}
+
+// radixUnsigned parses an integer expressed in the provided base, whose digits
+// satisfy the provided predicate, from the input. The number can be prefixed with
+// a '0' followed by the lowercase prefix character, in either case. A prefix which
+// isn't followed by any digit is not considered a prefix, and a zero prefix
+// character disables prefixes altogether. The provided failure is reported when
+// the input doesn't match.
+func radixUnsigned[Input Bytes](
+	input Input,
+	prefix byte,
+	predicate func(rune) bool,
+	base int,
+	failure *Error[Input],
+) Result[uint64, Input] {
+	start := 0
+	if len(input) > 2 && input[0] == '0' && input[1]|0x20 == prefix && predicate(rune(input[2])) {
+		start = 2
+	}
+
+	end := start
+	for end < len(input) && predicate(rune(input[end])) {
+		end++
+	}
+
+	if end == start {
+		return Failure[Input, uint64](failure, input)
+	}
+
+	n, err := strconv.ParseUint(viewString(input[start:end]), base, 64)
+	if err != nil {
+		return Failure[Input, uint64](conversionError(input, string(input[:end]), err, failure.Expected[0]), input)
+	}
+
+	return Success(n, input[end:])
+}
+
+// radixSigned behaves like radixUnsigned, but accepts a leading '-' sign, and
+// produces an int64.
+func radixSigned[Input Bytes](
+	input Input,
+	prefix byte,
+	predicate func(rune) bool,
+	base int,
+	failure *Error[Input],
+) Result[int64, Input] {
+	negative := len(input) > 0 && input[0] == '-'
+
+	offset := 0
+	if negative {
+		offset = 1
+	}
+
+	result := radixUnsigned(input[offset:], prefix, predicate, base, failure)
+	if result.Err != nil {
+		if rangeErr, ok := result.Err.Err.(*RangeError); ok {
+			literal := string(input[:offset]) + rangeErr.Literal
+			return Failure[Input, int64](newRangeError(input, literal, failure.Expected[0]), input)
+		}
+
+		return Failure[Input, int64](failure, input)
+	}
+
+	literal := input[:len(input)-len(result.Remaining)]
+
+	if negative {
+		if result.Output > math.MaxInt64+1 {
+			return Failure[Input, int64](newRangeError(input, string(literal), failure.Expected[0]), input)
+		}
+
+		// The magnitude of math.MinInt64 wraps around to math.MinInt64
+		// itself when converted, which its negation leaves untouched.
+		return Success(-int64(result.Output), result.Remaining)
+	}
+
+	if result.Output > math.MaxInt64 {
+		return Failure[Input, int64](newRangeError(input, string(literal), failure.Expected[0]), input)
+	}
+
+	return Success(int64(result.Output), result.Remaining)
+}
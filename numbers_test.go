@@ -0,0 +1,2374 @@
+package gomme
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFloat32(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, float32]
+		input         string
+		wantErr       bool
+		wantOutput    float32
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a decimal number should succeed",
+			parser:        Float32[string](),
+			input:         "3.25abc",
+			wantErr:       false,
+			wantOutput:    3.25,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a negative number should succeed",
+			parser:        Float32[string](),
+			input:         "-0.5",
+			wantErr:       false,
+			wantOutput:    -0.5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an integer should succeed",
+			parser:        Float32[string](),
+			input:         "42 apples",
+			wantErr:       false,
+			wantOutput:    42,
+			wantRemaining: " apples",
+		},
+		{
+			name:          "parsing an exponent should succeed",
+			parser:        Float32[string](),
+			input:         "-1.5e3x",
+			wantErr:       false,
+			wantOutput:    -1500,
+			wantRemaining: "x",
+		},
+		{
+			name:          "parsing a number followed by a lone dot should stop before it",
+			parser:        Float32[string](),
+			input:         "42.",
+			wantErr:       false,
+			wantOutput:    42,
+			wantRemaining: ".",
+		},
+		{
+			name:          "parsing the largest float32 should succeed",
+			parser:        Float32[string](),
+			input:         "340282346638528859811704183484516925440",
+			wantErr:       false,
+			wantOutput:    math.MaxFloat32,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a number overflowing float32 should fail",
+			parser:        Float32[string](),
+			input:         "340282356779733661637539395458142568448",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "340282356779733661637539395458142568448",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        Float32[string](),
+			input:         "-abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-abc",
+		},
+		{
+			name:          "parsing a leading dot should fail",
+			parser:        Float32[string](),
+			input:         ".5",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: ".5",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Float32[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkFloat32(b *testing.B) {
+	parser := Float32[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("3.25abc")
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, float64]
+		input         string
+		wantErr       bool
+		wantOutput    float64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a decimal number should succeed",
+			parser:        Float64[string](),
+			input:         "3.25abc",
+			wantErr:       false,
+			wantOutput:    3.25,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing a negative number should succeed",
+			parser:        Float64[string](),
+			input:         "-0.5",
+			wantErr:       false,
+			wantOutput:    -0.5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a number with a leading plus should succeed",
+			parser:        Float64[string](),
+			input:         "+3.5",
+			wantErr:       false,
+			wantOutput:    3.5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an integer should succeed",
+			parser:        Float64[string](),
+			input:         "42 apples",
+			wantErr:       false,
+			wantOutput:    42,
+			wantRemaining: " apples",
+		},
+		{
+			name:          "parsing an exponent should succeed",
+			parser:        Float64[string](),
+			input:         "1e9,",
+			wantErr:       false,
+			wantOutput:    1e9,
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing a signed uppercase exponent should succeed",
+			parser:        Float64[string](),
+			input:         "-2.5E-3",
+			wantErr:       false,
+			wantOutput:    -2.5e-3,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an incomplete exponent should stop before it",
+			parser:        Float64[string](),
+			input:         "12e+",
+			wantErr:       false,
+			wantOutput:    12,
+			wantRemaining: "e+",
+		},
+		{
+			name:          "parsing a number followed by a lone dot should stop before it",
+			parser:        Float64[string](),
+			input:         "42.",
+			wantErr:       false,
+			wantOutput:    42,
+			wantRemaining: ".",
+		},
+		{
+			name:          "parsing a number overflowing float64 should fail",
+			parser:        Float64[string](),
+			input:         "1e400",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1e400",
+		},
+		{
+			name:          "parsing a leading dot should fail",
+			parser:        Float64[string](),
+			input:         ".5",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: ".5",
+		},
+		{
+			name:          "parsing infinity should fail",
+			parser:        Float64[string](),
+			input:         "inf",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "inf",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        Float64[string](),
+			input:         "+abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "+abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Float64[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkFloat64(b *testing.B) {
+	parser := Float64[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("-2.5E-3")
+	}
+}
+
+func TestFloat64With(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, float64]
+		input         string
+		wantErr       bool
+		wantOutput    float64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a leading dot should succeed when allowed",
+			parser:        Float64With[string](FloatLeadingDot),
+			input:         ".5;",
+			wantErr:       false,
+			wantOutput:    0.5,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a negative leading dot should succeed when allowed",
+			parser:        Float64With[string](FloatLeadingDot),
+			input:         "-.5",
+			wantErr:       false,
+			wantOutput:    -0.5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing infinity should succeed when allowed",
+			parser:        Float64With[string](FloatInfNaN),
+			input:         "Infinity!",
+			wantErr:       false,
+			wantOutput:    math.Inf(1),
+			wantRemaining: "!",
+		},
+		{
+			name:          "parsing a negative short infinity should succeed when allowed",
+			parser:        Float64With[string](FloatInfNaN),
+			input:         "-INF",
+			wantErr:       false,
+			wantOutput:    math.Inf(-1),
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an exponent should stop when not allowed",
+			parser:        Float64With[string](FloatLeadingDot),
+			input:         "1e9",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: "e9",
+		},
+		{
+			name:          "parsing a leading plus should fail when not allowed",
+			parser:        Float64With[string](FloatExponent),
+			input:         "+1",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "+1",
+		},
+		{
+			name:          "parsing a signed nan should fail",
+			parser:        Float64With[string](FloatInfNaN),
+			input:         "-nan",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-nan",
+		},
+		{
+			name:          "parsing grouped digits should succeed when allowed",
+			parser:        Float64With[string](FloatExponent | FloatDigitSeparators),
+			input:         "1_000.000_1e1_0;",
+			wantErr:       false,
+			wantOutput:    1000.0001e10,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a separator next to the decimal delimiter should fail",
+			parser:        Float64With[string](FloatDigitSeparators),
+			input:         "1_.5",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1_.5",
+		},
+		{
+			name:          "parsing a separator starting the fraction should fail",
+			parser:        Float64With[string](FloatDigitSeparators),
+			input:         "1._5",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1._5",
+		},
+		{
+			name:          "parsing a separator starting the exponent should fail",
+			parser:        Float64With[string](FloatExponent | FloatDigitSeparators),
+			input:         "1e_5",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1e_5",
+		},
+		{
+			name:          "parsing grouped digits should stop when not allowed",
+			parser:        Float64With[string](FloatExponent),
+			input:         "1_000.5",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: "_000.5",
+		},
+		{
+			name:          "parsing a lone dot should fail",
+			parser:        Float64With[string](FloatLeadingDot),
+			input:         ".",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: ".",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkFloat64With(b *testing.B) {
+	parser := Float64With[string](FloatLeadingDot | FloatInfNaN)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(".5;")
+	}
+}
+
+func TestFloat64WithNaN(t *testing.T) {
+	t.Parallel()
+
+	result := Float64With[string](FloatInfNaN)("NaN, 1")
+
+	assert.Nil(t, result.Err)
+	assert.True(t, math.IsNaN(result.Output))
+	assert.Equal(t, ", 1", result.Remaining)
+}
+
+func TestFloat64OutOfRange(t *testing.T) {
+	t.Parallel()
+
+	result := Float64[string]()("1e400")
+
+	assert.True(t, errors.Is(result.Err, strconv.ErrRange))
+	assert.True(t, result.Err.IsFatal())
+}
+
+func TestFloatFastPathMatchesStrconv(t *testing.T) {
+	t.Parallel()
+
+	literals := []string{
+		"0", "-0", "+0", "0.0", "1", "-1", "3.25", "-2.5E-3", "0.1", "0.3", "1.7976931348623157",
+		"123456789.123456789", "9007199254740992", "9007199254740993", "4503599627370497.5",
+		"1e22", "1e23", "1e-22", "1e-23", "12.5e10", "0.000001", "1.00000000000000000000",
+		"16777216", "16777217", "3.4028235e38", "1e-45", "1_000.000_1", "2.5e+1_0", "1e400",
+	}
+
+	for _, literal := range literals {
+		literal := literal
+
+		t.Run(literal, func(t *testing.T) {
+			t.Parallel()
+
+			stripped := stripDigitSeparators(literal, true)
+
+			for _, bitSize := range []int{32, 64} {
+				want, err := strconv.ParseFloat(stripped, bitSize)
+				if err != nil {
+					want = math.NaN()
+				}
+
+				got, ok := exactFloat(literal, bitSize)
+				if ok && math.Float64bits(got) != math.Float64bits(want) {
+					t.Errorf("got %v, want %v for bit size %d", got, want, bitSize)
+				}
+			}
+		})
+	}
+}
+
+func TestFloatParsersDoNotAllocate(t *testing.T) {
+	stringParser := Float64[string]()
+	bytesParser := Float64[[]byte]()
+	float32Parser := Float32[[]byte]()
+	numberParser := Number[[]byte]()
+	input := []byte("-1234.5678e-3, 1")
+
+	allocs := testing.AllocsPerRun(100, func() {
+		stringParser("-1234.5678e-3, 1")
+		bytesParser(input)
+		float32Parser(input)
+		numberParser(input)
+	})
+
+	assert.Equal(t, 0.0, allocs)
+}
+
+func BenchmarkFloat64Bytes(b *testing.B) {
+	parser := Float64[[]byte]()
+	input := []byte("-1234.5678e-3")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(input)
+	}
+}
+
+func TestNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, float64]
+		input         string
+		wantErr       bool
+		wantOutput    float64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing an integer should succeed",
+			parser:        Number[string](),
+			input:         "42,",
+			wantErr:       false,
+			wantOutput:    42,
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing a decimal number should succeed",
+			parser:        Number[string](),
+			input:         "-3.25",
+			wantErr:       false,
+			wantOutput:    -3.25,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing scientific notation should succeed",
+			parser:        Number[string](),
+			input:         "1e9",
+			wantErr:       false,
+			wantOutput:    1e9,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a number with a leading plus should succeed",
+			parser:        Number[string](),
+			input:         "+3.5",
+			wantErr:       false,
+			wantOutput:    3.5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a number without integer part should succeed",
+			parser:        Number[string](),
+			input:         ".5",
+			wantErr:       false,
+			wantOutput:    0.5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a signed number without integer part with an exponent should succeed",
+			parser:        Number[string](),
+			input:         "-.5E2 ",
+			wantErr:       false,
+			wantOutput:    -50,
+			wantRemaining: " ",
+		},
+		{
+			name:          "parsing a lone dot should fail",
+			parser:        Number[string](),
+			input:         ".e1",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: ".e1",
+		},
+		{
+			name:          "parsing a number overflowing float64 should fail",
+			parser:        Number[string](),
+			input:         "1e400",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1e400",
+		},
+		{
+			name:          "parsing non numeric input should fail",
+			parser:        Number[string](),
+			input:         "abc",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Number[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a hexadecimal number with WithHex should succeed",
+			parser:        Number[string](WithHex()),
+			input:         "0x1F,",
+			wantErr:       false,
+			wantOutput:    31,
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing a negative hexadecimal number with WithHex should succeed",
+			parser:        Number[string](WithHex()),
+			input:         "-0Xff",
+			wantErr:       false,
+			wantOutput:    -255,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a zero followed by an x with WithHex should stop after the zero",
+			parser:        Number[string](WithHex()),
+			input:         "0xg",
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: "xg",
+		},
+		{
+			name:          "parsing a hexadecimal number without WithHex should stop after the zero",
+			parser:        Number[string](),
+			input:         "0x1F",
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: "x1F",
+		},
+		{
+			name:          "parsing an exponent with WithExponent should succeed",
+			parser:        Number[string](WithFormat(0), WithExponent(), WithHex()),
+			input:         "1.5e3",
+			wantErr:       false,
+			wantOutput:    1500,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an exponent with a format lacking it should stop before it",
+			parser:        Number[string](WithFormat(FloatLeadingPlus), WithHex()),
+			input:         "1.5e3",
+			wantErr:       false,
+			wantOutput:    1.5,
+			wantRemaining: "e3",
+		},
+		{
+			name:          "parsing a leading plus with a format lacking it should fail",
+			parser:        Number[string](WithFormat(FloatExponent)),
+			input:         "+1",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "+1",
+		},
+		{
+			name:          "parsing a leading plus with WithLeadingPlus should succeed",
+			parser:        Number[string](WithFormat(0), WithLeadingPlus(), WithHex()),
+			input:         "+0x10",
+			wantErr:       false,
+			wantOutput:    16,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a leading dot with WithLeadingDot should succeed",
+			parser:        Number[string](WithFormat(0), WithLeadingDot()),
+			input:         ".25",
+			wantErr:       false,
+			wantOutput:    0.25,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing the default syntax along with options should succeed",
+			parser:        Number[string](WithHex()),
+			input:         "+.5e1",
+			wantErr:       false,
+			wantOutput:    5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a leading dot with a format lacking it should fail",
+			parser:        Number[string](WithFormat(0)),
+			input:         ".5",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: ".5",
+		},
+		{
+			name:          "parsing digit separators with WithDigitSeparators should succeed",
+			parser:        Number[string](WithDigitSeparators()),
+			input:         "1_000.5",
+			wantErr:       false,
+			wantOutput:    1000.5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing infinity with WithInfNaN should succeed",
+			parser:        Number[string](WithInfNaN()),
+			input:         "-Inf",
+			wantErr:       false,
+			wantOutput:    math.Inf(-1),
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkNumber(b *testing.B) {
+	parser := Number[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("-3.25e2")
+	}
+}
+
+func BenchmarkNumberWithOptions(b *testing.B) {
+	parser := Number[string](WithFormat(FloatExponent), WithHex())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("0x1F")
+	}
+}
+
+func TestHexUint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, uint64]
+		input         string
+		wantErr       bool
+		wantOutput    uint64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a prefixed number should succeed",
+			parser:        HexUint[string](),
+			input:         "0x1F;",
+			wantErr:       false,
+			wantOutput:    31,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing an uppercase prefixed number should succeed",
+			parser:        HexUint[string](),
+			input:         "0XfF",
+			wantErr:       false,
+			wantOutput:    255,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an unprefixed number should succeed",
+			parser:        HexUint[string](),
+			input:         "ff zz",
+			wantErr:       false,
+			wantOutput:    255,
+			wantRemaining: " zz",
+		},
+		{
+			name:          "parsing the largest value should succeed",
+			parser:        HexUint[string](),
+			input:         "0xffffffffffffffff",
+			wantErr:       false,
+			wantOutput:    math.MaxUint64,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a prefix without digits should only parse the zero",
+			parser:        HexUint[string](),
+			input:         "0xzz",
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: "xzz",
+		},
+		{
+			name:          "parsing an overflowing number should fail",
+			parser:        HexUint[string](),
+			input:         "0x10000000000000000",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "0x10000000000000000",
+		},
+		{
+			name:          "parsing a negative number should fail",
+			parser:        HexUint[string](),
+			input:         "-0x1",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-0x1",
+		},
+		{
+			name:          "parsing non hexadecimal input should fail",
+			parser:        HexUint[string](),
+			input:         "zz",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "zz",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        HexUint[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkHexUint(b *testing.B) {
+	parser := HexUint[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("0x1F;")
+	}
+}
+
+func TestHexInt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a prefixed number should succeed",
+			parser:        HexInt[string](),
+			input:         "0x1F;",
+			wantErr:       false,
+			wantOutput:    31,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a negative prefixed number should succeed",
+			parser:        HexInt[string](),
+			input:         "-0x1F",
+			wantErr:       false,
+			wantOutput:    -31,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a negative unprefixed number should succeed",
+			parser:        HexInt[string](),
+			input:         "-ff",
+			wantErr:       false,
+			wantOutput:    -255,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing the largest value should succeed",
+			parser:        HexInt[string](),
+			input:         "0x7fffffffffffffff",
+			wantErr:       false,
+			wantOutput:    math.MaxInt64,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing the smallest value should succeed",
+			parser:        HexInt[string](),
+			input:         "-0x8000000000000000",
+			wantErr:       false,
+			wantOutput:    math.MinInt64,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an overflowing number should fail",
+			parser:        HexInt[string](),
+			input:         "0x8000000000000000",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "0x8000000000000000",
+		},
+		{
+			name:          "parsing an underflowing number should fail",
+			parser:        HexInt[string](),
+			input:         "-0x8000000000000001",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-0x8000000000000001",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        HexInt[string](),
+			input:         "-zz",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-zz",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        HexInt[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkHexInt(b *testing.B) {
+	parser := HexInt[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("-0x1F")
+	}
+}
+
+func TestHexIntOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		input       string
+		wantLiteral string
+	}{
+		{
+			name:        "parsing an overflowing number should fail with a range error",
+			input:       "0x8000000000000000;",
+			wantLiteral: "0x8000000000000000",
+		},
+		{
+			name:        "parsing an underflowing number should fail with a range error",
+			input:       "-0x8000000000000001;",
+			wantLiteral: "-0x8000000000000001",
+		},
+		{
+			name:        "parsing a number overflowing 64 bits should fail with a range error",
+			input:       "-0x10000000000000000;",
+			wantLiteral: "-0x10000000000000000",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := HexInt[string]()(tc.input)
+
+			var rangeErr *RangeError
+			if assert.True(t, errors.As(result.Err, &rangeErr)) {
+				assert.Equal(t, tc.wantLiteral, rangeErr.Literal)
+			}
+
+			assert.Equal(t, tc.input, result.Remaining)
+		})
+	}
+}
+
+func TestOctUint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, uint64]
+		input         string
+		wantErr       bool
+		wantOutput    uint64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a prefixed number should succeed",
+			parser:        OctUint[string](),
+			input:         "0o777 ",
+			wantErr:       false,
+			wantOutput:    511,
+			wantRemaining: " ",
+		},
+		{
+			name:          "parsing an uppercase prefixed number should succeed",
+			parser:        OctUint[string](),
+			input:         "0O17",
+			wantErr:       false,
+			wantOutput:    15,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an unprefixed number should succeed",
+			parser:        OctUint[string](),
+			input:         "755",
+			wantErr:       false,
+			wantOutput:    493,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing digits beyond octal should stop",
+			parser:        OctUint[string](),
+			input:         "0o78",
+			wantErr:       false,
+			wantOutput:    7,
+			wantRemaining: "8",
+		},
+		{
+			name:          "parsing the largest value should succeed",
+			parser:        OctUint[string](),
+			input:         "0o1777777777777777777777",
+			wantErr:       false,
+			wantOutput:    math.MaxUint64,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an overflowing number should fail",
+			parser:        OctUint[string](),
+			input:         "0o2000000000000000000000",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "0o2000000000000000000000",
+		},
+		{
+			name:          "parsing non octal input should fail",
+			parser:        OctUint[string](),
+			input:         "89",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "89",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        OctUint[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkOctUint(b *testing.B) {
+	parser := OctUint[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("0o777 ")
+	}
+}
+
+func TestOctInt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a prefixed number should succeed",
+			parser:        OctInt[string](),
+			input:         "0o17",
+			wantErr:       false,
+			wantOutput:    15,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a negative prefixed number should succeed",
+			parser:        OctInt[string](),
+			input:         "-0o17",
+			wantErr:       false,
+			wantOutput:    -15,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a negative unprefixed number should succeed",
+			parser:        OctInt[string](),
+			input:         "-17",
+			wantErr:       false,
+			wantOutput:    -15,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an overflowing number should fail",
+			parser:        OctInt[string](),
+			input:         "0o1000000000000000000000",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "0o1000000000000000000000",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        OctInt[string](),
+			input:         "-",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        OctInt[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkOctInt(b *testing.B) {
+	parser := OctInt[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("-0o17")
+	}
+}
+
+func TestBinUint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, uint64]
+		input         string
+		wantErr       bool
+		wantOutput    uint64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a prefixed number should succeed",
+			parser:        BinUint[string](),
+			input:         "0b1010,",
+			wantErr:       false,
+			wantOutput:    10,
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing an uppercase prefixed number should succeed",
+			parser:        BinUint[string](),
+			input:         "0B11",
+			wantErr:       false,
+			wantOutput:    3,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an unprefixed number should succeed",
+			parser:        BinUint[string](),
+			input:         "101",
+			wantErr:       false,
+			wantOutput:    5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing digits beyond binary should stop",
+			parser:        BinUint[string](),
+			input:         "0b12",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: "2",
+		},
+		{
+			name:          "parsing a prefix without digits should only parse the zero",
+			parser:        BinUint[string](),
+			input:         "0b",
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: "b",
+		},
+		{
+			name:          "parsing an overflowing number should fail",
+			parser:        BinUint[string](),
+			input:         "0b10000000000000000000000000000000000000000000000000000000000000000",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "0b10000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name:          "parsing non binary input should fail",
+			parser:        BinUint[string](),
+			input:         "23",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "23",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BinUint[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkBinUint(b *testing.B) {
+	parser := BinUint[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("0b1010,")
+	}
+}
+
+func TestBinInt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a prefixed number should succeed",
+			parser:        BinInt[string](),
+			input:         "0b101",
+			wantErr:       false,
+			wantOutput:    5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a negative prefixed number should succeed",
+			parser:        BinInt[string](),
+			input:         "-0b101",
+			wantErr:       false,
+			wantOutput:    -5,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a negative unprefixed number should succeed",
+			parser:        BinInt[string](),
+			input:         "-11",
+			wantErr:       false,
+			wantOutput:    -3,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        BinInt[string](),
+			input:         "-b",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-b",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BinInt[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkBinInt(b *testing.B) {
+	parser := BinInt[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("-0b101")
+	}
+}
+
+func TestIntegerBase(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a base 16 number without prefix should succeed",
+			parser:        IntegerBase[string](16),
+			input:         "ff;",
+			wantErr:       false,
+			wantOutput:    255,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a base 36 number should succeed",
+			parser:        IntegerBase[string](36),
+			input:         "Zz",
+			wantErr:       false,
+			wantOutput:    1295,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a negative base 3 number should succeed",
+			parser:        IntegerBase[string](3),
+			input:         "-1203",
+			wantErr:       false,
+			wantOutput:    -15,
+			wantRemaining: "3",
+		},
+		{
+			name:          "parsing a base 2 number should stop at invalid digits",
+			parser:        IntegerBase[string](2),
+			input:         "1012",
+			wantErr:       false,
+			wantOutput:    5,
+			wantRemaining: "2",
+		},
+		{
+			name:          "parsing a prefixed number should only parse the zero",
+			parser:        IntegerBase[string](16),
+			input:         "0x1f",
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: "x1f",
+		},
+		{
+			name:          "parsing an overflowing number should fail",
+			parser:        IntegerBase[string](16),
+			input:         "8000000000000000",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "8000000000000000",
+		},
+		{
+			name:          "parsing digits outside of the base should fail",
+			parser:        IntegerBase[string](8),
+			input:         "9",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "9",
+		},
+		{
+			name:          "parsing with a base too small should fail",
+			parser:        IntegerBase[string](1),
+			input:         "0",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "0",
+		},
+		{
+			name:          "parsing with a base too large should fail",
+			parser:        IntegerBase[string](37),
+			input:         "1",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "1",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        IntegerBase[string](10),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkIntegerBase(b *testing.B) {
+	parser := IntegerBase[string](16)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("ff;")
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, *big.Int]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing an integer should succeed",
+			parser:        BigInt[string](),
+			input:         "123abc",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "abc",
+		},
+		{
+			name:          "parsing an integer overflowing 64 bits should succeed",
+			parser:        BigInt[string](),
+			input:         "123456789012345678901234567890,",
+			wantErr:       false,
+			wantOutput:    "123456789012345678901234567890",
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing a negative integer should succeed",
+			parser:        BigInt[string](),
+			input:         "-98765432109876543210",
+			wantErr:       false,
+			wantOutput:    "-98765432109876543210",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        BigInt[string](),
+			input:         "-abc",
+			wantErr:       true,
+			wantRemaining: "-abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        BigInt[string](),
+			input:         "",
+			wantErr:       true,
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if !tc.wantErr && gotResult.Output.String() != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkBigInt(b *testing.B) {
+	parser := BigInt[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("123456789012345678901234567890")
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, DecimalValue]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantScale     int
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a decimal number should succeed",
+			parser:        Decimal[string](),
+			input:         "19.99 EUR",
+			wantErr:       false,
+			wantOutput:    "19.99",
+			wantScale:     2,
+			wantRemaining: " EUR",
+		},
+		{
+			name:          "parsing trailing zeros should preserve them",
+			parser:        Decimal[string](),
+			input:         "1.500",
+			wantErr:       false,
+			wantOutput:    "1.500",
+			wantScale:     3,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a negative number smaller than one should succeed",
+			parser:        Decimal[string](),
+			input:         "-0.05",
+			wantErr:       false,
+			wantOutput:    "-0.05",
+			wantScale:     2,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing digits beyond float64 precision should preserve them",
+			parser:        Decimal[string](),
+			input:         "12345678901234567890.123456789",
+			wantErr:       false,
+			wantOutput:    "12345678901234567890.123456789",
+			wantScale:     9,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an integer should succeed",
+			parser:        Decimal[string](),
+			input:         "42.",
+			wantErr:       false,
+			wantOutput:    "42",
+			wantScale:     0,
+			wantRemaining: ".",
+		},
+		{
+			name:          "parsing non numeric input should fail",
+			parser:        Decimal[string](),
+			input:         ".5",
+			wantErr:       true,
+			wantRemaining: ".5",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Decimal[string](),
+			input:         "",
+			wantErr:       true,
+			wantRemaining: "",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if !tc.wantErr {
+				if gotResult.Output.String() != tc.wantOutput {
+					t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+				}
+
+				if gotResult.Output.Scale != tc.wantScale {
+					t.Errorf("got scale %v, want scale %v", gotResult.Output.Scale, tc.wantScale)
+				}
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkDecimal(b *testing.B) {
+	parser := Decimal[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("12345678901234567890.123456789")
+	}
+}
+
+func TestDecimalValueRat(t *testing.T) {
+	t.Parallel()
+
+	result := Decimal[string]()("-1.250")
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "-5/4", result.Output.Rat().String())
+}
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, time.Duration]
+		input         string
+		wantErr       bool
+		wantOutput    time.Duration
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a single unit duration should succeed",
+			parser:        Duration[string](),
+			input:         "250ms;",
+			wantErr:       false,
+			wantOutput:    250 * time.Millisecond,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a compound duration should succeed",
+			parser:        Duration[string](),
+			input:         "1h30m",
+			wantErr:       false,
+			wantOutput:    90 * time.Minute,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a fractional negative duration should succeed",
+			parser:        Duration[string](),
+			input:         "-1.5h",
+			wantErr:       false,
+			wantOutput:    -90 * time.Minute,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a microseconds duration should succeed",
+			parser:        Duration[string](),
+			input:         "3µs 2us",
+			wantErr:       false,
+			wantOutput:    3 * time.Microsecond,
+			wantRemaining: " 2us",
+		},
+		{
+			name:          "parsing a leading dot duration should succeed",
+			parser:        Duration[string](),
+			input:         ".5s",
+			wantErr:       false,
+			wantOutput:    500 * time.Millisecond,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a duration should stop at an unknown unit",
+			parser:        Duration[string](),
+			input:         "1m2d",
+			wantErr:       false,
+			wantOutput:    time.Minute,
+			wantRemaining: "2d",
+		},
+		{
+			name:          "parsing a duration should stop at a signed component",
+			parser:        Duration[string](),
+			input:         "1h-30m",
+			wantErr:       false,
+			wantOutput:    time.Hour,
+			wantRemaining: "-30m",
+		},
+		{
+			name:          "parsing a signed duration should stop at a signed component",
+			parser:        Duration[string](),
+			input:         "+1h+30m",
+			wantErr:       false,
+			wantOutput:    time.Hour,
+			wantRemaining: "+30m",
+		},
+		{
+			name:          "parsing a trailing dot component should succeed",
+			parser:        Duration[string](),
+			input:         "1.h",
+			wantErr:       false,
+			wantOutput:    time.Hour,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a number without unit should fail",
+			parser:        Duration[string](),
+			input:         "15",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "15",
+		},
+		{
+			name:          "parsing an overflowing duration should fail",
+			parser:        Duration[string](),
+			input:         "9999999999h",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "9999999999h",
+		},
+		{
+			name:          "parsing a lone sign should fail",
+			parser:        Duration[string](),
+			input:         "-h",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-h",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Duration[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a bare zero should succeed",
+			parser:        Duration[string](),
+			input:         "0",
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a signed bare zero should succeed",
+			parser:        Duration[string](),
+			input:         "-0;",
+			wantErr:       false,
+			wantOutput:    0,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a fractional zero without unit should fail",
+			parser:        Duration[string](),
+			input:         "0.5",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "0.5",
+		},
+		{
+			name:          "parsing a zero with leading zeros and no unit should fail",
+			parser:        Duration[string](),
+			input:         "00",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "00",
+		},
+		{
+			name:          "parsing an overflowing duration should fail",
+			parser:        Duration[string](),
+			input:         "3000000h",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "3000000h",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func TestDurationRangeError(t *testing.T) {
+	t.Parallel()
+
+	result := Duration[string]()("3000000h;")
+
+	var rangeErr *RangeError
+	if result.Err == nil || !result.Err.IsFatal() || !errors.As(result.Err, &rangeErr) {
+		t.Fatalf("got error %v, want fatal RangeError", result.Err)
+	}
+
+	if rangeErr.Literal != "3000000h" {
+		t.Errorf("got literal %q, want literal %q", rangeErr.Literal, "3000000h")
+	}
+}
+func BenchmarkDuration(b *testing.B) {
+	parser := Duration[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1h30m")
+	}
+}
+
+func TestByteSize(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a size without unit should succeed",
+			parser:        ByteSize[string](),
+			input:         "512;",
+			wantErr:       false,
+			wantOutput:    512,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a size in bytes should succeed",
+			parser:        ByteSize[string](),
+			input:         "512B",
+			wantErr:       false,
+			wantOutput:    512,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a decimal unit should succeed",
+			parser:        ByteSize[string](),
+			input:         "10KB",
+			wantErr:       false,
+			wantOutput:    10000,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a binary unit should succeed",
+			parser:        ByteSize[string](),
+			input:         "2MiB",
+			wantErr:       false,
+			wantOutput:    2 << 20,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a short binary unit should succeed",
+			parser:        ByteSize[string](),
+			input:         "4Gi",
+			wantErr:       false,
+			wantOutput:    4 << 30,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a fractional size with a prefix-only unit should succeed",
+			parser:        ByteSize[string](),
+			input:         "1.5G",
+			wantErr:       false,
+			wantOutput:    1500000000,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a lowercase unit should succeed",
+			parser:        ByteSize[string](),
+			input:         "3kib,",
+			wantErr:       false,
+			wantOutput:    3072,
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing a fraction of a byte should truncate it",
+			parser:        ByteSize[string](),
+			input:         "1.5",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an unknown unit should leave it in the remaining input",
+			parser:        ByteSize[string](),
+			input:         "10 KB",
+			wantErr:       false,
+			wantOutput:    10,
+			wantRemaining: " KB",
+		},
+		{
+			name:          "parsing an overflowing size should fail",
+			parser:        ByteSize[string](),
+			input:         "8EiB",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "8EiB",
+		},
+		{
+			name:          "parsing a negative size should fail",
+			parser:        ByteSize[string](),
+			input:         "-1KB",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "-1KB",
+		},
+		{
+			name:          "parsing non numeric input should fail",
+			parser:        ByteSize[string](),
+			input:         "KB",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "KB",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        ByteSize[string](),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an integer beyond float64 precision should be exact",
+			parser:        ByteSize[string](),
+			input:         "9007199254740993",
+			wantErr:       false,
+			wantOutput:    9007199254740993,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing the largest int64 should succeed",
+			parser:        ByteSize[string](),
+			input:         "9223372036854775807B",
+			wantErr:       false,
+			wantOutput:    math.MaxInt64,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a size one byte beyond the largest int64 should fail",
+			parser:        ByteSize[string](),
+			input:         "9223372036854775808",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "9223372036854775808",
+		},
+		{
+			name:          "parsing a fractional size close to the largest int64 should succeed",
+			parser:        ByteSize[string](),
+			input:         "7.5EiB",
+			wantErr:       false,
+			wantOutput:    15 << 59,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkByteSize(b *testing.B) {
+	parser := ByteSize[string]()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1.5GiB")
+	}
+}
+
+func TestGroupedInt(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, int64]
+		input         string
+		wantErr       bool
+		wantOutput    int64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a grouped integer should succeed",
+			parser:        GroupedInt[string](','),
+			input:         "9,223,372,036,854,775,807\r\n",
+			wantErr:       false,
+			wantOutput:    math.MaxInt64,
+			wantRemaining: "\r\n",
+		},
+		{
+			name:          "parsing a negative grouped integer should succeed",
+			parser:        GroupedInt[string](' '),
+			input:         "-1 234 567 apples",
+			wantErr:       false,
+			wantOutput:    -1234567,
+			wantRemaining: " apples",
+		},
+		{
+			name:          "parsing an ungrouped integer should succeed",
+			parser:        GroupedInt[string](','),
+			input:         "1234567",
+			wantErr:       false,
+			wantOutput:    1234567,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a separator followed by too few digits should stop before it",
+			parser:        GroupedInt[string](','),
+			input:         "1,23",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: ",23",
+		},
+		{
+			name:          "parsing a separator followed by too many digits should stop before it",
+			parser:        GroupedInt[string](','),
+			input:         "1,234,5678",
+			wantErr:       false,
+			wantOutput:    1234,
+			wantRemaining: ",5678",
+		},
+		{
+			name:          "parsing a separator after a long first group should stop before it",
+			parser:        GroupedInt[string](','),
+			input:         "1234,567",
+			wantErr:       false,
+			wantOutput:    1234,
+			wantRemaining: ",567",
+		},
+		{
+			name:          "parsing an overflowing integer should fail",
+			parser:        GroupedInt[string](','),
+			input:         "9,223,372,036,854,775,808",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "9,223,372,036,854,775,808",
+		},
+		{
+			name:          "parsing a leading separator should fail",
+			parser:        GroupedInt[string](','),
+			input:         ",123",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: ",123",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        GroupedInt[string](','),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing an integer with digit separators using IntDigitSeparators should succeed",
+			parser:        GroupedInt[string](',', IntDigitSeparators),
+			input:         "1_000_000,5",
+			wantErr:       false,
+			wantOutput:    1000000,
+			wantRemaining: ",5",
+		},
+		{
+			name:          "parsing a grouped integer using IntDigitSeparators should succeed",
+			parser:        GroupedInt[string](',', IntDigitSeparators),
+			input:         "1,000_5",
+			wantErr:       false,
+			wantOutput:    1000,
+			wantRemaining: "_5",
+		},
+		{
+			name:          "parsing an integer with digit separators without IntDigitSeparators should stop before them",
+			parser:        GroupedInt[string](','),
+			input:         "1_000",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: "_000",
+		},
+		{
+			name:          "parsing an overflowing integer with digit separators using IntDigitSeparators should fail",
+			parser:        GroupedInt[string](',', IntDigitSeparators),
+			input:         "9_223_372_036_854_775_808",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "9_223_372_036_854_775_808",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkGroupedInt(b *testing.B) {
+	parser := GroupedInt[string](',')
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("9,223,372,036,854,775,807")
+	}
+}
+
+func TestGroupedNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, float64]
+		input         string
+		wantErr       bool
+		wantOutput    float64
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a number using a dot decimal mark should succeed",
+			parser:        GroupedNumber[string](',', '.'),
+			input:         "1,234,567.89;",
+			wantErr:       false,
+			wantOutput:    1234567.89,
+			wantRemaining: ";",
+		},
+		{
+			name:          "parsing a number using a comma decimal mark should succeed",
+			parser:        GroupedNumber[string]('.', ','),
+			input:         "-1.234.567,89",
+			wantErr:       false,
+			wantOutput:    -1234567.89,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a number without decimal part should succeed",
+			parser:        GroupedNumber[string](',', '.'),
+			input:         "12,345",
+			wantErr:       false,
+			wantOutput:    12345,
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a number with a short group before the decimal mark should stop",
+			parser:        GroupedNumber[string]('.', ','),
+			input:         "1.23,5",
+			wantErr:       false,
+			wantOutput:    1,
+			wantRemaining: ".23,5",
+		},
+		{
+			name:          "parsing a decimal mark without decimals should stop before it",
+			parser:        GroupedNumber[string](',', '.'),
+			input:         "1,000.",
+			wantErr:       false,
+			wantOutput:    1000,
+			wantRemaining: ".",
+		},
+		{
+			name:          "parsing non numeric input should fail",
+			parser:        GroupedNumber[string](',', '.'),
+			input:         ".5",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: ".5",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        GroupedNumber[string](',', '.'),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    0,
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkGroupedNumber(b *testing.B) {
+	parser := GroupedNumber[string](',', '.')
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1,234,567.89")
+	}
+}
@@ -0,0 +1,211 @@
+package gomme
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Pattern compiles a scanf-like format into a parser, which matches the format's
+// literal text, and produces the values captured by its verbs, in order. It is a
+// quick way to parse simple line-oriented formats, such as "%d-%d-%d %s", before
+// describing them using combinators.
+//
+// The supported verbs are:
+//   - %d, %x, %o, and %b capture an optionally negative decimal, hexadecimal,
+//     octal, or binary integer, as an int64. Like HexInt, OctInt, and BinInt,
+//     the latter three accept an optional base prefix.
+//   - %f, %e, and %g capture a floating-point number, as a float64.
+//   - %s captures a non-empty run of non-whitespace characters, stopping before
+//     the literal text which follows it in the format, if any, as a string.
+//   - %q captures a double quoted string, with its escape sequences decoded, as
+//     a string.
+//   - %c captures a single character, as a rune.
+//   - %% matches a literal percent sign.
+//
+// Like in fmt's scanning functions, a run of whitespace in the format matches
+// zero or more whitespace characters of the input. Any other character of the
+// format matches itself.
+//
+// If the input doesn't match the format, the parser returns an error result,
+// whose error points at the part of the input which didn't match. If the format
+// holds an unknown or incomplete verb, the parser always returns a fatal error
+// result.
+func Pattern[Input Bytes](format string) Parser[Input, []any] {
+	steps, captures, err := compilePattern[Input](format)
+
+	return func(input Input) Result[[]any, Input] {
+		if err != nil {
+			return Failure[Input, []any](&Error[Input]{Input: input, Err: err, Expected: []string{"Pattern"}}, input)
+		}
+
+		values := make([]any, 0, captures)
+		remaining := input
+
+		for _, step := range steps {
+			result := step.parse(remaining)
+			if result.Err != nil {
+				if result.Err.IsFatal() {
+					return Failure[Input, []any](result.Err, input)
+				}
+
+				return Failure[Input, []any](NewError(remaining, step.expected), input)
+			}
+
+			if step.capture {
+				values = append(values, result.Output)
+			}
+
+			remaining = result.Remaining
+		}
+
+		return Success(values, remaining)
+	}
+}
+
+// patternStep is one of the elements a Pattern's format is made of.
+type patternStep[Input Bytes] struct {
+	parse Parser[Input, any]
+
+	// expected describes the step in the errors reporting the input doesn't
+	// match it.
+	expected string
+
+	// capture is true if the step's output is one of the pattern's values.
+	capture bool
+}
+
+// compilePattern splits the provided format into the steps matching it, and
+// returns them along with the number of values they capture.
+func compilePattern[Input Bytes](format string) ([]patternStep[Input], int, error) {
+	var (
+		steps    []patternStep[Input]
+		captures int
+		literal  strings.Builder
+	)
+
+	// Literal text is accumulated until the next verb or whitespace, so that it
+	// is matched as a single token.
+	flush := func() {
+		if literal.Len() > 0 {
+			token := literal.String()
+			steps = append(steps, patternStep[Input]{parse: Untyped(Token[Input](token)), expected: fmt.Sprintf("%q", token)})
+			literal.Reset()
+		}
+	}
+
+	for idx := 0; idx < len(format); {
+		c, size := utf8.DecodeRuneInString(format[idx:])
+
+		switch {
+		case IsWhitespace(c):
+			flush()
+			for idx < len(format) && IsWhitespace(rune(format[idx])) {
+				idx++
+			}
+
+			steps = append(steps, patternStep[Input]{parse: Untyped(Whitespace0[Input]()), expected: "whitespace"})
+
+			continue
+		case c != '%':
+			literal.WriteString(format[idx : idx+size])
+			idx += size
+
+			continue
+		}
+
+		if idx+1 >= len(format) {
+			return nil, 0, fmt.Errorf("gomme: invalid pattern %q: incomplete verb at offset %d", format, idx)
+		}
+
+		verb := format[idx+1]
+		idx += 2
+
+		if verb == '%' {
+			literal.WriteByte('%')
+			continue
+		}
+
+		flush()
+
+		var parse Parser[Input, any]
+		switch verb {
+		case 'd':
+			parse = Untyped(Int64[Input]())
+		case 'x':
+			parse = Untyped(HexInt[Input]())
+		case 'o':
+			parse = Untyped(OctInt[Input]())
+		case 'b':
+			parse = Untyped(BinInt[Input]())
+		case 'f', 'e', 'g':
+			parse = Untyped(Float64[Input]())
+		case 's':
+			parse = Untyped(patternWord[Input](literalAfter(format[idx:])))
+		case 'q':
+			parse = Untyped(QuotedString[Input]())
+		case 'c':
+			parse = Untyped(AnyRune[Input]())
+		default:
+			return nil, 0, fmt.Errorf("gomme: invalid pattern %q: unknown verb %%%c", format, verb)
+		}
+
+		steps = append(steps, patternStep[Input]{parse: parse, expected: "%" + string(verb), capture: true})
+		captures++
+	}
+
+	flush()
+
+	return steps, captures, nil
+}
+
+// literalAfter returns the literal text the provided rest of a format starts with,
+// ignoring leading whitespace, up to the next verb or whitespace.
+func literalAfter(rest string) string {
+	var literal strings.Builder
+
+	rest = strings.TrimLeft(rest, " \t\r\n")
+
+	for idx := 0; idx < len(rest); idx++ {
+		c := rest[idx]
+		if IsWhitespace(rune(c)) {
+			break
+		}
+
+		if c == '%' {
+			if idx+1 >= len(rest) || rest[idx+1] != '%' {
+				break
+			}
+
+			idx++
+		}
+
+		literal.WriteByte(c)
+	}
+
+	return literal.String()
+}
+
+// patternWord produces the parser of Pattern's %s verb, which captures a
+// non-empty run of non-whitespace characters, stopping before the provided
+// terminator, if any.
+func patternWord[Input Bytes](terminator string) Parser[Input, string] {
+	terminatorBytes := []byte(terminator)
+
+	return func(input Input) Result[string, Input] {
+		end := 0
+		for end < len(input) && !IsWhitespace(rune(input[end])) {
+			if terminator != "" && hasPrefix(input[end:], terminator, terminatorBytes) {
+				break
+			}
+
+			end++
+		}
+
+		if end == 0 {
+			return Failure[Input, string](NewError(input, "%s"), input)
+		}
+
+		return Success(viewString(input[:end]), input[end:])
+	}
+}
@@ -0,0 +1,155 @@
+package gomme
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPattern(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		format        string
+		input         string
+		wantErr       bool
+		wantOutput    []any
+		wantRemaining string
+	}{
+		{
+			name:          "date and word should be captured",
+			format:        "%d-%d-%d %s",
+			input:         "2023-10-07 saturday!",
+			wantErr:       false,
+			wantOutput:    []any{int64(2023), int64(10), int64(7), "saturday!"},
+			wantRemaining: "",
+		},
+		{
+			name:          "whitespace in the format should match any amount of whitespace",
+			format:        "%s = %f",
+			input:         "pi=3.14 rest",
+			wantErr:       false,
+			wantOutput:    []any{"pi", 3.14},
+			wantRemaining: " rest",
+		},
+		{
+			name:          "words should stop before the following literal",
+			format:        "%s:%s@%s",
+			input:         "user:secret@host",
+			wantErr:       false,
+			wantOutput:    []any{"user", "secret", "host"},
+			wantRemaining: "",
+		},
+		{
+			name:          "radix integers, quoted strings and characters should be captured",
+			format:        "%x %o %b %q %c",
+			input:         `0xff 17 -0b101 "a\"b" é`,
+			wantErr:       false,
+			wantOutput:    []any{int64(255), int64(15), int64(-5), `a"b`, 'é'},
+			wantRemaining: "",
+		},
+		{
+			name:          "escaped percent signs should match themselves",
+			format:        "%d%%",
+			input:         "42%",
+			wantErr:       false,
+			wantOutput:    []any{int64(42)},
+			wantRemaining: "",
+		},
+		{
+			name:          "format without verbs should capture nothing",
+			format:        "GET /",
+			input:         "GET /index.html",
+			wantErr:       false,
+			wantOutput:    []any{},
+			wantRemaining: "index.html",
+		},
+		{
+			name:          "mismatching literal should fail",
+			format:        "%d-%d",
+			input:         "12/34",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "12/34",
+		},
+		{
+			name:          "mismatching verb should fail",
+			format:        "%d %d",
+			input:         "12 abc",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "12 abc",
+		},
+		{
+			name:          "empty word should fail",
+			format:        "[%s]",
+			input:         "[]",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "[]",
+		},
+		{
+			name:          "unknown verb should fail",
+			format:        "%y",
+			input:         "42",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "42",
+		},
+		{
+			name:          "incomplete verb should fail",
+			format:        "%d%",
+			input:         "42%",
+			wantErr:       true,
+			wantOutput:    nil,
+			wantRemaining: "42%",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := Pattern[string](tc.format)(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+		})
+	}
+}
+
+func TestPatternErrors(t *testing.T) {
+	t.Parallel()
+
+	result := Pattern[[]byte]("%d-%d")([]byte("12/34"))
+	assert.Error(t, result.Err)
+	assert.False(t, result.Err.IsFatal())
+	assert.Equal(t, []string{`"-"`}, result.Err.Expected)
+	assert.Equal(t, []byte("/34"), result.Err.Input)
+
+	result = Pattern[[]byte]("%d")([]byte("99999999999999999999"))
+	assert.True(t, result.Err.IsFatal())
+	assert.True(t, errors.Is(result.Err, strconv.ErrRange))
+
+	result = Pattern[[]byte]("%y")([]byte("42"))
+	assert.True(t, result.Err.IsFatal())
+	assert.EqualError(t, result.Err, `expected Pattern: gomme: invalid pattern "%y": unknown verb %y`)
+}
+
+func BenchmarkPattern(b *testing.B) {
+	parser := Pattern[string]("%d-%d-%d %s")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("2023-10-07 saturday")
+	}
+}
@@ -0,0 +1,131 @@
+package gomme
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Printer is the dual of a Parser: it appends the representation of a value of
+// type Output to the provided input, and returns the extended input. Printers
+// are composed using the duals of the parser combinators, such as PrintDelimited
+// or PrintSeparatedList, so that they mirror the parsers of the same format.
+type Printer[Output any, Input Bytes] func(dst Input, value Output) (Input, error)
+
+// Print returns the representation of the provided value, as produced by the
+// provided printer.
+func Print[Input Bytes, Output any](printer Printer[Output, Input], value Output) (Input, error) {
+	var dst Input
+	return printer(dst, value)
+}
+
+// Iso is a pair of conversions between the values of two types, inverse of each
+// other. It maps the outputs of a parser with To, and the values of its dual
+// printer with From, so that parsing and printing remain symmetrical.
+type Iso[A, B any] struct {
+	// To converts a parser's output.
+	To func(A) (B, error)
+
+	// From converts a value back into one its printer can print.
+	From func(B) (A, error)
+}
+
+// PrintToken produces a printer appending the provided token. It is the dual of
+// the parsers whose output is discarded, such as delimiters or separators.
+func PrintToken[Input Bytes](token string) Printer[struct{}, Input] {
+	return func(dst Input, _ struct{}) (Input, error) {
+		return appendString(dst, token), nil
+	}
+}
+
+// PrintInput produces a printer appending values verbatim. It is the dual of the
+// parsers producing the part of the input they matched, such as Alpha1.
+func PrintInput[Input Bytes]() Printer[Input, Input] {
+	return func(dst Input, value Input) (Input, error) {
+		return appendString(dst, string(value)), nil
+	}
+}
+
+// PrintString produces a printer appending strings verbatim.
+func PrintString[Input Bytes]() Printer[string, Input] {
+	return func(dst Input, value string) (Input, error) {
+		return appendString(dst, value), nil
+	}
+}
+
+// PrintInt64 produces a printer appending integers in decimal. It is the dual of
+// Int64.
+func PrintInt64[Input Bytes]() Printer[int64, Input] {
+	return func(dst Input, value int64) (Input, error) {
+		return appendString(dst, strconv.FormatInt(value, 10)), nil
+	}
+}
+
+// PrintDelimited produces a printer appending the prefix, the value printed by
+// the provided printer, and the suffix. It is the dual of Delimited.
+func PrintDelimited[Input Bytes, Output any](
+	prefix Printer[struct{}, Input],
+	printer Printer[Output, Input],
+	suffix Printer[struct{}, Input],
+) Printer[Output, Input] {
+	return func(dst Input, value Output) (Input, error) {
+		dst, err := prefix(dst, struct{}{})
+		if err != nil {
+			return dst, err
+		}
+
+		if dst, err = printer(dst, value); err != nil {
+			return dst, err
+		}
+
+		return suffix(dst, struct{}{})
+	}
+}
+
+// PrintSeparatedList produces a printer appending each of the values of a slice
+// using the provided printer, separated by the provided separator. It is the
+// dual of SeparatedList0, and SeparatedList1.
+func PrintSeparatedList[Input Bytes, Output any](
+	printer Printer[Output, Input],
+	separator Printer[struct{}, Input],
+) Printer[[]Output, Input] {
+	return func(dst Input, values []Output) (Input, error) {
+		var err error
+		for idx, value := range values {
+			if idx > 0 {
+				if dst, err = separator(dst, struct{}{}); err != nil {
+					return dst, err
+				}
+			}
+
+			if dst, err = printer(dst, value); err != nil {
+				return dst, fmt.Errorf("element %d: %w", idx, err)
+			}
+		}
+
+		return dst, nil
+	}
+}
+
+// PrintMap produces a printer converting values using the provided iso's From
+// conversion before printing them using the provided printer. It is the dual of
+// Map, applying the iso's To conversion.
+func PrintMap[Input Bytes, A, B any](printer Printer[A, Input], iso Iso[A, B]) Printer[B, Input] {
+	return func(dst Input, value B) (Input, error) {
+		converted, err := iso.From(value)
+		if err != nil {
+			return dst, err
+		}
+
+		return printer(dst, converted)
+	}
+}
+
+// appendString appends the provided text to the input.
+func appendString[Input Bytes](dst Input, text string) Input {
+	switch typed := any(dst).(type) {
+	case []byte:
+		return Input(append(typed, text...))
+	default:
+		return any(typed.(string) + text).(Input)
+	}
+}
@@ -0,0 +1,180 @@
+package gomme
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrint(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		print   func() (string, error)
+		wantErr bool
+		want    string
+	}{
+		{
+			name:  "printing a token should append it",
+			print: func() (string, error) { return Print(PrintToken[string]("->"), struct{}{}) },
+			want:  "->",
+		},
+		{
+			name:  "printing an input should append it verbatim",
+			print: func() (string, error) { return Print(PrintInput[string](), "abc") },
+			want:  "abc",
+		},
+		{
+			name:  "printing a string should append it verbatim",
+			print: func() (string, error) { return Print(PrintString[string](), "a b") },
+			want:  "a b",
+		},
+		{
+			name:  "printing a negative integer should append it in decimal",
+			print: func() (string, error) { return Print(PrintInt64[string](), -42) },
+			want:  "-42",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.print()
+			if (err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", err, tc.wantErr)
+			}
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPrintAppendsToBytes(t *testing.T) {
+	t.Parallel()
+
+	dst := make([]byte, 0, 16)
+	dst = append(dst, "x="...)
+
+	got, err := PrintInt64[[]byte]()(dst, 12)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("x=12"), got)
+}
+
+func TestPrintDelimited(t *testing.T) {
+	t.Parallel()
+
+	printer := PrintDelimited(PrintToken[string]("("), PrintInt64[string](), PrintToken[string](")"))
+
+	got, err := Print(printer, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, "(7)", got)
+
+	failing := PrintDelimited(PrintToken[string]("("), failingPrinter[int64](), PrintToken[string](")"))
+
+	_, err = Print(failing, 7)
+	assert.ErrorIs(t, err, errUnprintable)
+}
+
+func BenchmarkPrintDelimited(b *testing.B) {
+	printer := PrintDelimited(PrintToken[[]byte]("("), PrintInt64[[]byte](), PrintToken[[]byte](")"))
+	dst := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = printer(dst[:0], 12345)
+	}
+}
+
+func TestPrintSeparatedList(t *testing.T) {
+	t.Parallel()
+
+	printer := PrintSeparatedList(PrintInt64[string](), PrintToken[string](", "))
+
+	testCases := []struct {
+		name   string
+		values []int64
+		want   string
+	}{
+		{name: "printing no values should print nothing", values: nil, want: ""},
+		{name: "printing a single value should print no separator", values: []int64{1}, want: "1"},
+		{name: "printing values should separate them", values: []int64{1, 2, 3}, want: "1, 2, 3"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Print(printer, tc.values)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+
+	_, err := Print(PrintSeparatedList(failingPrinter[int64](), PrintToken[string](",")), []int64{1})
+	assert.EqualError(t, err, "element 0: unprintable value")
+}
+
+func BenchmarkPrintSeparatedList(b *testing.B) {
+	printer := PrintSeparatedList(PrintInt64[[]byte](), PrintToken[[]byte](","))
+	values := []int64{1, 22, 333, 4444, 55555}
+	dst := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = printer(dst[:0], values)
+	}
+}
+
+func TestPrintMap(t *testing.T) {
+	t.Parallel()
+
+	printer := PrintMap(PrintInt64[string](), Iso[int64, bool]{
+		To: func(value int64) (bool, error) { return value != 0, nil },
+		From: func(value bool) (int64, error) {
+			if value {
+				return 1, nil
+			}
+
+			return 0, errors.New("false can't be printed")
+		},
+	})
+
+	got, err := Print(printer, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", got)
+
+	_, err = Print(printer, false)
+	assert.Error(t, err)
+}
+
+func BenchmarkPrintMap(b *testing.B) {
+	printer := PrintMap(PrintInt64[[]byte](), Iso[int64, int]{
+		To:   func(value int64) (int, error) { return int(value), nil },
+		From: func(value int) (int64, error) { return int64(value), nil },
+	})
+	dst := make([]byte, 0, 64)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = printer(dst[:0], 12345)
+	}
+}
+
+var errUnprintable = errors.New("unprintable value")
+
+// failingPrinter produces a printer failing to print any value.
+func failingPrinter[Output any]() Printer[Output, string] {
+	return func(dst string, _ Output) (string, error) {
+		return dst, errUnprintable
+	}
+}
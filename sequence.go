@@ -9,6 +9,86 @@ func Delimited[I Bytes, OP, O, OS any](prefix Parser[I, OP], parser Parser[I, O]
 	}
 }
 
+// FieldParser is a sequence step producing no output of its own, but instead
+// assigning the value it parsed to a field of the provided target. FieldParser
+// values are produced by Field, and consumed by Into.
+type FieldParser[I Bytes, T any] func(input I, target *T) Result[struct{}, I]
+
+// Field pairs a parser with a setter function in charge of assigning its output
+// to the target value being built by Into. If the setter is nil, the parser's
+// output is discarded, which allows to mix structural tokens with field values.
+func Field[I Bytes, T, O any](parse Parser[I, O], set func(*T, O)) FieldParser[I, T] {
+	return func(input I, target *T) Result[struct{}, I] {
+		result := parse(input)
+		if result.Err != nil {
+			return Failure[I, struct{}](result.Err, input)
+		}
+
+		if set != nil {
+			set(target, result.Output)
+		}
+
+		return Success(struct{}{}, result.Remaining)
+	}
+}
+
+// SkipField wraps a parser whose output should be discarded by Into, such as
+// delimiters or whitespace found between fields.
+func SkipField[I Bytes, T, O any](parse Parser[I, O]) FieldParser[I, T] {
+	return Field[I, T, O](parse, nil)
+}
+
+// Into applies a sequence of field parsers in order, and returns the value of
+// type T they populated as its output. It keeps each field's assignment right
+// next to the parser producing it, instead of mapping over nested containers.
+//
+// If any of the field parsers fails, Into fails and returns an error Result.
+func Into[I Bytes, T any](fields ...FieldParser[I, T]) Parser[I, T] {
+	return func(input I) Result[T, I] {
+		var target T
+
+		remaining := input
+		for _, field := range fields {
+			result := field(remaining, &target)
+			if result.Err != nil {
+				return Failure[I, T](result.Report(), input)
+			}
+
+			remaining = result.Remaining
+		}
+
+		return Success(target, remaining)
+	}
+}
+
+// Lexeme applies the provided parser, and then skips any whitespace following
+// it, as parsed by Whitespace0 using the provided whitespace policies, if any. It
+// allows describing a grammar's tokens once, instead of surrounding each of their
+// uses with whitespace parsers.
+func Lexeme[I Bytes, O any](parse Parser[I, O], policies ...WhitespacePolicy) Parser[I, O] {
+	return Terminated(parse, Whitespace0[I](policies...))
+}
+
+// LexemeWith behaves like Lexeme, but skips what the provided space parser
+// matches after the parser, such as whitespace interleaved with comments.
+func LexemeWith[I Bytes, O, OS any](space Parser[I, OS], parse Parser[I, O]) Parser[I, O] {
+	return Terminated(parse, space)
+}
+
+// Padded applies the provided parser, skipping any whitespace, as parsed by
+// Whitespace0 using the provided whitespace policies, if any, found before and
+// after it.
+func Padded[I Bytes, O any](parse Parser[I, O], policies ...WhitespacePolicy) Parser[I, O] {
+	space := Whitespace0[I](policies...)
+	return Delimited(space, parse, space)
+}
+
+// PaddedWith behaves like Padded, but skips what the provided space parser
+// matches before and after the parser.
+func PaddedWith[I Bytes, O, OS any](space Parser[I, OS], parse Parser[I, O]) Parser[I, O] {
+	return Delimited(space, parse, space)
+}
+
 // Pair applies two parsers and returns a Result containing a pair container holding
 // the resulting values.
 func Pair[I Bytes, LO, RO any, LP Parser[I, LO], RP Parser[I, RO]](
@@ -17,18 +97,44 @@ func Pair[I Bytes, LO, RO any, LP Parser[I, LO], RP Parser[I, RO]](
 	return func(input I) Result[PairContainer[LO, RO], I] {
 		leftResult := leftParser(input)
 		if leftResult.Err != nil {
-			return Failure[I, PairContainer[LO, RO]](NewError(input, "Pair"), input)
+			return Failure[I, PairContainer[LO, RO]](sequenceError(leftResult.Err, input, "Pair"), input)
 		}
 
 		rightResult := rightParser(leftResult.Remaining)
 		if rightResult.Err != nil {
-			return Failure[I, PairContainer[LO, RO]](NewError(input, "Pair"), input)
+			return Failure[I, PairContainer[LO, RO]](sequenceError(rightResult.Err, input, "Pair"), input)
 		}
 
 		return Success(PairContainer[LO, RO]{leftResult.Output, rightResult.Output}, rightResult.Remaining)
 	}
 }
 
+// PairIn behaves like Pair, but allocates the pair container from the provided
+// arena, rather than from the heap, and returns a pointer to it, as MapIn does.
+//
+// As parsers allocating from the same arena must not be invoked concurrently,
+// PairIn parsers must not be either.
+func PairIn[I Bytes, LO, RO any, LP Parser[I, LO], RP Parser[I, RO]](
+	arena *Arena[PairContainer[LO, RO]], leftParser LP, rightParser RP,
+) Parser[I, *PairContainer[LO, RO]] {
+	return func(input I) Result[*PairContainer[LO, RO], I] {
+		leftResult := leftParser(input)
+		if leftResult.Err != nil {
+			return Failure[I, *PairContainer[LO, RO]](sequenceError(leftResult.Err, input, "PairIn"), input)
+		}
+
+		rightResult := rightParser(leftResult.Remaining)
+		if rightResult.Err != nil {
+			return Failure[I, *PairContainer[LO, RO]](sequenceError(rightResult.Err, input, "PairIn"), input)
+		}
+
+		pair := arena.New()
+		*pair = PairContainer[LO, RO]{leftResult.Output, rightResult.Output}
+
+		return Success(pair, rightResult.Remaining)
+	}
+}
+
 // Preceded parses and discards a result from the prefix parser. It
 // then parses a result from the main parser and returns its result.
 //
@@ -43,7 +149,7 @@ func Preceded[I Bytes, OP, O any](prefix Parser[I, OP], parser Parser[I, O]) Par
 
 		result := parser(prefixResult.Remaining)
 		if result.Err != nil {
-			return Failure[I, O](result.Err, input)
+			return Failure[I, O](result.Report(), input)
 		}
 
 		return Success(result.Output, result.Remaining)
@@ -60,23 +166,80 @@ func SeparatedPair[I Bytes, LO, RO any, S Separator, LP Parser[I, LO], SP Parser
 	return func(input I) Result[PairContainer[LO, RO], I] {
 		leftResult := leftParser(input)
 		if leftResult.Err != nil {
-			return Failure[I, PairContainer[LO, RO]](NewError(input, "SeparatedPair"), input)
+			return Failure[I, PairContainer[LO, RO]](sequenceError(leftResult.Err, input, "SeparatedPair"), input)
 		}
 
 		sepResult := separator(leftResult.Remaining)
 		if sepResult.Err != nil {
-			return Failure[I, PairContainer[LO, RO]](NewError(input, "SeparatedPair"), input)
+			return Failure[I, PairContainer[LO, RO]](sequenceError(sepResult.Err, input, "SeparatedPair"), input)
 		}
 
 		rightResult := rightParser(sepResult.Remaining)
 		if rightResult.Err != nil {
-			return Failure[I, PairContainer[LO, RO]](NewError(input, "SeparatedPair"), input)
+			return Failure[I, PairContainer[LO, RO]](sequenceError(rightResult.Err, input, "SeparatedPair"), input)
 		}
 
 		return Success(PairContainer[LO, RO]{leftResult.Output, rightResult.Output}, rightResult.Remaining)
 	}
 }
 
+// KeyValue parses a key/value pair: a key, followed by a separator, and a value,
+// such as "name = gomme" or "port: 8080". It returns a pair container holding
+// the key and the value; the separator is discarded. Unlike SeparatedPair, it
+// accepts separators producing any type of output.
+//
+// KeyValues parses lists of such pairs into maps.
+func KeyValue[I Bytes, K, S, V any](key Parser[I, K], separator Parser[I, S], value Parser[I, V]) Parser[I, PairContainer[K, V]] {
+	return func(input I) Result[PairContainer[K, V], I] {
+		keyResult := key(input)
+		if keyResult.Err != nil {
+			return Failure[I, PairContainer[K, V]](sequenceError(keyResult.Err, input, "KeyValue"), input)
+		}
+
+		sepResult := separator(keyResult.Remaining)
+		if sepResult.Err != nil {
+			return Failure[I, PairContainer[K, V]](sequenceError(sepResult.Err, input, "KeyValue"), input)
+		}
+
+		valueResult := value(sepResult.Remaining)
+		if valueResult.Err != nil {
+			return Failure[I, PairContainer[K, V]](sequenceError(valueResult.Err, input, "KeyValue"), input)
+		}
+
+		return Success(PairContainer[K, V]{keyResult.Output, valueResult.Output}, valueResult.Remaining)
+	}
+}
+
+// SeparatedTriple applies two separated parsers and returns a Result containing a triple
+// container as its output. Unlike SeparatedPair, the result of the separator parser is
+// kept, and exposed as the container's Middle value. This is useful when the separator
+// carries meaning, such as the operator in a binary expression.
+func SeparatedTriple[I Bytes, LO, SO, RO any, LP Parser[I, LO], SP Parser[I, SO], RP Parser[I, RO]](
+	leftParser LP, separator SP, rightParser RP,
+) Parser[I, TripleContainer[LO, SO, RO]] {
+	return func(input I) Result[TripleContainer[LO, SO, RO], I] {
+		leftResult := leftParser(input)
+		if leftResult.Err != nil {
+			return Failure[I, TripleContainer[LO, SO, RO]](sequenceError(leftResult.Err, input, "SeparatedTriple"), input)
+		}
+
+		sepResult := separator(leftResult.Remaining)
+		if sepResult.Err != nil {
+			return Failure[I, TripleContainer[LO, SO, RO]](sequenceError(sepResult.Err, input, "SeparatedTriple"), input)
+		}
+
+		rightResult := rightParser(sepResult.Remaining)
+		if rightResult.Err != nil {
+			return Failure[I, TripleContainer[LO, SO, RO]](sequenceError(rightResult.Err, input, "SeparatedTriple"), input)
+		}
+
+		return Success(
+			TripleContainer[LO, SO, RO]{leftResult.Output, sepResult.Output, rightResult.Output},
+			rightResult.Remaining,
+		)
+	}
+}
+
 // Sequence applies a sequence of parsers and returns either a
 // slice of results or an error if any parser fails.
 func Sequence[I Bytes, O any](parsers ...Parser[I, O]) Parser[I, []O] {
@@ -87,7 +250,7 @@ func Sequence[I Bytes, O any](parsers ...Parser[I, O]) Parser[I, []O] {
 		for _, parser := range parsers {
 			res := parser(remaining)
 			if res.Err != nil {
-				return Failure[I, []O](res.Err, input)
+				return Failure[I, []O](res.Report(), input)
 			}
 
 			outputs = append(outputs, res.Output)
@@ -110,9 +273,183 @@ func Terminated[I Bytes, O, OS any](parser Parser[I, O], suffix Parser[I, OS]) P
 
 		suffixResult := suffix(result.Remaining)
 		if suffixResult.Err != nil {
-			return Failure[I, O](suffixResult.Err, input)
+			return Failure[I, O](suffixResult.Report(), input)
 		}
 
 		return Success(result.Output, suffixResult.Remaining)
 	}
 }
+
+// Triplet applies three parsers and returns a Result containing a triple container
+// holding the resulting values.
+func Triplet[I Bytes, LO, MO, RO any, LP Parser[I, LO], MP Parser[I, MO], RP Parser[I, RO]](
+	leftParser LP, middleParser MP, rightParser RP,
+) Parser[I, TripleContainer[LO, MO, RO]] {
+	return func(input I) Result[TripleContainer[LO, MO, RO], I] {
+		leftResult := leftParser(input)
+		if leftResult.Err != nil {
+			return Failure[I, TripleContainer[LO, MO, RO]](sequenceError(leftResult.Err, input, "Triplet"), input)
+		}
+
+		middleResult := middleParser(leftResult.Remaining)
+		if middleResult.Err != nil {
+			return Failure[I, TripleContainer[LO, MO, RO]](sequenceError(middleResult.Err, input, "Triplet"), input)
+		}
+
+		rightResult := rightParser(middleResult.Remaining)
+		if rightResult.Err != nil {
+			return Failure[I, TripleContainer[LO, MO, RO]](sequenceError(rightResult.Err, input, "Triplet"), input)
+		}
+
+		return Success(
+			TripleContainer[LO, MO, RO]{leftResult.Output, middleResult.Output, rightResult.Output},
+			rightResult.Remaining,
+		)
+	}
+}
+
+// Untyped wraps a parser so that its output is exposed as an `any` value. It
+// is mostly useful to feed parsers with heterogeneous output types to the
+// SequenceBuilder.
+func Untyped[I Bytes, O any](parse Parser[I, O]) Parser[I, any] {
+	return func(input I) Result[any, I] {
+		result := parse(input)
+		if result.Err != nil {
+			return Failure[I, any](result.Err, input)
+		}
+
+		return Success[any](result.Output, result.Remaining)
+	}
+}
+
+// SequenceBuilder allows to fluently describe a sequence of parsers, some of
+// which have their output kept, while others have it discarded. It is meant to
+// be used for sequences longer than a pair, where nesting Preceded, Terminated
+// and Delimited combinators would become hard to read.
+//
+// SequenceBuilder values are produced by Seq, and turned into a parser by Build.
+type SequenceBuilder[I Bytes, O any] struct {
+	steps []sequenceStep[I]
+}
+
+type sequenceStep[I Bytes] struct {
+	parse Parser[I, any]
+	keep  bool
+}
+
+// Seq starts the description of a sequence of parsers whose kept outputs will
+// eventually be combined into a value of type O.
+func Seq[I Bytes, O any]() *SequenceBuilder[I, O] {
+	return &SequenceBuilder[I, O]{}
+}
+
+// Then appends a parser to the sequence, and keeps its output.
+func (b *SequenceBuilder[I, O]) Then(parse Parser[I, any]) *SequenceBuilder[I, O] {
+	b.steps = append(b.steps, sequenceStep[I]{parse: parse, keep: true})
+	return b
+}
+
+// Skip appends a parser to the sequence, and discards its output.
+func (b *SequenceBuilder[I, O]) Skip(parse Parser[I, any]) *SequenceBuilder[I, O] {
+	b.steps = append(b.steps, sequenceStep[I]{parse: parse, keep: false})
+	return b
+}
+
+// Build produces a parser applying the described sequence in order. The outputs
+// of the steps added through Then are passed, in order, to the provided function,
+// whose result becomes the parser's output.
+//
+// If any of the steps fails, or if the provided function returns an error, the
+// produced parser fails and returns an error Result.
+func (b *SequenceBuilder[I, O]) Build(fn func(outputs []any) (O, error)) Parser[I, O] {
+	steps := make([]sequenceStep[I], len(b.steps))
+	copy(steps, b.steps)
+
+	keptCount := 0
+	for _, step := range steps {
+		if step.keep {
+			keptCount++
+		}
+	}
+
+	return func(input I) Result[O, I] {
+		outputs := make([]any, 0, keptCount)
+
+		remaining := input
+		for _, step := range steps {
+			result := step.parse(remaining)
+			if result.Err != nil {
+				return Failure[I, O](result.Report(), input)
+			}
+
+			if step.keep {
+				outputs = append(outputs, result.Output)
+			}
+
+			remaining = result.Remaining
+		}
+
+		output, err := fn(outputs)
+		if err != nil {
+			return Failure[I, O](NewError(input, err.Error()), input)
+		}
+
+		return Success(output, remaining)
+	}
+}
+
+// Column describes a column of a fixed-width record, as parsed by FixedWidthFields.
+// Width is the number of bytes the column spans, and Parse the parser applied to
+// them.
+type Column[I Bytes, O any] struct {
+	Width uint
+	Parse Parser[I, O]
+}
+
+// FixedWidthFields splits the input into consecutive windows whose widths are
+// defined by the provided columns, applies each column's parser to its window,
+// and returns a slice of the results. Columns holding values of different types
+// can be described by wrapping their parsers with Untyped.
+//
+// If the input is too short to hold all the columns, or if any column's parser
+// fails, or does not consume the entirety of its window, FixedWidthFields fails
+// and returns an error Result.
+func FixedWidthFields[I Bytes, O any](columns ...Column[I, O]) Parser[I, []O] {
+	return func(input I) Result[[]O, I] {
+		outputs := make([]O, 0, len(columns))
+
+		remaining := input
+		for _, column := range columns {
+			if uint(len(remaining)) < column.Width {
+				return Failure[I, []O](NewError(input, "FixedWidthFields"), input)
+			}
+
+			result := column.Parse(remaining[:column.Width])
+			if result.Err != nil && result.Err.IsFatal() {
+				return Failure[I, []O](result.Err, input)
+			}
+
+			if result.Err != nil || len(result.Remaining) != 0 {
+				return Failure[I, []O](NewError(input, "FixedWidthFields"), input)
+			}
+
+			outputs = append(outputs, result.Output)
+			remaining = remaining[column.Width:]
+		}
+
+		return Success(outputs, remaining)
+	}
+}
+
+// sequenceError produces the error reported by the named sequence combinator,
+// or by a combinator wrapping a single parser, such as Map, when one of its
+// parsers failed with the provided error: fatal errors are reported as is, so
+// that they keep stopping the parsing, while other ones are reported as the
+// combinator's own.
+func sequenceError[I Bytes](err *Error[I], input I, name string) *Error[I] {
+	if err.IsFatal() {
+		return err
+	}
+
+	return NewError(input, name)
+}
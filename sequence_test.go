@@ -1,6 +1,7 @@
 package gomme
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -102,64 +103,1060 @@ func BenchmarkDelimited(b *testing.B) {
 	}
 }
 
+func TestInto(t *testing.T) {
+	t.Parallel()
+
+	type point struct {
+		X string
+		Y string
+	}
+
+	parser := Into(
+		SkipField[string, point](Char[string]('(')),
+		Field(Digit1[string](), func(p *point, x string) { p.X = x }),
+		SkipField[string, point](Char[string](',')),
+		Field(Digit1[string](), func(p *point, y string) { p.Y = y }),
+		SkipField[string, point](Char[string](')')),
+	)
+
+	testCases := []struct {
+		name          string
+		input         string
+		wantErr       bool
+		wantOutput    point
+		wantRemaining string
+	}{
+		{
+			name:          "matching fields should succeed",
+			input:         "(12,34)abc",
+			wantErr:       false,
+			wantOutput:    point{X: "12", Y: "34"},
+			wantRemaining: "abc",
+		},
+		{
+			name:          "failing field should fail",
+			input:         "(12;34)",
+			wantErr:       true,
+			wantOutput:    point{},
+			wantRemaining: "(12;34)",
+		},
+		{
+			name:          "empty input should fail",
+			input:         "",
+			wantErr:       true,
+			wantOutput:    point{},
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkInto(b *testing.B) {
+	type pair struct {
+		Left  string
+		Right string
+	}
+
+	parser := Into(
+		Field(Digit1[string](), func(p *pair, v string) { p.Left = v }),
+		SkipField[string, pair](Char[string]('|')),
+		Field(Digit1[string](), func(p *pair, v string) { p.Right = v }),
+	)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("12|34")
+	}
+}
+
+func TestLexeme(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a lexeme followed by whitespace should succeed",
+			parser:        Lexeme(Alpha1[string]()),
+			input:         "abc \t\n123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing a lexeme without trailing whitespace should succeed",
+			parser:        Lexeme(Alpha1[string]()),
+			input:         "abc123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing a lexeme preceded by whitespace should fail",
+			parser:        Lexeme(Alpha1[string]()),
+			input:         " abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: " abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Lexeme(Alpha1[string]()),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a lexeme with HorizontalWhitespace should leave line endings",
+			parser:        Lexeme(Alpha1[string](), HorizontalWhitespace),
+			input:         "abc \t\n123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "\n123",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkLexeme(b *testing.B) {
+	parser := Lexeme(Alpha1[string]())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("abc \t\n123")
+	}
+}
+
+func TestLexemeWith(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a lexeme followed by space should succeed",
+			parser:        LexemeWith(Many0(Alternative(Whitespace1[string](), Recognize(Pair(Char[string]('#'), NotLineEnding0[string]())))), Alpha1[string]()),
+			input:         "abc # comment\n 123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing a lexeme without trailing space should succeed",
+			parser:        LexemeWith(Space[string](), Alpha1[string]()),
+			input:         "abc 123",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "123",
+		},
+		{
+			name:          "parsing a lexeme whose space fails should fail",
+			parser:        LexemeWith(Space[string](), Alpha1[string]()),
+			input:         "abc123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "abc123",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        LexemeWith(Space[string](), Alpha1[string]()),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkLexemeWith(b *testing.B) {
+	parser := LexemeWith(Space[string](), Alpha1[string]())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("abc 123")
+	}
+}
+
+func TestPadded(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a padded value should succeed",
+			parser:        Padded(Digit1[string]()),
+			input:         " \t123\n,",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing an unpadded value should succeed",
+			parser:        Padded(Digit1[string]()),
+			input:         "123,",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing a padded invalid value should fail",
+			parser:        Padded(Digit1[string]()),
+			input:         "  abc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "  abc",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        Padded(Digit1[string]()),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a padded value with HorizontalWhitespace should leave line endings",
+			parser:        Padded(Alpha1[string](), HorizontalWhitespace),
+			input:         "\t abc \n",
+			wantErr:       false,
+			wantOutput:    "abc",
+			wantRemaining: "\n",
+		},
+		{
+			name:          "parsing a value preceded by a line ending with HorizontalWhitespace should fail",
+			parser:        Padded(Alpha1[string](), HorizontalWhitespace),
+			input:         "\nabc",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\nabc",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkPadded(b *testing.B) {
+	parser := Padded(Digit1[string]())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser(" \t123\n,")
+	}
+}
+
+func TestPaddedWith(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, string]
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a value padded with space should succeed",
+			parser:        PaddedWith(Many0(Space[string]()), Digit1[string]()),
+			input:         "  123 ,",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: ",",
+		},
+		{
+			name:          "parsing a value padded with other whitespace should stop",
+			parser:        PaddedWith(Many0(Space[string]()), Digit1[string]()),
+			input:         " 123\t,",
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "\t,",
+		},
+		{
+			name:          "parsing a value padded with unexpected whitespace should fail",
+			parser:        PaddedWith(Many0(Space[string]()), Digit1[string]()),
+			input:         "\t123",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "\t123",
+		},
+		{
+			name:          "parsing empty input should fail",
+			parser:        PaddedWith(Many0(Space[string]()), Digit1[string]()),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkPaddedWith(b *testing.B) {
+	parser := PaddedWith(Many0(Space[string]()), Digit1[string]())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("  123 ,")
+	}
+}
+
 func TestPair(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		leftParser  Parser[string, string]
-		rightParser Parser[string, string]
+		leftParser  Parser[string, string]
+		rightParser Parser[string, string]
+	}
+	testCases := []struct {
+		name          string
+		args          args
+		input         string
+		wantErr       bool
+		wantOutput    PairContainer[string, string]
+		wantRemaining string
+	}{
+		{
+			name:  "matching parsers should succeed",
+			input: "1abc\r\n",
+			args: args{
+				leftParser:  Digit1[string](),
+				rightParser: TakeUntil(CRLF[string]()),
+			},
+			wantErr:       false,
+			wantOutput:    PairContainer[string, string]{"1", "abc"},
+			wantRemaining: "\r\n",
+		},
+		{
+			name:  "matching left parser, failing right parser, should fail",
+			input: "1abc",
+			args: args{
+				leftParser:  Digit1[string](),
+				rightParser: TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "1abc",
+		},
+		{
+			name:  "failing left parser, matching right parser, should fail",
+			input: "adef",
+			args: args{
+				leftParser:  Digit1[string](),
+				rightParser: TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "adef",
+		},
+		{
+			name:  "failing left parser, failing right parser, should fail",
+			input: "123",
+			args: args{
+				leftParser:  Digit1[string](),
+				rightParser: TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "123",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			parser := Pair(tc.args.leftParser, tc.args.rightParser)
+
+			gotResult := parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkPair(b *testing.B) {
+	parser := Pair(Digit1[string](), TakeUntil(CRLF[string]()))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1abc\r\n")
+	}
+}
+
+func TestPairIn(t *testing.T) {
+	t.Parallel()
+
+	arena := NewArena[PairContainer[string, rune]](4)
+	parser := PairIn(arena, Digit1[string](), Char[string]('a'))
+
+	first := parser("12abc")
+	assert.Nil(t, first.Err)
+	assert.Equal(t, &PairContainer[string, rune]{"12", 'a'}, first.Output)
+	assert.Equal(t, "bc", first.Remaining)
+
+	second := parser("34a")
+	assert.Nil(t, second.Err)
+	assert.Equal(t, &PairContainer[string, rune]{"34", 'a'}, second.Output)
+	assert.Equal(t, &PairContainer[string, rune]{"12", 'a'}, first.Output)
+
+	failed := parser("12b")
+	assert.NotNil(t, failed.Err)
+	assert.Nil(t, failed.Output)
+	assert.Equal(t, "12b", failed.Remaining)
+
+	// Outputs are released along with the arena they were allocated from.
+	arena.Reset()
+	assert.Equal(t, &PairContainer[string, rune]{}, first.Output)
+}
+
+func BenchmarkPairIn(b *testing.B) {
+	arena := NewArena[PairContainer[string, string]](1024)
+	parser := PairIn(arena, Digit1[string](), TakeUntil(CRLF[string]()))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if i%1024 == 0 {
+			arena.Reset()
+		}
+
+		parser("1abc\r\n")
+	}
+}
+
+func TestSequencesPropagateFatalErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		parse func(input string) *Error[string]
+		input string
+	}{
+		{"Pair left", func(input string) *Error[string] {
+			return Pair(Int8[string](), Char[string](','))(input).Err
+		}, "300,"},
+		{"Pair right", func(input string) *Error[string] {
+			return Pair(Char[string](','), Int8[string]())(input).Err
+		}, ",300"},
+		{"SeparatedPair", func(input string) *Error[string] {
+			return SeparatedPair(Int8[string](), Char[string](','), Int8[string]())(input).Err
+		}, "1,300"},
+		{"SeparatedTriple", func(input string) *Error[string] {
+			return SeparatedTriple(Int8[string](), Int8[string](), Int8[string]())(input).Err
+		}, "1300"},
+		{"Triplet", func(input string) *Error[string] {
+			return Triplet(Char[string]('a'), Char[string]('b'), Int8[string]())(input).Err
+		}, "ab300"},
+		{"KeyValue", func(input string) *Error[string] {
+			return KeyValue(Alpha1[string](), Char[string]('='), Int8[string]())(input).Err
+		}, "a=300"},
+		{"FixedWidthFields", func(input string) *Error[string] {
+			return FixedWidthFields(
+				Column[string, int8]{Width: 1, Parse: Int8[string]()},
+				Column[string, int8]{Width: 3, Parse: Int8[string]()},
+			)(input).Err
+		}, "1300"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.parse(tc.input)
+
+			var rangeErr *RangeError
+			assert.True(t, err.IsFatal(), "got error %v, want a fatal error", err)
+			assert.True(t, errors.As(err, &rangeErr), "got error %v, want a range error", err)
+		})
+	}
+}
+
+func TestPreceded(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		p Parser[string, string]
+	}
+	testCases := []struct {
+		name          string
+		args          args
+		input         string
+		wantErr       bool
+		wantOutput    string
+		wantRemaining string
+	}{
+		{
+			name:  "matching parser should succeed",
+			input: "+123",
+			args: args{
+				p: Preceded(Char[string]('+'), Digit1[string]()),
+			},
+			wantErr:       false,
+			wantOutput:    "123",
+			wantRemaining: "",
+		},
+		{
+			name:  "no prefix match should fail",
+			input: "+123",
+			args: args{
+				p: Preceded(Char[string]('-'), Digit1[string]()),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "+123",
+		},
+		{
+			name:  "no parser match should succeed",
+			input: "+",
+			args: args{
+				p: Preceded(Char[string]('+'), Digit1[string]()),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "+",
+		},
+		{
+			name:  "empty input should fail",
+			input: "",
+			args: args{
+				p: Preceded(Char[string]('+'), Digit1[string]()),
+			},
+			wantErr:       true,
+			wantOutput:    "",
+			wantRemaining: "",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.args.p(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkPreceded(b *testing.B) {
+	parser := Preceded(Char[string]('+'), Digit1[string]())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("+123")
+	}
+}
+
+func TestSeparatedPair(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		leftParser      Parser[string, string]
+		separatorParser Parser[string, rune]
+		rightParser     Parser[string, string]
+	}
+	testCases := []struct {
+		name          string
+		args          args
+		input         string
+		wantErr       bool
+		wantOutput    PairContainer[string, string]
+		wantRemaining string
+	}{
+		// { true, true, true }
+		{
+			name:  "matching parsers should succeed",
+			input: "1|abc\r\n",
+			args: args{
+				leftParser:      Digit1[string](),
+				separatorParser: Char[string]('|'),
+				rightParser:     TakeUntil(CRLF[string]()),
+			},
+			wantErr:       false,
+			wantOutput:    PairContainer[string, string]{"1", "abc"},
+			wantRemaining: "\r\n",
+		},
+		// { true, true, false }
+		{
+			name:  "matching left parser, matching separator, failing right parser, should fail",
+			input: "1|abc",
+			args: args{
+				leftParser:      Digit1[string](),
+				separatorParser: Char[string]('|'),
+				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "1|abc",
+		},
+		// { true, false, true }
+		{
+			name:  "matching left parser, failing separator, matching right parser, should fail",
+			input: "1^abc",
+			args: args{
+				leftParser:      Digit1[string](),
+				separatorParser: Char[string]('|'),
+				rightParser:     TakeWhileOneOf[string]('a', 'b', 'c'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "1^abc",
+		},
+		// { true, false, false }
+		{
+			name:  "matching left parser, failing separator, failing right parser, should fail",
+			input: "1^abc",
+			args: args{
+				leftParser:      Digit1[string](),
+				separatorParser: Char[string]('|'),
+				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "1^abc",
+		},
+		// { false, true, true }
+		{
+			name:  "failing left parser, matching separator, matching right parser, should fail",
+			input: "a|def",
+			args: args{
+				leftParser:      Digit1[string](),
+				separatorParser: Char[string]('|'),
+				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "a|def",
+		},
+		// { false, true, false }
+		{
+			name:  "failing left parser, matching separator, failing right parser, should fail",
+			input: "a|123",
+			args: args{
+				leftParser:      Digit1[string](),
+				separatorParser: Char[string]('|'),
+				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "a|123",
+		},
+		// { false, false, true }
+		{
+			name:  "failing left parser, failing separator, matching right parser, should fail",
+			input: "a^def",
+			args: args{
+				leftParser:      Digit1[string](),
+				separatorParser: Char[string]('|'),
+				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "a^def",
+		},
+		// { false, false, false }
+		{
+			name:  "failing left parser, failing separator, failing right parser, should fail",
+			input: "a^123",
+			args: args{
+				leftParser:      Digit1[string](),
+				separatorParser: Char[string]('|'),
+				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
+			},
+			wantErr:       true,
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "a^123",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			parser := SeparatedPair(tc.args.leftParser, tc.args.separatorParser, tc.args.rightParser)
+
+			gotResult := parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSeparatedPair(b *testing.B) {
+	parser := SeparatedPair(Digit1[string](), Char[string]('|'), TakeUntil(CRLF[string]()))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1|abc\r\n")
+	}
+}
+
+func TestSeparatedTriple(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, TripleContainer[string, rune, string]]
+		input         string
+		wantErr       bool
+		wantOutput    TripleContainer[string, rune, string]
+		wantRemaining string
+	}{
+		{
+			name:          "matching parsers should succeed and keep the separator",
+			parser:        SeparatedTriple(Digit1[string](), OneOf[string]('+', '-'), Digit1[string]()),
+			input:         "1+2\r\n",
+			wantErr:       false,
+			wantOutput:    TripleContainer[string, rune, string]{"1", '+', "2"},
+			wantRemaining: "\r\n",
+		},
+		{
+			name:          "failing left parser should fail",
+			parser:        SeparatedTriple(Digit1[string](), OneOf[string]('+', '-'), Digit1[string]()),
+			input:         "a-2",
+			wantErr:       true,
+			wantOutput:    TripleContainer[string, rune, string]{},
+			wantRemaining: "a-2",
+		},
+		{
+			name:          "failing separator should fail",
+			parser:        SeparatedTriple(Digit1[string](), OneOf[string]('+', '-'), Digit1[string]()),
+			input:         "1*2",
+			wantErr:       true,
+			wantOutput:    TripleContainer[string, rune, string]{},
+			wantRemaining: "1*2",
+		},
+		{
+			name:          "failing right parser should fail",
+			parser:        SeparatedTriple(Digit1[string](), OneOf[string]('+', '-'), Digit1[string]()),
+			input:         "1-b",
+			wantErr:       true,
+			wantOutput:    TripleContainer[string, rune, string]{},
+			wantRemaining: "1-b",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
+
+			if gotResult.Remaining != tc.wantRemaining {
+				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
+			}
+		})
+	}
+}
+
+func BenchmarkSeparatedTriple(b *testing.B) {
+	parser := SeparatedTriple(Digit1[string](), OneOf[string]('+', '-'), Digit1[string]())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("1+2\r\n")
+	}
+}
+
+func TestKeyValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		parser        Parser[string, PairContainer[string, int64]]
+		input         string
+		wantErr       bool
+		wantFatal     bool
+		wantOutput    PairContainer[string, int64]
+		wantRemaining string
+	}{
+		{
+			name:          "parsing a key/value pair should succeed",
+			parser:        KeyValue(Alpha1[string](), Padded(Char[string]('=')), Int64[string]()),
+			input:         "port = 8080\n",
+			wantErr:       false,
+			wantOutput:    PairContainer[string, int64]{"port", 8080},
+			wantRemaining: "\n",
+		},
+		{
+			name:          "parsing a pair with a separator of any type should succeed",
+			parser:        KeyValue(Alpha1[string](), Whitespace1[string](), Int64[string]()),
+			input:         "port 8080",
+			wantErr:       false,
+			wantOutput:    PairContainer[string, int64]{"port", 8080},
+			wantRemaining: "",
+		},
+		{
+			name:          "parsing a pair missing its separator should fail",
+			parser:        KeyValue(Alpha1[string](), Char[string]('='), Int64[string]()),
+			input:         "port 8080",
+			wantErr:       true,
+			wantOutput:    PairContainer[string, int64]{},
+			wantRemaining: "port 8080",
+		},
+		{
+			name:          "parsing a pair missing its value should fail",
+			parser:        KeyValue(Alpha1[string](), Char[string]('='), Int64[string]()),
+			input:         "port=",
+			wantErr:       true,
+			wantOutput:    PairContainer[string, int64]{},
+			wantRemaining: "port=",
+		},
+		{
+			name:          "parsing a pair whose value overflows should fail with a fatal error",
+			parser:        KeyValue(Alpha1[string](), Char[string]('='), Int64[string]()),
+			input:         "port=99999999999999999999",
+			wantErr:       true,
+			wantFatal:     true,
+			wantOutput:    PairContainer[string, int64]{},
+			wantRemaining: "port=99999999999999999999",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResult := tc.parser(tc.input)
+			if (gotResult.Err != nil) != tc.wantErr {
+				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
+			}
+
+			if gotResult.Err != nil && gotResult.Err.IsFatal() != tc.wantFatal {
+				t.Errorf("got fatal %v, want fatal %v", gotResult.Err.IsFatal(), tc.wantFatal)
+			}
+
+			assert.Equal(t, tc.wantOutput, gotResult.Output)
+			assert.Equal(t, tc.wantRemaining, gotResult.Remaining)
+		})
+	}
+}
+
+func BenchmarkKeyValue(b *testing.B) {
+	parser := KeyValue(Alpha1[string](), Padded(Char[string]('=')), Int64[string]())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		parser("port = 8080")
+	}
+}
+
+func TestSequence(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		p Parser[string, []string]
 	}
 	testCases := []struct {
 		name          string
 		args          args
 		input         string
 		wantErr       bool
-		wantOutput    PairContainer[string, string]
+		wantOutput    []string
 		wantRemaining string
 	}{
 		{
 			name:  "matching parsers should succeed",
-			input: "1abc\r\n",
+			input: "1a3",
 			args: args{
-				leftParser:  Digit1[string](),
-				rightParser: TakeUntil(CRLF[string]()),
+				p: Sequence(Digit1[string](), Alpha0[string](), Digit1[string]()),
 			},
 			wantErr:       false,
-			wantOutput:    PairContainer[string, string]{"1", "abc"},
-			wantRemaining: "\r\n",
+			wantOutput:    []string{"1", "a", "3"},
+			wantRemaining: "",
 		},
 		{
-			name:  "matching left parser, failing right parser, should fail",
-			input: "1abc",
+			name:  "matching parsers in longer input should succeed",
+			input: "1a3bcd",
 			args: args{
-				leftParser:  Digit1[string](),
-				rightParser: TakeWhileOneOf[string]('d', 'e', 'f'),
+				p: Sequence(Digit1[string](), Alpha0[string](), Digit1[string]()),
+			},
+			wantErr:       false,
+			wantOutput:    []string{"1", "a", "3"},
+			wantRemaining: "bcd",
+		},
+		{
+			name:  "partially matching parsers should fail",
+			input: "1a3",
+			args: args{
+				p: Sequence(Digit1[string](), Digit1[string](), Digit1[string]()),
 			},
 			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "1abc",
+			wantOutput:    nil,
+			wantRemaining: "1a3",
 		},
 		{
-			name:  "failing left parser, matching right parser, should fail",
-			input: "adef",
+			name:  "too short input should fail",
+			input: "12",
 			args: args{
-				leftParser:  Digit1[string](),
-				rightParser: TakeWhileOneOf[string]('d', 'e', 'f'),
+				p: Sequence(Digit1[string](), Digit1[string](), Digit1[string]()),
 			},
 			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "adef",
+			wantOutput:    nil,
+			wantRemaining: "12",
 		},
 		{
-			name:  "failing left parser, failing right parser, should fail",
-			input: "123",
+			name:  "empty input should succeed",
+			input: "",
 			args: args{
-				leftParser:  Digit1[string](),
-				rightParser: TakeWhileOneOf[string]('d', 'e', 'f'),
+				p: Sequence(Digit1[string](), Digit1[string](), Digit1[string]()),
 			},
 			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "123",
+			wantOutput:    nil,
+			wantRemaining: "",
 		},
 	}
 	for _, tc := range testCases {
@@ -168,16 +1165,16 @@ func TestPair(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			parser := Pair(tc.args.leftParser, tc.args.rightParser)
-
-			gotResult := parser(tc.input)
+			gotResult := tc.args.p(tc.input)
 			if (gotResult.Err != nil) != tc.wantErr {
 				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
 			}
 
-			if gotResult.Output != tc.wantOutput {
-				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
-			}
+			// testify makes it easier comparing slices
+			assert.Equal(t,
+				tc.wantOutput, gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
 
 			if gotResult.Remaining != tc.wantRemaining {
 				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
@@ -186,16 +1183,16 @@ func TestPair(t *testing.T) {
 	}
 }
 
-func BenchmarkPair(b *testing.B) {
-	parser := Pair(Digit1[string](), TakeUntil(CRLF[string]()))
+func BenchmarkSequence(b *testing.B) {
+	parser := Sequence(Digit1[string](), Alpha0[string](), Digit1[string]())
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("1abc\r\n")
+		parser("123")
 	}
 }
 
-func TestPreceded(t *testing.T) {
+func TestTerminated(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
@@ -211,29 +1208,29 @@ func TestPreceded(t *testing.T) {
 	}{
 		{
 			name:  "matching parser should succeed",
-			input: "+123",
+			input: "1+23",
 			args: args{
-				p: Preceded(Char[string]('+'), Digit1[string]()),
+				p: Terminated(Digit1[string](), Char[string]('+')),
 			},
 			wantErr:       false,
-			wantOutput:    "123",
-			wantRemaining: "",
+			wantOutput:    "1",
+			wantRemaining: "23",
 		},
 		{
-			name:  "no prefix match should fail",
-			input: "+123",
+			name:  "no suffix match should fail",
+			input: "1-23",
 			args: args{
-				p: Preceded(Char[string]('-'), Digit1[string]()),
+				p: Terminated(Digit1[string](), Char[string]('+')),
 			},
 			wantErr:       true,
 			wantOutput:    "",
-			wantRemaining: "+123",
+			wantRemaining: "1-23",
 		},
 		{
 			name:  "no parser match should succeed",
 			input: "+",
 			args: args{
-				p: Preceded(Char[string]('+'), Digit1[string]()),
+				p: Terminated(Digit1[string](), Char[string]('+')),
 			},
 			wantErr:       true,
 			wantOutput:    "",
@@ -243,7 +1240,7 @@ func TestPreceded(t *testing.T) {
 			name:  "empty input should fail",
 			input: "",
 			args: args{
-				p: Preceded(Char[string]('+'), Digit1[string]()),
+				p: Terminated(Digit1[string](), Char[string]('+')),
 			},
 			wantErr:       true,
 			wantOutput:    "",
@@ -272,134 +1269,65 @@ func TestPreceded(t *testing.T) {
 	}
 }
 
-func BenchmarkPreceded(b *testing.B) {
-	parser := Preceded(Char[string]('+'), Digit1[string]())
+func BenchmarkTerminated(b *testing.B) {
+	parser := Terminated(Digit1[string](), Char[string]('+'))
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("+123")
+		parser("123+")
 	}
 }
 
-func TestSeparatedPair(t *testing.T) {
+func TestTriplet(t *testing.T) {
 	t.Parallel()
 
-	type args struct {
-		leftParser      Parser[string, string]
-		separatorParser Parser[string, rune]
-		rightParser     Parser[string, string]
-	}
 	testCases := []struct {
 		name          string
-		args          args
+		parser        Parser[string, TripleContainer[string, rune, string]]
 		input         string
 		wantErr       bool
-		wantOutput    PairContainer[string, string]
+		wantOutput    TripleContainer[string, rune, string]
 		wantRemaining string
 	}{
-		// { true, true, true }
 		{
-			name:  "matching parsers should succeed",
-			input: "1|abc\r\n",
-			args: args{
-				leftParser:      Digit1[string](),
-				separatorParser: Char[string]('|'),
-				rightParser:     TakeUntil(CRLF[string]()),
-			},
+			name:          "matching parsers should succeed",
+			parser:        Triplet(Digit1[string](), Char[string]('e'), Digit1[string]()),
+			input:         "12e34abc",
 			wantErr:       false,
-			wantOutput:    PairContainer[string, string]{"1", "abc"},
-			wantRemaining: "\r\n",
-		},
-		// { true, true, false }
-		{
-			name:  "matching left parser, matching separator, failing right parser, should fail",
-			input: "1|abc",
-			args: args{
-				leftParser:      Digit1[string](),
-				separatorParser: Char[string]('|'),
-				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
-			},
-			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "1|abc",
-		},
-		// { true, false, true }
-		{
-			name:  "matching left parser, failing separator, matching right parser, should fail",
-			input: "1^abc",
-			args: args{
-				leftParser:      Digit1[string](),
-				separatorParser: Char[string]('|'),
-				rightParser:     TakeWhileOneOf[string]('a', 'b', 'c'),
-			},
-			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "1^abc",
-		},
-		// { true, false, false }
-		{
-			name:  "matching left parser, failing separator, failing right parser, should fail",
-			input: "1^abc",
-			args: args{
-				leftParser:      Digit1[string](),
-				separatorParser: Char[string]('|'),
-				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
-			},
-			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "1^abc",
+			wantOutput:    TripleContainer[string, rune, string]{"12", 'e', "34"},
+			wantRemaining: "abc",
 		},
-		// { false, true, true }
 		{
-			name:  "failing left parser, matching separator, matching right parser, should fail",
-			input: "a|def",
-			args: args{
-				leftParser:      Digit1[string](),
-				separatorParser: Char[string]('|'),
-				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
-			},
+			name:          "failing left parser should fail",
+			parser:        Triplet(Digit1[string](), Char[string]('e'), Digit1[string]()),
+			input:         "ae34",
 			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "a|def",
+			wantOutput:    TripleContainer[string, rune, string]{},
+			wantRemaining: "ae34",
 		},
-		// { false, true, false }
 		{
-			name:  "failing left parser, matching separator, failing right parser, should fail",
-			input: "a|123",
-			args: args{
-				leftParser:      Digit1[string](),
-				separatorParser: Char[string]('|'),
-				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
-			},
+			name:          "failing middle parser should fail",
+			parser:        Triplet(Digit1[string](), Char[string]('e'), Digit1[string]()),
+			input:         "12f34",
 			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "a|123",
+			wantOutput:    TripleContainer[string, rune, string]{},
+			wantRemaining: "12f34",
 		},
-		// { false, false, true }
 		{
-			name:  "failing left parser, failing separator, matching right parser, should fail",
-			input: "a^def",
-			args: args{
-				leftParser:      Digit1[string](),
-				separatorParser: Char[string]('|'),
-				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
-			},
+			name:          "failing right parser should fail",
+			parser:        Triplet(Digit1[string](), Char[string]('e'), Digit1[string]()),
+			input:         "12eabc",
 			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "a^def",
+			wantOutput:    TripleContainer[string, rune, string]{},
+			wantRemaining: "12eabc",
 		},
-		// { false, false, false }
 		{
-			name:  "failing left parser, failing separator, failing right parser, should fail",
-			input: "a^123",
-			args: args{
-				leftParser:      Digit1[string](),
-				separatorParser: Char[string]('|'),
-				rightParser:     TakeWhileOneOf[string]('d', 'e', 'f'),
-			},
+			name:          "empty input should fail",
+			parser:        Triplet(Digit1[string](), Char[string]('e'), Digit1[string]()),
+			input:         "",
 			wantErr:       true,
-			wantOutput:    PairContainer[string, string]{},
-			wantRemaining: "a^123",
+			wantOutput:    TripleContainer[string, rune, string]{},
+			wantRemaining: "",
 		},
 	}
 	for _, tc := range testCases {
@@ -408,9 +1336,7 @@ func TestSeparatedPair(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			parser := SeparatedPair(tc.args.leftParser, tc.args.separatorParser, tc.args.rightParser)
-
-			gotResult := parser(tc.input)
+			gotResult := tc.parser(tc.input)
 			if (gotResult.Err != nil) != tc.wantErr {
 				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
 			}
@@ -426,77 +1352,61 @@ func TestSeparatedPair(t *testing.T) {
 	}
 }
 
-func BenchmarkSeparatedPair(b *testing.B) {
-	parser := SeparatedPair(Digit1[string](), Char[string]('|'), TakeUntil(CRLF[string]()))
+func BenchmarkTriplet(b *testing.B) {
+	parser := Triplet(Digit1[string](), Char[string]('e'), Digit1[string]())
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("1|abc\r\n")
+		parser("12e34")
 	}
 }
 
-func TestSequence(t *testing.T) {
+func TestSeq(t *testing.T) {
 	t.Parallel()
 
-	type args struct {
-		p Parser[string, []string]
-	}
+	parser := Seq[string, PairContainer[string, string]]().
+		Skip(Untyped(Char[string]('('))).
+		Then(Untyped(Digit1[string]())).
+		Skip(Untyped(Whitespace0[string]())).
+		Then(Untyped(Alpha1[string]())).
+		Skip(Untyped(Char[string](')'))).
+		Build(func(outputs []any) (PairContainer[string, string], error) {
+			return PairContainer[string, string]{outputs[0].(string), outputs[1].(string)}, nil
+		})
+
 	testCases := []struct {
 		name          string
-		args          args
 		input         string
 		wantErr       bool
-		wantOutput    []string
+		wantOutput    PairContainer[string, string]
 		wantRemaining string
 	}{
 		{
-			name:  "matching parsers should succeed",
-			input: "1a3",
-			args: args{
-				p: Sequence(Digit1[string](), Alpha0[string](), Digit1[string]()),
-			},
-			wantErr:       false,
-			wantOutput:    []string{"1", "a", "3"},
-			wantRemaining: "",
-		},
-		{
-			name:  "matching parsers in longer input should succeed",
-			input: "1a3bcd",
-			args: args{
-				p: Sequence(Digit1[string](), Alpha0[string](), Digit1[string]()),
-			},
+			name:          "matching sequence should succeed",
+			input:         "(12 abc)def",
 			wantErr:       false,
-			wantOutput:    []string{"1", "a", "3"},
-			wantRemaining: "bcd",
+			wantOutput:    PairContainer[string, string]{"12", "abc"},
+			wantRemaining: "def",
 		},
 		{
-			name:  "partially matching parsers should fail",
-			input: "1a3",
-			args: args{
-				p: Sequence(Digit1[string](), Digit1[string](), Digit1[string]()),
-			},
+			name:          "failing kept step should fail",
+			input:         "(abc abc)",
 			wantErr:       true,
-			wantOutput:    nil,
-			wantRemaining: "1a3",
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "(abc abc)",
 		},
 		{
-			name:  "too short input should fail",
-			input: "12",
-			args: args{
-				p: Sequence(Digit1[string](), Digit1[string](), Digit1[string]()),
-			},
+			name:          "failing skipped step should fail",
+			input:         "(12 abc]",
 			wantErr:       true,
-			wantOutput:    nil,
-			wantRemaining: "12",
+			wantOutput:    PairContainer[string, string]{},
+			wantRemaining: "(12 abc]",
 		},
 		{
-			name:  "empty input should succeed",
-			input: "",
-			args: args{
-				p: Sequence(Digit1[string](), Digit1[string](), Digit1[string]()),
-			},
+			name:          "empty input should fail",
+			input:         "",
 			wantErr:       true,
-			wantOutput:    nil,
+			wantOutput:    PairContainer[string, string]{},
 			wantRemaining: "",
 		},
 	}
@@ -506,16 +1416,14 @@ func TestSequence(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			gotResult := tc.args.p(tc.input)
+			gotResult := parser(tc.input)
 			if (gotResult.Err != nil) != tc.wantErr {
 				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
 			}
 
-			// testify makes it easier comparing slices
-			assert.Equal(t,
-				tc.wantOutput, gotResult.Output,
-				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
-			)
+			if gotResult.Output != tc.wantOutput {
+				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
+			}
 
 			if gotResult.Remaining != tc.wantRemaining {
 				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
@@ -524,67 +1432,77 @@ func TestSequence(t *testing.T) {
 	}
 }
 
-func BenchmarkSequence(b *testing.B) {
-	parser := Sequence(Digit1[string](), Alpha0[string](), Digit1[string]())
+func BenchmarkSeq(b *testing.B) {
+	parser := Seq[string, []any]().
+		Then(Untyped(Digit1[string]())).
+		Skip(Untyped(Char[string]('|'))).
+		Then(Untyped(Digit1[string]())).
+		Build(func(outputs []any) ([]any, error) { return outputs, nil })
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("123")
+		parser("12|34")
 	}
 }
 
-func TestTerminated(t *testing.T) {
+func TestFixedWidthFields(t *testing.T) {
 	t.Parallel()
 
-	type args struct {
-		p Parser[string, string]
+	padded := func(parse Parser[string, string]) Parser[string, string] {
+		return Delimited(Whitespace0[string](), parse, Whitespace0[string]())
+	}
+	columns := []Column[string, string]{
+		{Width: 6, Parse: padded(Alpha1[string]())},
+		{Width: 4, Parse: padded(Digit1[string]())},
+		{Width: 3, Parse: Alpha1[string]()},
 	}
+
 	testCases := []struct {
 		name          string
-		args          args
+		parser        Parser[string, []string]
 		input         string
 		wantErr       bool
-		wantOutput    string
+		wantOutput    []string
 		wantRemaining string
 	}{
 		{
-			name:  "matching parser should succeed",
-			input: "1+23",
-			args: args{
-				p: Terminated(Digit1[string](), Char[string]('+')),
-			},
+			name:          "matching columns should succeed",
+			parser:        FixedWidthFields(columns...),
+			input:         "ACME    42EUR\n",
 			wantErr:       false,
-			wantOutput:    "1",
-			wantRemaining: "23",
+			wantOutput:    []string{"ACME", "42", "EUR"},
+			wantRemaining: "\n",
 		},
 		{
-			name:  "no suffix match should fail",
-			input: "1-23",
-			args: args{
-				p: Terminated(Digit1[string](), Char[string]('+')),
-			},
+			name:          "failing column parser should fail",
+			parser:        FixedWidthFields(columns...),
+			input:         "ACME    ABEUR\n",
 			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "1-23",
+			wantOutput:    nil,
+			wantRemaining: "ACME    ABEUR\n",
 		},
 		{
-			name:  "no parser match should succeed",
-			input: "+",
-			args: args{
-				p: Terminated(Digit1[string](), Char[string]('+')),
-			},
+			name:          "partially consumed column should fail",
+			parser:        FixedWidthFields(columns...),
+			input:         "AC ME   42EUR\n",
 			wantErr:       true,
-			wantOutput:    "",
-			wantRemaining: "+",
+			wantOutput:    nil,
+			wantRemaining: "AC ME   42EUR\n",
 		},
 		{
-			name:  "empty input should fail",
-			input: "",
-			args: args{
-				p: Terminated(Digit1[string](), Char[string]('+')),
-			},
+			name:          "too short input should fail",
+			parser:        FixedWidthFields(columns...),
+			input:         "ACME    42EU",
 			wantErr:       true,
-			wantOutput:    "",
+			wantOutput:    nil,
+			wantRemaining: "ACME    42EU",
+		},
+		{
+			name:          "empty input should fail",
+			parser:        FixedWidthFields(columns...),
+			input:         "",
+			wantErr:       true,
+			wantOutput:    nil,
 			wantRemaining: "",
 		},
 	}
@@ -594,14 +1512,16 @@ func TestTerminated(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			gotResult := tc.args.p(tc.input)
+			gotResult := tc.parser(tc.input)
 			if (gotResult.Err != nil) != tc.wantErr {
 				t.Errorf("got error %v, want error %v", gotResult.Err, tc.wantErr)
 			}
 
-			if gotResult.Output != tc.wantOutput {
-				t.Errorf("got output %v, want output %v", gotResult.Output, tc.wantOutput)
-			}
+			assert.Equal(t,
+				tc.wantOutput,
+				gotResult.Output,
+				"got output %v, want output %v", gotResult.Output, tc.wantOutput,
+			)
 
 			if gotResult.Remaining != tc.wantRemaining {
 				t.Errorf("got remaining %v, want remaining %v", gotResult.Remaining, tc.wantRemaining)
@@ -610,11 +1530,14 @@ func TestTerminated(t *testing.T) {
 	}
 }
 
-func BenchmarkTerminated(b *testing.B) {
-	parser := Terminated(Digit1[string](), Char[string]('+'))
+func BenchmarkFixedWidthFields(b *testing.B) {
+	parser := FixedWidthFields(
+		Column[string, string]{Width: 4, Parse: Alpha1[string]()},
+		Column[string, string]{Width: 2, Parse: Digit1[string]()},
+	)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		parser("123+")
+		parser("ACME42")
 	}
 }
@@ -0,0 +1,61 @@
+package gomme
+
+// Syntax pairs a parser with its dual printer, so that a format is described
+// once, and can be both parsed and printed. Syntax values are composed using the
+// functions suffixed with Syntax, which combine their parsers and printers
+// using the matching combinators and their duals.
+type Syntax[Input Bytes, Output any] struct {
+	Parser  Parser[Input, Output]
+	Printer Printer[Output, Input]
+}
+
+// TokenSyntax matches and prints the provided token, whose value is discarded.
+func TokenSyntax[Input Bytes](token string) Syntax[Input, struct{}] {
+	return Syntax[Input, struct{}]{
+		Parser:  Assign(struct{}{}, Token[Input](token)),
+		Printer: PrintToken[Input](token),
+	}
+}
+
+// Int64Syntax parses integers using Int64, and prints them using PrintInt64.
+func Int64Syntax[Input Bytes]() Syntax[Input, int64] {
+	return Syntax[Input, int64]{
+		Parser:  Int64[Input](),
+		Printer: PrintInt64[Input](),
+	}
+}
+
+// DelimitedSyntax combines the provided syntaxes using Delimited and
+// PrintDelimited.
+func DelimitedSyntax[Input Bytes, Output any](
+	prefix Syntax[Input, struct{}],
+	syntax Syntax[Input, Output],
+	suffix Syntax[Input, struct{}],
+) Syntax[Input, Output] {
+	return Syntax[Input, Output]{
+		Parser:  Delimited(prefix.Parser, syntax.Parser, suffix.Parser),
+		Printer: PrintDelimited(prefix.Printer, syntax.Printer, suffix.Printer),
+	}
+}
+
+// SeparatedListSyntax combines the provided syntaxes using SeparatedList0 and
+// PrintSeparatedList.
+func SeparatedListSyntax[Input Bytes, Output any](
+	syntax Syntax[Input, Output],
+	separator Syntax[Input, struct{}],
+) Syntax[Input, []Output] {
+	return Syntax[Input, []Output]{
+		Parser:  SeparatedList0(syntax.Parser, separator.Parser),
+		Printer: PrintSeparatedList(syntax.Printer, separator.Printer),
+	}
+}
+
+// MapSyntax converts the values of the provided syntax using the provided iso:
+// parsed values are mapped using Map and the iso's To conversion, and printed
+// ones are converted back using PrintMap and the iso's From conversion.
+func MapSyntax[Input Bytes, A, B any](syntax Syntax[Input, A], iso Iso[A, B]) Syntax[Input, B] {
+	return Syntax[Input, B]{
+		Parser:  Map(syntax.Parser, iso.To),
+		Printer: PrintMap(syntax.Printer, iso),
+	}
+}
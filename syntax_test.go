@@ -0,0 +1,89 @@
+package gomme
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// listSyntax describes bracketed lists of integers, such as "[1,2,3]".
+func listSyntax() Syntax[string, []int] {
+	integer := MapSyntax(
+		Syntax[string, string]{Parser: Digit1[string](), Printer: PrintInput[string]()},
+		itoaIso,
+	)
+
+	return DelimitedSyntax(
+		TokenSyntax[string]("["),
+		SeparatedListSyntax(integer, TokenSyntax[string](",")),
+		TokenSyntax[string]("]"),
+	)
+}
+
+func TestSyntax(t *testing.T) {
+	t.Parallel()
+
+	syntax := listSyntax()
+
+	testCases := []struct {
+		name   string
+		input  string
+		values []int
+	}{
+		{name: "empty list should round-trip", input: "[]", values: []int{}},
+		{name: "single element list should round-trip", input: "[42]", values: []int{42}},
+		{name: "list should round-trip", input: "[1,22,333]", values: []int{1, 22, 333}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := syntax.Parser(tc.input)
+			assert.Nil(t, result.Err)
+			assert.Equal(t, tc.values, result.Output)
+			assert.Equal(t, "", result.Remaining)
+
+			printed, err := Print(syntax.Printer, tc.values)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.input, printed)
+		})
+	}
+
+	assert.Error(t, syntax.Parser("[1,a]").Err)
+}
+
+func TestInt64Syntax(t *testing.T) {
+	t.Parallel()
+
+	syntax := DelimitedSyntax(TokenSyntax[[]byte](":"), Int64Syntax[[]byte](), TokenSyntax[[]byte]("\r\n"))
+
+	result := syntax.Parser([]byte(":-12\r\n"))
+	assert.Nil(t, result.Err)
+	assert.Equal(t, int64(-12), result.Output)
+
+	printed, err := Print(syntax.Printer, -12)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(":-12\r\n"), printed)
+}
+
+func BenchmarkSyntax(b *testing.B) {
+	syntax := listSyntax()
+	values := []int{1, 22, 333}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		printed, _ := Print(syntax.Printer, values)
+		syntax.Parser(printed)
+	}
+}
+
+// itoaIso converts between integers and their decimal representation.
+var itoaIso = Iso[string, int]{
+	To:   strconv.Atoi,
+	From: func(value int) (string, error) { return strconv.Itoa(value), nil },
+}
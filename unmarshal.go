@@ -0,0 +1,470 @@
+package gomme
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Struct derives a parser producing values of the struct type T, out of records
+// whose fields follow each other in the input, such as log lines. The struct's
+// exported fields are parsed in order, each one from the input immediately
+// following the previous one's.
+//
+// The parser of a field is selected by its `parse` struct tag, which holds one of:
+//   - the name of a parser registered using RegisterParser, or of a built-in one:
+//     "int", "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32",
+//     "uint64", "float32", "float64", "alpha", "alphanumeric", "digits",
+//     "whitespace", and "quoted", for a double quoted string.
+//   - "token=X", matching the literal X.
+//   - "until=X", matching the input up to, but excluding, the literal X.
+//   - "oneof=XYZ", matching one or more of the characters X, Y, and Z.
+//   - "-", leaving the field set to its zero value.
+//
+// Fields without a tag are parsed after their type: integer and float fields
+// using the matching numeric parser, and struct fields as nested records. Fields
+// named _ are matched, but not assigned, which allows to describe delimiters
+// using tags such as `parse:"token=,"`. Other unexported fields are ignored.
+//
+// A parser's output is assigned to its field if the field's type can hold it,
+// or converted to the field's type if both are numeric, or both are strings
+// or byte slices. Numbers which don't fit into their field make the parser
+// return a fatal error result.
+//
+// If one of the fields fails to parse, the parser returns its error result. If
+// T isn't a struct, or holds a field whose tag or type can't be handled, the
+// parser always returns a fatal error result.
+func Struct[Input Bytes, T any]() Parser[Input, T] {
+	decode, err := recordLayout[Input](reflect.TypeOf((*T)(nil)).Elem())
+
+	return func(input Input) Result[T, Input] {
+		if err != nil {
+			return Failure[Input, T](&Error[Input]{Input: input, Err: err, Expected: []string{"Struct"}}, input)
+		}
+
+		var output T
+		result := decode(input, reflect.ValueOf(&output).Elem())
+		if result.Err != nil {
+			return Failure[Input, T](result.Err, input)
+		}
+
+		return Success(output, result.Remaining)
+	}
+}
+
+// Unmarshal parses the whole input into the struct pointed to by v, following the
+// layout described by the struct's tags, as documented by Struct.
+//
+// It returns an error if v isn't a non-nil pointer to a struct whose layout can
+// be handled, if the input doesn't match the layout, or if any input is left
+// once the struct was parsed. Input errors are *Error[Input] values.
+func Unmarshal[Input Bytes](input Input, v any) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return fmt.Errorf("gomme: Unmarshal requires a non-nil pointer to a struct, got %T", v)
+	}
+
+	decode, err := recordLayout[Input](target.Type().Elem())
+	if err != nil {
+		return err
+	}
+
+	// Fields are parsed into a copy of the target, so that it is left untouched
+	// if the input doesn't match its layout.
+	record := reflect.New(target.Type().Elem()).Elem()
+
+	result := decode(input, record)
+	if result.Err != nil {
+		return result.Err
+	}
+
+	if len(result.Remaining) > 0 {
+		return NewError(result.Remaining, "EOF")
+	}
+
+	target.Elem().Set(record)
+
+	return nil
+}
+
+// RegisterParser makes the provided parser available to the `parse` struct tags
+// of the records parsed from inputs of type Input, under the provided name. It is
+// meant to be called from init functions, as records whose layout was computed
+// before the parser was registered don't use it.
+//
+// It panics if the name is empty, or if a parser was already registered under the
+// same name for the same Input type, or if the name is one of a built-in parser.
+func RegisterParser[Input Bytes, Output any](name string, parse Parser[Input, Output]) {
+	if name == "" {
+		panic("gomme: RegisterParser called with an empty name")
+	}
+
+	if _, ok := builtinParser[Input](name); ok {
+		panic(fmt.Sprintf("gomme: RegisterParser called with the name of built-in parser %q", name))
+	}
+
+	key := registryKey{name: name, input: reflect.TypeOf((*Input)(nil)).Elem()}
+	if _, loaded := registry.LoadOrStore(key, typedParser(parse)); loaded {
+		panic(fmt.Sprintf("gomme: RegisterParser called twice for parser %q", name))
+	}
+}
+
+// registryKey identifies a registered parser.
+type registryKey struct {
+	name  string
+	input reflect.Type
+}
+
+var (
+	// registry associates registryKey values with taggedParser values.
+	registry sync.Map
+
+	// layouts caches the record decoders computed by recordLayout, associating
+	// layoutKey values with layout values.
+	layouts sync.Map
+)
+
+// layoutKey identifies the layout of a record type, as parsed from inputs of a
+// given type.
+type layoutKey struct {
+	record reflect.Type
+	input  reflect.Type
+}
+
+// layout holds the outcome of computing a record type's layout.
+type layout[Input Bytes] struct {
+	decode recordDecoder[Input]
+	err    error
+}
+
+// recordDecoder parses a record, or one of its fields, from the provided input into
+// the destination value.
+type recordDecoder[Input Bytes] func(input Input, dst reflect.Value) Result[struct{}, Input]
+
+// taggedParser is a parser whose output is exposed as an `any` value, along
+// with the type of its output.
+type taggedParser[Input Bytes] struct {
+	parse  Parser[Input, any]
+	output reflect.Type
+}
+
+// typedParser wraps the provided parser into a taggedParser.
+func typedParser[Input Bytes, Output any](parse Parser[Input, Output]) taggedParser[Input] {
+	return taggedParser[Input]{parse: Untyped(parse), output: reflect.TypeOf((*Output)(nil)).Elem()}
+}
+
+// recordLayout returns the decoder of the struct type t, computing it the first
+// time a given struct type is parsed from a given Input type.
+func recordLayout[Input Bytes](t reflect.Type) (recordDecoder[Input], error) {
+	key := layoutKey{record: t, input: reflect.TypeOf((*Input)(nil)).Elem()}
+	if cached, ok := layouts.Load(key); ok {
+		entry := cached.(layout[Input])
+		return entry.decode, entry.err
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("gomme: cannot parse into non-struct type %s", t)
+	}
+
+	decode, err := fieldsDecoder[Input](t)
+	if err != nil {
+		err = fmt.Errorf("gomme: %w", err)
+	}
+
+	layouts.Store(key, layout[Input]{decode: decode, err: err})
+
+	return decode, err
+}
+
+// fieldsDecoder produces the decoder parsing the fields of the struct type t in
+// order.
+func fieldsDecoder[Input Bytes](t reflect.Type) (recordDecoder[Input], error) {
+	type decodedField struct {
+		index  int
+		decode recordDecoder[Input]
+	}
+
+	fields := make([]decodedField, 0, t.NumField())
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+
+		tag, tagged := field.Tag.Lookup("parse")
+		if tag == "-" || (!field.IsExported() && field.Name != "_") {
+			if tagged && tag != "-" {
+				return nil, fmt.Errorf("cannot parse into unexported field %s.%s", t, field.Name)
+			}
+
+			continue
+		}
+
+		decode, err := fieldDecoder[Input](field, tag, tagged)
+		if err != nil {
+			return nil, fmt.Errorf("field %s.%s: %w", t, field.Name, err)
+		}
+
+		fields = append(fields, decodedField{index: idx, decode: decode})
+	}
+
+	return func(input Input, dst reflect.Value) Result[struct{}, Input] {
+		remaining := input
+		for _, field := range fields {
+			result := field.decode(remaining, dst.Field(field.index))
+			if result.Err != nil {
+				return Failure[Input, struct{}](result.Report(), input)
+			}
+
+			remaining = result.Remaining
+		}
+
+		return Success(struct{}{}, remaining)
+	}, nil
+}
+
+// fieldDecoder produces the decoder of the provided struct field, following its
+// `parse` tag, or its type if it has none.
+func fieldDecoder[Input Bytes](field reflect.StructField, tag string, tagged bool) (recordDecoder[Input], error) {
+	if !tagged && field.Name == "_" {
+		return nil, errors.New("missing parse tag")
+	}
+
+	if !tagged && field.Type.Kind() == reflect.Struct {
+		return fieldsDecoder[Input](field.Type)
+	}
+
+	var parser taggedParser[Input]
+	if tagged {
+		var err error
+		if parser, err = tagParser[Input](tag); err != nil {
+			return nil, err
+		}
+	} else {
+		var ok bool
+		if parser, ok = kindParser[Input](field.Type.Kind()); !ok {
+			return nil, fmt.Errorf("missing parse tag for type %s", field.Type)
+		}
+	}
+
+	parse := parser.parse
+	if field.Name == "_" {
+		return func(input Input, _ reflect.Value) Result[struct{}, Input] {
+			result := parse(input)
+			if result.Err != nil {
+				return Failure[Input, struct{}](result.Err, input)
+			}
+
+			return Success(struct{}{}, result.Remaining)
+		}, nil
+	}
+
+	assign, err := assigner(parser.output, field.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(input Input, dst reflect.Value) Result[struct{}, Input] {
+		result := parse(input)
+		if result.Err != nil {
+			return Failure[Input, struct{}](result.Err, input)
+		}
+
+		if !assign(dst, reflect.ValueOf(result.Output)) {
+			literal := input[:len(input)-len(result.Remaining)]
+			return Failure[Input, struct{}](newRangeError(input, string(literal), "Struct"), input)
+		}
+
+		return Success(struct{}{}, result.Remaining)
+	}, nil
+}
+
+// tagParser returns the parser described by a `parse` struct tag.
+func tagParser[Input Bytes](tag string) (taggedParser[Input], error) {
+	directive, value, hasValue := strings.Cut(tag, "=")
+	if hasValue {
+		if value == "" {
+			return taggedParser[Input]{}, fmt.Errorf("empty %s pattern", directive)
+		}
+
+		switch directive {
+		case "token":
+			return typedParser(Token[Input](value)), nil
+		case "until":
+			return typedParser(TakeUntilToken[Input](value)), nil
+		case "oneof":
+			return typedParser(TakeWhileOneOf[Input]([]rune(value)...)), nil
+		default:
+			return taggedParser[Input]{}, fmt.Errorf("unknown pattern %q", tag)
+		}
+	}
+
+	if parser, ok := builtinParser[Input](tag); ok {
+		return parser, nil
+	}
+
+	key := registryKey{name: tag, input: reflect.TypeOf((*Input)(nil)).Elem()}
+	if registered, ok := registry.Load(key); ok {
+		return registered.(taggedParser[Input]), nil
+	}
+
+	return taggedParser[Input]{}, fmt.Errorf("unknown parser %q", tag)
+}
+
+// builtinParser returns the built-in parser of the provided name.
+func builtinParser[Input Bytes](name string) (taggedParser[Input], bool) {
+	switch name {
+	case "int":
+		return typedParser(Int[Input]()), true
+	case "int8":
+		return typedParser(Int8[Input]()), true
+	case "int16":
+		return typedParser(Int16[Input]()), true
+	case "int32":
+		return typedParser(Int32[Input]()), true
+	case "int64":
+		return typedParser(Int64[Input]()), true
+	case "uint8":
+		return typedParser(UInt8[Input]()), true
+	case "uint16":
+		return typedParser(UInt16[Input]()), true
+	case "uint32":
+		return typedParser(UInt32[Input]()), true
+	case "uint64":
+		return typedParser(UInt64[Input]()), true
+	case "float32":
+		return typedParser(Float32[Input]()), true
+	case "float64":
+		return typedParser(Float64[Input]()), true
+	case "alpha":
+		return typedParser(Alpha1[Input]()), true
+	case "alphanumeric":
+		return typedParser(Alphanumeric1[Input]()), true
+	case "digits":
+		return typedParser(Digit1[Input]()), true
+	case "whitespace":
+		return typedParser(Whitespace1[Input]()), true
+	case "quoted":
+		return typedParser(QuotedString[Input]()), true
+	default:
+		return taggedParser[Input]{}, false
+	}
+}
+
+// kindParser returns the parser of the fields of the provided kind which have no
+// `parse` tag.
+func kindParser[Input Bytes](kind reflect.Kind) (taggedParser[Input], bool) {
+	switch kind {
+	case reflect.Int:
+		return builtinParser[Input]("int")
+	case reflect.Int8:
+		return builtinParser[Input]("int8")
+	case reflect.Int16:
+		return builtinParser[Input]("int16")
+	case reflect.Int32:
+		return builtinParser[Input]("int32")
+	case reflect.Int64:
+		return builtinParser[Input]("int64")
+	case reflect.Uint, reflect.Uint64:
+		return builtinParser[Input]("uint64")
+	case reflect.Uint8:
+		return builtinParser[Input]("uint8")
+	case reflect.Uint16:
+		return builtinParser[Input]("uint16")
+	case reflect.Uint32:
+		return builtinParser[Input]("uint32")
+	case reflect.Float32:
+		return builtinParser[Input]("float32")
+	case reflect.Float64:
+		return builtinParser[Input]("float64")
+	default:
+		return taggedParser[Input]{}, false
+	}
+}
+
+// assigner produces the function assigning values of type from to destinations
+// of type to, converting them if needed. The function returns false if a number
+// doesn't fit into its destination.
+func assigner(from, to reflect.Type) (func(dst, value reflect.Value) bool, error) {
+	switch {
+	case from.AssignableTo(to):
+		return func(dst, value reflect.Value) bool {
+			dst.Set(value)
+			return true
+		}, nil
+
+	case isTextType(from) && isTextType(to):
+		return func(dst, value reflect.Value) bool {
+			dst.Set(value.Convert(to))
+			return true
+		}, nil
+
+	case isIntegerKind(from.Kind()) && isIntegerKind(to.Kind()):
+		return func(dst, value reflect.Value) bool {
+			if value.CanInt() {
+				number := value.Int()
+				if dst.CanUint() {
+					if number < 0 || dst.OverflowUint(uint64(number)) {
+						return false
+					}
+
+					dst.SetUint(uint64(number))
+					return true
+				}
+
+				if dst.OverflowInt(number) {
+					return false
+				}
+
+				dst.SetInt(number)
+				return true
+			}
+
+			number := value.Uint()
+			if dst.CanUint() {
+				if dst.OverflowUint(number) {
+					return false
+				}
+
+				dst.SetUint(number)
+				return true
+			}
+
+			if number > math.MaxInt64 || dst.OverflowInt(int64(number)) {
+				return false
+			}
+
+			dst.SetInt(int64(number))
+			return true
+		}, nil
+
+	case (isIntegerKind(from.Kind()) || isFloatKind(from.Kind())) && isFloatKind(to.Kind()):
+		return func(dst, value reflect.Value) bool {
+			number := value.Convert(reflect.TypeOf(float64(0))).Float()
+			if dst.OverflowFloat(number) {
+				return false
+			}
+
+			dst.SetFloat(number)
+			return true
+		}, nil
+
+	default:
+		return nil, errors.New("cannot assign " + from.String() + " to type " + to.String())
+	}
+}
+
+// isTextType returns true if t is a string or byte slice type.
+func isTextType(t reflect.Type) bool {
+	return t.Kind() == reflect.String || (t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8)
+}
+
+// isIntegerKind returns true if k is an integer kind.
+func isIntegerKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Uint64
+}
+
+// isFloatKind returns true if k is a float kind.
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
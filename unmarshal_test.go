@@ -0,0 +1,213 @@
+package gomme
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// accessLog describes the lines of an HTTP server's access log, such as:
+//
+//	127.0.0.1 - [10/Oct/2000:13:55:36] "GET /index.html" 200 2326 0.25
+type accessLog struct {
+	Host     string `parse:"until= "`
+	_        string `parse:"token= - ["`
+	Time     []byte `parse:"until=]"`
+	_        string `parse:"token=] "`
+	Request  string `parse:"quoted"`
+	_        string `parse:"whitespace"`
+	Status   httpStatus
+	_        string `parse:"whitespace"`
+	Size     uint32
+	_        string `parse:"whitespace"`
+	Duration float64
+	comment  string
+}
+
+type httpStatus int16
+
+// keyValue is a record nested into the records of the tests.
+type keyValue struct {
+	Key   string `parse:"alpha"`
+	_     string `parse:"token=="`
+	Value int    `parse:"int64"`
+}
+
+type versioned struct {
+	Version string `parse:"semver"`
+	_       string `parse:"token=;"`
+	Entry   keyValue
+	Ignored int `parse:"-"`
+}
+
+func init() {
+	RegisterParser("semver", Recognize(SeparatedList1(Digit1[string](), Char[string]('.'))))
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	var line accessLog
+	err := Unmarshal(`127.0.0.1 - [10/Oct/2000:13:55:36] "GET /index.html" 200 2326 0.25`, &line)
+	assert.NoError(t, err)
+	assert.Equal(t, accessLog{
+		Host:     "127.0.0.1",
+		Time:     []byte("10/Oct/2000:13:55:36"),
+		Request:  "GET /index.html",
+		Status:   200,
+		Size:     2326,
+		Duration: 0.25,
+	}, line)
+
+	var record versioned
+	err = Unmarshal("1.2.3;answer=42", &record)
+	assert.NoError(t, err)
+	assert.Equal(t, versioned{Version: "1.2.3", Entry: keyValue{Key: "answer", Value: 42}}, record)
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		input   string
+		target  any
+		wantErr string
+	}{
+		{
+			name:    "mismatching input should fail",
+			input:   "answer:42",
+			target:  &keyValue{},
+			wantErr: "expected Token(=)",
+		},
+		{
+			name:    "trailing input should fail",
+			input:   "answer=42;",
+			target:  &keyValue{},
+			wantErr: "expected EOF",
+		},
+		{
+			name:  "number overflowing its field should fail",
+			input: "-1",
+			target: &struct {
+				Value uint8 `parse:"int64"`
+			}{},
+			wantErr: "expected Struct: number -1 out of range",
+		},
+		{
+			name:    "non-pointer target should fail",
+			input:   "answer=42",
+			target:  keyValue{},
+			wantErr: "gomme: Unmarshal requires a non-nil pointer to a struct, got gomme.keyValue",
+		},
+		{
+			name:    "non-struct target should fail",
+			input:   "42",
+			target:  new(int),
+			wantErr: "gomme: cannot parse into non-struct type int",
+		},
+		{
+			name:  "unknown parser should fail",
+			input: "42",
+			target: &struct {
+				Value int `parse:"roman"`
+			}{},
+			wantErr: `gomme: field struct { Value int "parse:\"roman\"" }.Value: unknown parser "roman"`,
+		},
+		{
+			name:  "untagged string field should fail",
+			input: "abc",
+			target: &struct {
+				Value string
+			}{},
+			wantErr: "gomme: field struct { Value string }.Value: missing parse tag for type string",
+		},
+		{
+			name:  "mismatching field type should fail",
+			input: "abc",
+			target: &struct {
+				Value int `parse:"alpha"`
+			}{},
+			wantErr: `gomme: field struct { Value int "parse:\"alpha\"" }.Value: cannot assign string to type int`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Unmarshal(tc.input, tc.target)
+			if err == nil {
+				t.Fatalf("got no error, want error %q", tc.wantErr)
+			}
+
+			if tc.wantErr != "" && err.Error() != tc.wantErr {
+				t.Errorf("got error %q, want error %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnmarshalLeavesTargetUntouchedOnError(t *testing.T) {
+	t.Parallel()
+
+	record := keyValue{Key: "unchanged", Value: 1}
+	assert.Error(t, Unmarshal([]byte("answer=x"), &record))
+	assert.Equal(t, keyValue{Key: "unchanged", Value: 1}, record)
+}
+
+func TestStruct(t *testing.T) {
+	t.Parallel()
+
+	type small struct {
+		Value int8   `parse:"int64"`
+		_     string `parse:"oneof=, "`
+	}
+
+	parser := Struct[[]byte, small]()
+
+	result := parser([]byte("-12, 7"))
+	assert.Nil(t, result.Err)
+	assert.Equal(t, small{Value: -12}, result.Output)
+	assert.Equal(t, []byte("7"), result.Remaining)
+
+	result = parser([]byte("-300,"))
+	assert.Error(t, result.Err)
+	assert.True(t, result.Err.IsFatal())
+	assert.True(t, errors.Is(result.Err, strconv.ErrRange))
+
+	result = parser([]byte("12;"))
+	assert.Error(t, result.Err)
+	assert.False(t, result.Err.IsFatal())
+	assert.Equal(t, []byte("12;"), result.Remaining)
+
+	invalid := Struct[string, int]()("42")
+	assert.Error(t, invalid.Err)
+	assert.True(t, invalid.Err.IsFatal())
+}
+
+func TestRegisterParserPanics(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { RegisterParser("", Digit1[string]()) })
+	assert.Panics(t, func() { RegisterParser("int", Digit1[string]()) })
+	assert.Panics(t, func() { RegisterParser("semver", Digit1[string]()) })
+
+	// Parsers are registered per Input type.
+	assert.NotPanics(t, func() { RegisterParser("semver", Digit1[[]byte]()) })
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	input := `127.0.0.1 - [10/Oct/2000:13:55:36] "GET /index.html" 200 2326 0.25`
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var line accessLog
+		_ = Unmarshal(input, &line)
+	}
+}
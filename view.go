@@ -0,0 +1,10 @@
+//go:build !gomme_unsafe || !go1.20
+
+package gomme
+
+// viewString converts the provided input into a string, copying it if it is
+// a []byte. Building with the gomme_unsafe tag, using Go 1.20 or later, turns it
+// into a zero-copy view.
+func viewString[Input Bytes](input Input) string {
+	return string(input)
+}
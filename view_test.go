@@ -0,0 +1,20 @@
+//go:build !gomme_unsafe
+
+package gomme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewStringCopiesBytes(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("123abc")
+	result := RecognizeString(Digit1[[]byte]())(input)
+	copy(input, "456")
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "123", result.Output)
+}
@@ -0,0 +1,19 @@
+//go:build gomme_unsafe && go1.20
+
+package gomme
+
+import "unsafe"
+
+// viewString converts the provided input into a string. A []byte input isn't
+// copied: the produced string shares its memory, and is only valid for as long
+// as the input isn't modified.
+func viewString[Input Bytes](input Input) string {
+	switch in := any(input).(type) {
+	case string:
+		return in
+	case []byte:
+		return unsafe.String(unsafe.SliceData(in), len(in))
+	}
+
+	return string(input)
+}
@@ -0,0 +1,34 @@
+//go:build gomme_unsafe && go1.20
+
+package gomme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewStringSharesBytes(t *testing.T) {
+	t.Parallel()
+
+	input := []byte("123abc")
+	result := RecognizeString(Digit1[[]byte]())(input)
+	copy(input, "456")
+
+	assert.Nil(t, result.Err)
+	assert.Equal(t, "456", result.Output)
+}
+
+func TestViewStringDoesNotAllocate(t *testing.T) {
+	recognize := RecognizeString(Pair(Digit1[[]byte](), Alpha1[[]byte]()))
+	quoted := QuotedString[[]byte]()
+	recognizeInput := []byte("123abc;")
+	quotedInput := []byte(`"hello", world`)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		recognize(recognizeInput)
+		quoted(quotedInput)
+	})
+
+	assert.Equal(t, 0.0, allocs)
+}